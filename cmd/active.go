@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var proposalActivePath bool
+
+var specProposalActiveCmd = &cobra.Command{
+	Use:   "active",
+	Short: "Print the primary proposal's slug for scripts",
+	Args:  cobra.NoArgs,
+	Run:   runSpecProposalActive,
+}
+
+func init() {
+	specProposalActiveCmd.Long = helpText("spec-proposal-active")
+	specProposalActiveCmd.Flags().BoolVar(&proposalActivePath, "path", false, "Print the proposal directory instead of its slug")
+	specProposalCmd.AddCommand(specProposalActiveCmd)
+}
+
+func runSpecProposalActive(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		os.Exit(1)
+	}
+
+	line, err := activeProposalLine(specPath, proposalActivePath)
+	if err != nil {
+		printError(err.Error())
+		os.Exit(1)
+	}
+	if line == "" {
+		os.Exit(1)
+	}
+	fmt.Println(line)
+}
+
+// activeProposalLine returns the primary proposal's slug, or its directory
+// when path is set. It is empty when no proposal is primary.
+func activeProposalLine(specPath string, path bool) (string, error) {
+	slug, proposalPath, err := getPrimaryProposal(specPath)
+	if err != nil {
+		return "", err
+	}
+	if path {
+		return proposalPath, nil
+	}
+	return slug, nil
+}
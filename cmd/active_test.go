@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestActiveProposalLine(t *testing.T) {
+	specPath := t.TempDir()
+	proposalPath := filepath.Join(specPath, proposalDir, "feature")
+	if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	// No active proposal prints nothing
+	for _, path := range []bool{false, true} {
+		line, err := activeProposalLine(specPath, path)
+		if err != nil {
+			t.Fatalf("activeProposalLine error: %v", err)
+		}
+		if line != "" {
+			t.Errorf("activeProposalLine(path=%v) = %q with nothing active, want empty", path, line)
+		}
+	}
+
+	state, _ := loadState(specPath)
+	state.ActivateProposal("feature", map[string]string{})
+	if err := saveState(specPath, state); err != nil {
+		t.Fatalf("saveState error: %v", err)
+	}
+
+	if line, err := activeProposalLine(specPath, false); err != nil || line != "feature" {
+		t.Errorf("activeProposalLine = %q, %v, want feature", line, err)
+	}
+	if line, err := activeProposalLine(specPath, true); err != nil || line != proposalPath {
+		t.Errorf("activeProposalLine(path) = %q, %v, want %s", line, err, proposalPath)
+	}
+
+	// A primary proposal that no longer exists is an error
+	if err := os.RemoveAll(proposalPath); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	if _, err := activeProposalLine(specPath, false); err == nil {
+		t.Error("activeProposalLine succeeded for a removed proposal, want error")
+	}
+}
@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+var (
+	pruneOlderThan string
+	pruneKeep      int
+	pruneDryRun    bool
+)
+
+var specArchiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "Manage archived proposals",
+}
+
+var specArchivePruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove old archived proposals",
+	Args:  cobra.NoArgs,
+	Run:   runSpecArchivePrune,
+}
+
+var specArchiveShowCmd = &cobra.Command{
+	Use:               "show <slug>",
+	Short:             "Show an archived proposal without restoring it",
+	Args:              cobra.ExactArgs(1),
+	Run:               runSpecArchiveShow,
+	ValidArgsFunction: completeArchiveNames,
+}
+
+func init() {
+	specArchiveCmd.Long = helpText("spec-archive")
+	specArchivePruneCmd.Long = helpText("spec-archive-prune")
+	specArchiveShowCmd.Long = helpText("spec-archive-show")
+
+	specArchivePruneCmd.Flags().StringVar(&pruneOlderThan, "older-than", "", "Remove entries older than this age (e.g. 30d, 2w, 72h)")
+	specArchivePruneCmd.Flags().IntVar(&pruneKeep, "keep", 0, "Always keep the N most recent entries")
+	specArchivePruneCmd.Flags().BoolVar(&pruneDryRun, "dry-run", false, "Show what would be removed without removing anything")
+
+	specArchiveCmd.AddCommand(specArchivePruneCmd)
+	specArchiveCmd.AddCommand(specArchiveShowCmd)
+	specCmd.AddCommand(specArchiveCmd)
+}
+
+// ArchiveEntry is a single archived proposal directory.
+type ArchiveEntry struct {
+	Slug    string
+	Path    string
+	ModTime time.Time
+	Size    int64
+}
+
+func runSpecArchivePrune(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	if pruneOlderThan == "" && pruneKeep <= 0 {
+		printError("Specify --older-than and/or --keep")
+		return
+	}
+
+	var olderThan time.Duration
+	if pruneOlderThan != "" {
+		olderThan, err = parseRetentionDuration(pruneOlderThan)
+		if err != nil {
+			printError(err.Error())
+			return
+		}
+	}
+
+	entries, err := listArchiveEntries(specPath)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to read archive: %v", err))
+		return
+	}
+
+	toPrune := selectArchivesToPrune(entries, olderThan, pruneKeep, time.Now())
+	if len(toPrune) == 0 {
+		printDim("Nothing to prune")
+		return
+	}
+
+	var freed int64
+	removed := 0
+	for _, entry := range toPrune {
+		age := time.Since(entry.ModTime).Round(time.Hour)
+		if pruneDryRun {
+			fmt.Printf("  %s %s\n", entry.Slug, dimStyle.Render(fmt.Sprintf("(%s old, %s)", age, formatBytes(entry.Size))))
+			removed++
+			freed += entry.Size
+			continue
+		}
+
+		if err := os.RemoveAll(entry.Path); err != nil {
+			printError(fmt.Sprintf("Failed to remove %s: %v", entry.Slug, err))
+			continue
+		}
+		printDim(fmt.Sprintf("Removed %s/%s", archiveDir, entry.Slug))
+		removed++
+		freed += entry.Size
+	}
+
+	if pruneDryRun {
+		printInfo(fmt.Sprintf("Would remove %d archived proposal(s), freeing %s", removed, formatBytes(freed)))
+		return
+	}
+	printSuccess(fmt.Sprintf("Removed %d archived proposal(s), freed %s", removed, formatBytes(freed)))
+}
+
+func runSpecArchiveShow(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	content, err := readArchivedProposal(specPath, args[0])
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	printPaged(content)
+}
+
+// completeArchiveNames provides shell completion for archived proposals.
+func completeArchiveNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeWorkspaceSlugs(archiveDir, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// readArchivedProposal returns the documents of an archived proposal with a
+// header saying whether it was completed or abandoned. A completed
+// proposal's specification was promoted rather than archived, so it is read
+// from section/<slug>.md.
+func readArchivedProposal(specPath, slug string) (string, error) {
+	archivePath := filepath.Join(specPath, archiveDir, slug)
+	if !workspace.FileExists(archivePath) {
+		return "", fmt.Errorf("archived proposal '%s' does not exist", slug)
+	}
+
+	status := "Completed"
+	if workspace.FileExists(filepath.Join(archivePath, ".abandoned")) {
+		status = "Abandoned"
+	}
+
+	var buf strings.Builder
+	buf.WriteString(fmt.Sprintf("# Archived proposal: %s\n\nStatus: %s\nLocation: %s\n", slug, status, archivePath))
+
+	for _, doc := range proposalDocuments(specPath) {
+		docPath := filepath.Join(archivePath, doc.File)
+		if doc.File == "specification.md" && !workspace.FileExists(docPath) {
+			docPath = filepath.Join(specPath, sectionDir, slug+".md")
+		}
+		content, err := os.ReadFile(docPath)
+		if err != nil {
+			continue
+		}
+		buf.WriteString(fmt.Sprintf("\n---\n\n## %s\n\n", doc.Name))
+		buf.Write(content)
+	}
+
+	return buf.String(), nil
+}
+
+// listArchiveEntries returns archived proposal directories, newest first.
+func listArchiveEntries(specPath string) ([]ArchiveEntry, error) {
+	archivePath := filepath.Join(specPath, archiveDir)
+	dirEntries, err := os.ReadDir(archivePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entries []ArchiveEntry
+	for _, de := range dirEntries {
+		if !de.IsDir() {
+			continue
+		}
+		info, err := de.Info()
+		if err != nil {
+			return nil, err
+		}
+		path := filepath.Join(archivePath, de.Name())
+		size, err := dirSize(path)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, ArchiveEntry{
+			Slug:    de.Name(),
+			Path:    path,
+			ModTime: info.ModTime(),
+			Size:    size,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ModTime.After(entries[j].ModTime)
+	})
+	return entries, nil
+}
+
+// selectArchivesToPrune returns the entries outside the retention policy.
+// entries must be sorted newest first. The keep newest entries are always
+// retained; of the rest, only those older than olderThan are pruned (or all
+// of them when olderThan is zero).
+func selectArchivesToPrune(entries []ArchiveEntry, olderThan time.Duration, keep int, now time.Time) []ArchiveEntry {
+	var prune []ArchiveEntry
+	for i, entry := range entries {
+		if keep > 0 && i < keep {
+			continue
+		}
+		if olderThan > 0 && now.Sub(entry.ModTime) <= olderThan {
+			continue
+		}
+		prune = append(prune, entry)
+	}
+	return prune
+}
+
+// parseRetentionDuration parses a duration, additionally accepting day (d)
+// and week (w) suffixes.
+func parseRetentionDuration(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if n, ok := strings.CutSuffix(value, suffix); ok {
+			count, err := strconv.Atoi(n)
+			if err != nil || count < 0 {
+				return 0, fmt.Errorf("invalid duration: %s", value)
+			}
+			return time.Duration(count) * unit, nil
+		}
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration: %s (use e.g. 30d, 2w, 72h)", value)
+	}
+	return d, nil
+}
+
+// dirSize returns the total size of regular files under path.
+func dirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// formatBytes renders a byte count in human-readable units.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGT"[exp])
+}
@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func seedArchives(t *testing.T, specPath string, ages map[string]time.Duration, now time.Time) {
+	t.Helper()
+	for slug, age := range ages {
+		dir := filepath.Join(specPath, archiveDir, slug)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "design.md"), []byte("# Design\n"), 0o644); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+		mtime := now.Add(-age)
+		if err := os.Chtimes(dir, mtime, mtime); err != nil {
+			t.Fatalf("chtimes: %v", err)
+		}
+	}
+}
+
+func TestSelectArchivesToPrune(t *testing.T) {
+	day := 24 * time.Hour
+	now := time.Now()
+	specPath := t.TempDir()
+	seedArchives(t, specPath, map[string]time.Duration{
+		"newest": 1 * day,
+		"recent": 10 * day,
+		"old":    40 * day,
+		"oldest": 90 * day,
+	}, now)
+
+	// Live proposals and promoted specs must never be considered
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir, "live"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	entries, err := listArchiveEntries(specPath)
+	if err != nil {
+		t.Fatalf("listArchiveEntries error: %v", err)
+	}
+	if len(entries) != 4 {
+		t.Fatalf("expected 4 archive entries, got %d", len(entries))
+	}
+	if entries[0].Slug != "newest" || entries[3].Slug != "oldest" {
+		t.Fatalf("entries not sorted newest first: %v, %v", entries[0].Slug, entries[3].Slug)
+	}
+
+	tests := []struct {
+		name      string
+		olderThan time.Duration
+		keep      int
+		want      []string
+	}{
+		{name: "older than", olderThan: 30 * day, want: []string{"old", "oldest"}},
+		{name: "keep", keep: 1, want: []string{"recent", "old", "oldest"}},
+		{name: "keep protects old entries", olderThan: 5 * day, keep: 3, want: []string{"oldest"}},
+		{name: "nothing old enough", olderThan: 365 * day, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := selectArchivesToPrune(entries, tt.olderThan, tt.keep, now)
+			if len(got) != len(tt.want) {
+				t.Fatalf("pruned %d entries, want %d", len(got), len(tt.want))
+			}
+			for i, entry := range got {
+				if entry.Slug != tt.want[i] {
+					t.Errorf("pruned[%d] = %s, want %s", i, entry.Slug, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseRetentionDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{input: "30d", want: 30 * 24 * time.Hour},
+		{input: "2w", want: 14 * 24 * time.Hour},
+		{input: "72h", want: 72 * time.Hour},
+		{input: "abc", wantErr: true},
+		{input: "xd", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseRetentionDuration(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("parseRetentionDuration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("parseRetentionDuration(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestReadArchivedProposal(t *testing.T) {
+	specPath := t.TempDir()
+	files := map[string]string{
+		filepath.Join(archiveDir, "login", "design.md"):                 "# Design: Login\n",
+		filepath.Join(archiveDir, "login", "implementation.md"):         "# Implementation: Login\n",
+		filepath.Join(sectionDir, "login.md"):                           "# Login\n\nPromoted spec.\n",
+		filepath.Join(archiveDir, "search", "specification.md"):         "# Search\n\nAbandoned spec.\n",
+		filepath.Join(archiveDir, "search", "design.md"):                "# Design: Search\n",
+		filepath.Join(archiveDir, "search", ".abandoned"):               "",
+		filepath.Join(archiveDir, "search", "implementation.md"):        "# Implementation: Search\n",
+		filepath.Join(archiveDir, "search", "notes", "ignored-file.md"): "not a proposal document\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(specPath, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		slug   string
+		status string
+		want   []string
+	}{
+		{slug: "login", status: "Status: Completed", want: []string{"Promoted spec.", "# Design: Login", "# Implementation: Login"}},
+		{slug: "search", status: "Status: Abandoned", want: []string{"Abandoned spec.", "# Design: Search", "# Implementation: Search"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.slug, func(t *testing.T) {
+			content, err := readArchivedProposal(specPath, tt.slug)
+			if err != nil {
+				t.Fatalf("readArchivedProposal: %v", err)
+			}
+			if !strings.Contains(content, tt.status) {
+				t.Errorf("content missing %q:\n%s", tt.status, content)
+			}
+			last := -1
+			for _, want := range tt.want {
+				i := strings.Index(content, want)
+				if i <= last {
+					t.Errorf("content missing %q after previous documents:\n%s", want, content)
+				}
+				last = i
+			}
+			if strings.Contains(content, "not a proposal document") {
+				t.Errorf("content includes a non-document file:\n%s", content)
+			}
+		})
+	}
+
+	if _, err := readArchivedProposal(specPath, "missing"); err == nil {
+		t.Error("readArchivedProposal(missing) succeeded, want error")
+	}
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var bumpPhaseComplete bool
+
+var specProposalBumpPhaseCmd = &cobra.Command{
+	Use:   "bump-phase <change-slug>",
+	Short: "Show or complete the remaining tasks of a proposal's current phase",
+	Args:  cobra.ExactArgs(1),
+	Run:   runSpecProposalBumpPhase,
+}
+
+func init() {
+	specProposalBumpPhaseCmd.Long = helpText("spec-proposal-bump-phase")
+	specProposalBumpPhaseCmd.Flags().BoolVar(&bumpPhaseComplete, "complete", false, "Check off every remaining task in the current phase")
+	specProposalCmd.AddCommand(specProposalBumpPhaseCmd)
+}
+
+func runSpecProposalBumpPhase(cmd *cobra.Command, args []string) {
+	slug := args[0]
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	proposalPath, err := checkProposal(specPath, slug)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	implPath := filepath.Join(proposalPath, "implementation.md")
+	content, err := os.ReadFile(implPath)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to read implementation.md: %v", err))
+		return
+	}
+
+	phases := extractPhases(string(content))
+	idx := nextIncompletePhase(phases)
+	if idx == -1 {
+		printSuccess("All phases complete")
+		return
+	}
+
+	if !bumpPhaseComplete {
+		printPhaseRemaining(phases, idx)
+		fmt.Println()
+		printDim(fmt.Sprintf("Run 'nocturnal spec proposal bump-phase %s --complete' to check them off", slug))
+		return
+	}
+
+	phase := phases[idx]
+	updated, completed := completePhaseTasks(string(content), phase)
+	if err := os.WriteFile(implPath, []byte(updated), 0644); err != nil {
+		printError(fmt.Sprintf("Failed to write implementation.md: %v", err))
+		return
+	}
+	for _, task := range completed {
+		if err := recordTaskCompletion(specPath, slug, task.ID); err != nil {
+			printWarning(fmt.Sprintf("Failed to record task completion: %v", err))
+			break
+		}
+	}
+
+	printSuccess(fmt.Sprintf("Completed phase %d: %s (%d task(s) checked off)", idx+1, phase.Name, len(completed)))
+
+	phases = extractPhases(updated)
+	next := nextIncompletePhase(phases)
+	if next == -1 {
+		printSuccess("All phases complete")
+		return
+	}
+	fmt.Println()
+	printPhaseRemaining(phases, next)
+}
+
+// nextIncompletePhase returns the index of the first phase with an
+// unchecked task, or -1 if every task is done. Phases without tasks are
+// skipped.
+func nextIncompletePhase(phases []Phase) int {
+	for i, phase := range phases {
+		for _, task := range phase.Tasks {
+			if !task.Complete {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// printPhaseRemaining prints a phase heading and its unchecked tasks.
+func printPhaseRemaining(phases []Phase, idx int) {
+	phase := phases[idx]
+	fmt.Println(boldStyle.Render(fmt.Sprintf("Phase %d of %d: %s", idx+1, len(phases), phase.Name)))
+	if phase.Goal != "" {
+		printDim(fmt.Sprintf("Goal: %s", phase.Goal))
+	}
+	fmt.Println()
+	for _, task := range phase.Tasks {
+		if !task.Complete {
+			fmt.Printf("  %s %s\n", infoStyle.Render(task.ID), task.Text)
+		}
+	}
+}
+
+// completePhaseTasks checks off every unchecked task in phase, leaving the
+// rest of content untouched. It returns the updated content and the tasks
+// that were checked off.
+func completePhaseTasks(content string, phase Phase) (string, []Task) {
+	lines := strings.Split(content, "\n")
+	var completed []Task
+	for _, task := range phase.Tasks {
+		if task.Complete {
+			continue
+		}
+		if setTaskChecked(lines, task, true) {
+			completed = append(completed, task)
+		}
+	}
+	return strings.Join(lines, "\n"), completed
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+func TestBumpPhaseCompletesOnlyCurrentPhase(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	proposalPath := filepath.Join(specPath, proposalDir, "oauth-login")
+	if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	impl := `# Implementation
+
+### Phase 1: Setup
+- [x] Add config
+- [x] Add migration
+
+### Phase 2: Core
+**Goal**: Login works
+- [x] Add handler
+- [ ] Add token exchange
+  - [ ] Store refresh token
+
+### Phase 3: Polish
+- [ ] Add docs
+`
+	implPath := filepath.Join(proposalPath, "implementation.md")
+	if err := os.WriteFile(implPath, []byte(impl), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	t.Cleanup(func() { bumpPhaseComplete = false })
+	bumpPhaseComplete = true
+	captureStdout(t, func() {
+		runSpecProposalBumpPhase(specProposalBumpPhaseCmd, []string{"oauth-login"})
+	})
+
+	got, err := os.ReadFile(implPath)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	want := `# Implementation
+
+### Phase 1: Setup
+- [x] Add config
+- [x] Add migration
+
+### Phase 2: Core
+**Goal**: Login works
+- [x] Add handler
+- [x] Add token exchange
+  - [x] Store refresh token
+
+### Phase 3: Polish
+- [ ] Add docs
+`
+	if string(got) != want {
+		t.Errorf("implementation.md =\n%s\nwant\n%s", got, want)
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	var tasks []string
+	for _, event := range state.History {
+		if event.Type == workspace.EventTaskCompleted {
+			tasks = append(tasks, event.Task)
+		}
+	}
+	if len(tasks) != 2 || tasks[0] != "2.2" || tasks[1] != "2.3" {
+		t.Errorf("recorded tasks = %v, want [2.2 2.3]", tasks)
+	}
+
+	if idx := nextIncompletePhase(extractPhases(string(got))); idx != 2 {
+		t.Errorf("next phase = %d, want 2", idx)
+	}
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// componentTagPattern matches a "[component=Name]" tag naming the design
+// component a task implements.
+var componentTagPattern = regexp.MustCompile(`\[component=([^\]]+)\]`)
+
+// genericDesignHeadings are the Detailed Design subsections of the design
+// template. They group components rather than name one.
+var genericDesignHeadings = map[string]bool{
+	"architecture overview": true,
+	"component design":      true,
+	"data design":           true,
+	"api design":            true,
+}
+
+// designComponents returns the headings nested under the "Detailed Design"
+// section of design.md, leaving out the template's own subsections and
+// unfilled "[Name]" placeholders.
+func designComponents(content string) []string {
+	var components []string
+	sectionLevel := 0
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+		title := headerTitle(trimmed)
+
+		switch {
+		case sectionLevel == 0:
+			if strings.EqualFold(title, "Detailed Design") {
+				sectionLevel = level
+			}
+		case level <= sectionLevel:
+			return components
+		case title != "" && !genericDesignHeadings[strings.ToLower(title)] && !strings.Contains(title, "["):
+			components = append(components, title)
+		}
+	}
+	return components
+}
+
+// componentAlignmentWarnings compares design components with implementation
+// tasks. A component is covered when a task mentions its name; a task's
+// [component=Name] tag must name a component of the design. Both checks are
+// heuristic, so they only ever warn.
+func componentAlignmentWarnings(design, implementation string) []string {
+	components := designComponents(design)
+	phases := extractPhases(implementation)
+
+	var warnings []string
+	for _, component := range components {
+		referenced := false
+		for _, phase := range phases {
+			for _, task := range phase.Tasks {
+				if containsText(task.Text, component) {
+					referenced = true
+				}
+			}
+		}
+		if !referenced {
+			warnings = append(warnings, fmt.Sprintf("No task references design component: %s", component))
+		}
+	}
+
+	for _, phase := range phases {
+		for _, task := range phase.Tasks {
+			for _, match := range componentTagPattern.FindAllStringSubmatch(task.Text, -1) {
+				name := strings.TrimSpace(match[1])
+				if !containsComponent(components, name) {
+					warnings = append(warnings, fmt.Sprintf("Task on line %d references component not in design.md: %s", task.Line, name))
+				}
+			}
+		}
+	}
+	return warnings
+}
+
+// containsComponent reports whether name matches one of components,
+// ignoring case.
+func containsComponent(components []string, name string) bool {
+	for _, component := range components {
+		if strings.EqualFold(component, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkComponentAlignment adds the component alignment warnings to the
+// implementation.md result. It does nothing when either document is missing,
+// since validation already reports that.
+func checkComponentAlignment(proposalPath string, results []ValidationResult) []ValidationResult {
+	design, err := os.ReadFile(filepath.Join(proposalPath, "design.md"))
+	if err != nil {
+		return results
+	}
+	implementation, err := os.ReadFile(filepath.Join(proposalPath, "implementation.md"))
+	if err != nil {
+		return results
+	}
+
+	warnings := componentAlignmentWarnings(string(design), string(implementation))
+	if len(warnings) == 0 {
+		return results
+	}
+
+	for i := range results {
+		if results[i].Document == "implementation.md" {
+			results[i].Warnings = append(results[i].Warnings, warnings...)
+			return results
+		}
+	}
+	return append(results, ValidationResult{Document: "implementation.md", Warnings: warnings})
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const componentsDesign = `# Design: Caching
+
+## 5. Detailed Design
+
+### Architecture Overview
+
+Requests go through the cache first.
+
+### Component Design
+
+#### Cache Layer
+
+Stores responses.
+
+#### Invalidation Worker
+
+Evicts stale entries.
+
+### Data Design
+
+### API Design
+
+## 6. Trade-offs
+
+### Memory
+`
+
+func TestDesignComponents(t *testing.T) {
+	got := designComponents(componentsDesign)
+	if want := []string{"Cache Layer", "Invalidation Worker"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("designComponents = %q, want %q", got, want)
+	}
+
+	template := "## 5. Detailed Design\n\n### Component Design\n\n#### [Name]\n\n## 6. Trade-offs\n"
+	if got := designComponents(template); got != nil {
+		t.Errorf("designComponents(template) = %q, want none", got)
+	}
+}
+
+func TestComponentAlignmentWarnings(t *testing.T) {
+	tests := []struct {
+		name           string
+		implementation string
+		want           []string
+	}{
+		{
+			name: "aligned",
+			implementation: `### Phase 1: Build
+- [ ] Add the cache layer {#cache}
+- [ ] Run the invalidation worker on a timer [component=Invalidation Worker] {#worker}
+`,
+		},
+		{
+			name: "misaligned",
+			implementation: `### Phase 1: Build
+- [ ] Add the cache layer {#cache}
+- [ ] Add metrics [component=Metrics Exporter] {#metrics}
+`,
+			want: []string{
+				"No task references design component: Invalidation Worker",
+				"Task on line 3 references component not in design.md: Metrics Exporter",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := componentAlignmentWarnings(componentsDesign, tt.implementation); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("componentAlignmentWarnings = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckComponentAlignment(t *testing.T) {
+	proposalPath := t.TempDir()
+	files := map[string]string{
+		"design.md":         componentsDesign,
+		"implementation.md": "### Phase 1: Build\n- [ ] Add the cache layer {#cache}\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(proposalPath, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	results := checkComponentAlignment(proposalPath, []ValidationResult{{Document: "design.md"}, {Document: "implementation.md"}})
+	if len(results) != 2 || len(results[0].Warnings) != 0 {
+		t.Fatalf("results = %+v, want warnings only on implementation.md", results)
+	}
+	if want := []string{"No task references design component: Invalidation Worker"}; !reflect.DeepEqual(results[1].Warnings, want) {
+		t.Errorf("implementation.md warnings = %q, want %q", results[1].Warnings, want)
+	}
+}
@@ -15,6 +15,8 @@ type Config struct {
 	Validation ValidationConfig `yaml:"validation"`
 	Context    ContextConfig    `yaml:"context"`
 	Git        GitConfig        `yaml:"git"`
+	UI         UIConfig         `yaml:"ui"`
+	Proposal   ProposalConfig   `yaml:"proposal"`
 }
 
 // ValidationConfig controls proposal validation behavior.
@@ -27,6 +29,7 @@ type ValidationConfig struct {
 type ContextConfig struct {
 	IncludeAffectedFiles bool `yaml:"include_affected_files"` // Include code from affected files
 	MaxFileLines         int  `yaml:"max_file_lines"`         // Max lines to include per file
+	MaxOutputBytes       int  `yaml:"max_output_bytes"`       // Default max_bytes for MCP context/tasks output (0 = no limit)
 }
 
 // GitConfig controls git integration behavior.
@@ -34,6 +37,17 @@ type GitConfig struct {
 	AutoCommit bool `yaml:"auto_commit"` // Automatically commit changes when tasks complete
 }
 
+// UIConfig controls interactive behavior.
+type UIConfig struct {
+	Editor          string `yaml:"editor"`            // Editor command for opening files (overrides $EDITOR)
+	WatchDebounceMs int    `yaml:"watch_debounce_ms"` // TUI wait after a file change before refreshing
+}
+
+// ProposalConfig controls the documents that make up a proposal.
+type ProposalConfig struct {
+	Documents []ProposalDocument `yaml:"documents"` // Documents scaffolded, read, hashed, and validated
+}
+
 // DefaultConfig returns the default configuration.
 func DefaultConfig() *Config {
 	return &Config{
@@ -48,6 +62,12 @@ func DefaultConfig() *Config {
 		Git: GitConfig{
 			AutoCommit: true,
 		},
+		UI: UIConfig{
+			WatchDebounceMs: 500,
+		},
+		Proposal: ProposalConfig{
+			Documents: defaultProposalDocuments(),
+		},
 	}
 }
 
@@ -59,9 +79,11 @@ func getConfigPath(specPath string) string {
 // loadConfig reads the config file. Returns default config if file doesn't exist.
 func loadConfig(specPath string) (*Config, error) {
 	configPath := getConfigPath(specPath)
+	debugf("loading config from %s", configPath)
 	data, err := os.ReadFile(configPath)
 	if err != nil {
 		if os.IsNotExist(err) {
+			debugf("no config file; using defaults")
 			return DefaultConfig(), nil
 		}
 		return nil, fmt.Errorf("failed to read config file: %w", err)
@@ -0,0 +1,283 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+var specProposalDiffCmd = &cobra.Command{
+	Use:               "diff <slug>",
+	Short:             "Show changes to a proposal since it was activated",
+	Args:              cobra.ExactArgs(1),
+	Run:               runSpecProposalDiff,
+	ValidArgsFunction: completeProposalNames,
+}
+
+func init() {
+	specProposalDiffCmd.Long = helpText("spec-proposal-diff")
+	specProposalCmd.AddCommand(specProposalDiffCmd)
+}
+
+func runSpecProposalDiff(cmd *cobra.Command, args []string) {
+	slug := args[0]
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	if _, err := checkProposal(specPath, slug); err != nil {
+		printError(err.Error())
+		return
+	}
+
+	diff, err := proposalBaselineDiff(specPath, slug)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	if diff == "" {
+		printSuccess(fmt.Sprintf("No changes to '%s' since activation", slug))
+		return
+	}
+
+	var out strings.Builder
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
+			out.WriteString(boldStyle.Render(strings.TrimSuffix(line, "\n")) + "\n")
+		case strings.HasPrefix(line, "@@"):
+			out.WriteString(infoStyle.Render(strings.TrimSuffix(line, "\n")) + "\n")
+		case strings.HasPrefix(line, "-"):
+			out.WriteString(errorStyle.Render(strings.TrimSuffix(line, "\n")) + "\n")
+		case strings.HasPrefix(line, "+"):
+			out.WriteString(successStyle.Render(strings.TrimSuffix(line, "\n")) + "\n")
+		default:
+			out.WriteString(line)
+		}
+	}
+	printPaged(out.String())
+}
+
+// proposalBaselineDiff returns a unified diff of an active proposal's
+// documents against their content at activation, or "" if nothing changed.
+func proposalBaselineDiff(specPath, slug string) (string, error) {
+	state, err := loadState(specPath)
+	if err != nil {
+		return "", err
+	}
+	if !state.IsProposalActive(slug) {
+		return "", fmt.Errorf("proposal '%s' is not active; the baseline is recorded on activation", slug)
+	}
+	baseline, ok := state.Baselines[slug]
+	if !ok {
+		return "", fmt.Errorf("no baseline recorded for '%s'; run 'nocturnal spec proposal touch %s' to record one", slug, slug)
+	}
+
+	proposalPath := filepath.Join(specPath, proposalDir, slug)
+	current, err := readProposalBaseline(proposalPath)
+	if err != nil {
+		return "", err
+	}
+
+	// Configured documents first, then any others in either version
+	filenames := proposalDocFilenames(proposalDocumentsAt(proposalPath))
+	var extra []string
+	for _, docs := range []map[string]string{baseline, current} {
+		for filename := range docs {
+			if !contains(filenames, filename) && !contains(extra, filename) {
+				extra = append(extra, filename)
+			}
+		}
+	}
+	sort.Strings(extra)
+
+	var buf strings.Builder
+	for _, filename := range append(filenames, extra...) {
+		buf.WriteString(unifiedDiff("a/"+filename, "b/"+filename, baseline[filename], current[filename]))
+	}
+	return buf.String(), nil
+}
+
+// diffOp is a single line of an edit script: ' ' (kept), '-' or '+'.
+type diffOp struct {
+	kind byte
+	text string
+}
+
+// unifiedDiff returns a unified diff between two texts, or "" if they are
+// equal.
+func unifiedDiff(oldName, newName, oldText, newText string) string {
+	if oldText == newText {
+		return ""
+	}
+
+	ops := diffLines(splitLines(oldText), splitLines(newText))
+
+	var buf strings.Builder
+	buf.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", oldName, newName))
+
+	for start := 0; start < len(ops); {
+		// Find the next change
+		first := start
+		for first < len(ops) && ops[first].kind == ' ' {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+
+		// Extend the hunk while changes are close enough to share context
+		last := first
+		for i := first + 1; i < len(ops) && i <= last+2*diffContext; i++ {
+			if ops[i].kind != ' ' {
+				last = i
+			}
+		}
+
+		from := max(first-diffContext, start)
+		to := min(last+diffContext+1, len(ops))
+
+		oldStart, newStart := 1, 1
+		for _, op := range ops[:from] {
+			if op.kind != '+' {
+				oldStart++
+			}
+			if op.kind != '-' {
+				newStart++
+			}
+		}
+		oldLen, newLen := 0, 0
+		for _, op := range ops[from:to] {
+			if op.kind != '+' {
+				oldLen++
+			}
+			if op.kind != '-' {
+				newLen++
+			}
+		}
+		if oldLen == 0 {
+			oldStart--
+		}
+		if newLen == 0 {
+			newStart--
+		}
+
+		buf.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", oldStart, oldLen, newStart, newLen))
+		for _, op := range ops[from:to] {
+			buf.WriteString(string(op.kind) + op.text + "\n")
+		}
+		start = to
+	}
+
+	return buf.String()
+}
+
+// diffLines computes a line edit script from a to b using the longest
+// common subsequence. Hirschberg's algorithm keeps memory linear in the
+// input, so diffing large specifications does not need an n*m table.
+func diffLines(a, b []string) []diffOp {
+	var ops []diffOp
+
+	// Unchanged leading and trailing lines need no search
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	for _, line := range a[:prefix] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	ops = appendDiff(ops, a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	return ops
+}
+
+// appendDiff appends the edit script from a to b to ops, splitting a in
+// half and b where the two halves' common subsequences meet.
+func appendDiff(ops []diffOp, a, b []string) []diffOp {
+	switch {
+	case len(a) == 0:
+		for _, line := range b {
+			ops = append(ops, diffOp{'+', line})
+		}
+		return ops
+	case len(b) == 0:
+		for _, line := range a {
+			ops = append(ops, diffOp{'-', line})
+		}
+		return ops
+	case len(a) == 1:
+		for k, line := range b {
+			if line == a[0] {
+				ops = appendDiff(ops, nil, b[:k])
+				ops = append(ops, diffOp{' ', line})
+				return appendDiff(ops, nil, b[k+1:])
+			}
+		}
+		ops = append(ops, diffOp{'-', a[0]})
+		return appendDiff(ops, nil, b)
+	}
+
+	mid := len(a) / 2
+	forward := lcsLengths(a[:mid], b, false)
+	backward := lcsLengths(a[mid:], b, true)
+	split, best := 0, -1
+	for k := 0; k <= len(b); k++ {
+		if n := forward[k] + backward[len(b)-k]; n > best {
+			split, best = k, n
+		}
+	}
+
+	ops = appendDiff(ops, a[:mid], b[:split])
+	return appendDiff(ops, a[mid:], b[split:])
+}
+
+// lcsLengths returns, for each k, the longest common subsequence length of
+// a and the first k lines of b, or with reverse the last k lines of a and b.
+// Only two rows are kept.
+func lcsLengths(a, b []string, reverse bool) []int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for i := range a {
+		x := a[i]
+		if reverse {
+			x = a[len(a)-1-i]
+		}
+		for j := range b {
+			y := b[j]
+			if reverse {
+				y = b[len(b)-1-j]
+			}
+			if x == y {
+				cur[j+1] = prev[j] + 1
+			} else {
+				cur[j+1] = max(prev[j+1], cur[j])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev
+}
+
+// splitLines splits text into lines, ignoring a trailing newline.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
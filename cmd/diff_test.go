@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestProposalBaselineDiff(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+
+	if _, err := proposalBaselineDiff(specPath, "oauth-login"); err == nil {
+		t.Fatal("expected error for inactive proposal")
+	}
+
+	if _, err := activateProposalChecked(specPath, "oauth-login", true); err != nil {
+		t.Fatalf("activateProposalChecked error: %v", err)
+	}
+
+	diff, err := proposalBaselineDiff(specPath, "oauth-login")
+	if err != nil {
+		t.Fatalf("proposalBaselineDiff error: %v", err)
+	}
+	if diff != "" {
+		t.Fatalf("expected empty diff for unchanged proposal, got:\n%s", diff)
+	}
+
+	specFile := filepath.Join(specPath, proposalDir, "oauth-login", "specification.md")
+	content, err := os.ReadFile(specFile)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	updated := strings.Replace(string(content), "# ", "# Revised ", 1) + "\nA new requirement.\n"
+	if err := os.WriteFile(specFile, []byte(updated), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	diff, err = proposalBaselineDiff(specPath, "oauth-login")
+	if err != nil {
+		t.Fatalf("proposalBaselineDiff error: %v", err)
+	}
+	for _, want := range []string{"--- a/specification.md", "+++ b/specification.md", "\n+# Revised ", "\n-# ", "\n+A new requirement."} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("diff missing %q:\n%s", want, diff)
+		}
+	}
+	if strings.Contains(diff, "design.md") {
+		t.Errorf("diff should not mention unchanged design.md:\n%s", diff)
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	old := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n"
+	new := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\n"
+
+	want := "--- old\n+++ new\n" +
+		"@@ -1,5 +1,5 @@\n a\n-b\n+B\n c\n d\n e\n" +
+		"@@ -8,3 +8,4 @@\n h\n i\n j\n+k\n"
+	if got := unifiedDiff("old", "new", old, new); got != want {
+		t.Errorf("unifiedDiff =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := unifiedDiff("old", "new", old, old); got != "" {
+		t.Errorf("expected empty diff for equal input, got %q", got)
+	}
+}
+
+func TestDiffLinesIsMinimal(t *testing.T) {
+	t.Parallel()
+
+	// lcs is the quadratic reference the edit script must match
+	lcs := func(a, b []string) int {
+		table := make([][]int, len(a)+1)
+		for i := range table {
+			table[i] = make([]int, len(b)+1)
+		}
+		for i := len(a) - 1; i >= 0; i-- {
+			for j := len(b) - 1; j >= 0; j-- {
+				if a[i] == b[j] {
+					table[i][j] = table[i+1][j+1] + 1
+				} else {
+					table[i][j] = max(table[i+1][j], table[i][j+1])
+				}
+			}
+		}
+		return table[0][0]
+	}
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	randomLines := func() []string {
+		lines := make([]string, rng.IntN(30))
+		for i := range lines {
+			lines[i] = string(rune('a' + rng.IntN(4)))
+		}
+		return lines
+	}
+
+	for range 200 {
+		a, b := randomLines(), randomLines()
+		var old, updated []string
+		common := 0
+		for _, op := range diffLines(a, b) {
+			if op.kind != '+' {
+				old = append(old, op.text)
+			}
+			if op.kind != '-' {
+				updated = append(updated, op.text)
+			}
+			if op.kind == ' ' {
+				common++
+			}
+		}
+		if !slices.Equal(old, a) || !slices.Equal(updated, b) {
+			t.Fatalf("diffLines(%q, %q) does not reproduce its inputs", a, b)
+		}
+		if want := lcs(a, b); common != want {
+			t.Fatalf("diffLines(%q, %q) kept %d lines, want %d", a, b, common, want)
+		}
+	}
+}
@@ -1,13 +1,18 @@
 package cmd
 
 import (
-	"bufio"
+	"bytes"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
 var docsCmd = &cobra.Command{
@@ -17,15 +22,21 @@ var docsCmd = &cobra.Command{
 
 var (
 	docsPath string
+
+	docsSearchFuzzy bool
+	docsSearchLimit int
 )
 
 func init() {
-	docsPath = filepath.Join(getSpecPath(), "third")
+	docsPath = filepath.Join(getSpecPath(), docsDir)
 
 	docsCmd.Long = helpText("agent-docs")
 	docsListCmd.Long = helpText("agent-docs-list")
 	docsSearchCmd.Long = helpText("agent-docs-search")
 
+	docsSearchCmd.Flags().BoolVar(&docsSearchFuzzy, "fuzzy", false, "Also match names containing the query's characters in order")
+	docsSearchCmd.Flags().IntVar(&docsSearchLimit, "limit", 0, "Show at most this many results (0 means no limit)")
+
 	docsCmd.AddCommand(docsListCmd)
 	docsCmd.AddCommand(docsSearchCmd)
 
@@ -43,6 +54,10 @@ type DocComponent struct {
 	Name    string
 	Content string
 	Source  string
+
+	// Byte range of the component's section in Source, set when loaded
+	// from the docs index
+	offset, length int64
 }
 
 // formatDocsListOutput formats components as a list with previews.
@@ -63,15 +78,72 @@ func formatDocsListOutput(components []*DocComponent) string {
 	return buf.String()
 }
 
-// searchDocs filters components by name (case-insensitive).
-func searchDocs(components []*DocComponent, query string) []*DocComponent {
-	queryLower := strings.ToLower(query)
+// Match scores for a component name against a search query, best first.
+const (
+	docMatchNone = iota
+	docMatchFuzzy
+	docMatchSubstring
+	docMatchPrefix
+	docMatchExact
+)
+
+// scoreDocName rates how well name matches query (case-insensitive). A fuzzy
+// match means the query's characters appear in order in the name.
+func scoreDocName(name, query string) int {
+	name = strings.ToLower(name)
+	query = strings.ToLower(query)
+
+	switch {
+	case name == query:
+		return docMatchExact
+	case strings.HasPrefix(name, query):
+		return docMatchPrefix
+	case strings.Contains(name, query):
+		return docMatchSubstring
+	case isSubsequence(query, name):
+		return docMatchFuzzy
+	}
+	return docMatchNone
+}
+
+// isSubsequence reports whether every rune of sub appears in s in order.
+func isSubsequence(sub, s string) bool {
+	runes := []rune(sub)
+	i := 0
+	for _, r := range s {
+		if i < len(runes) && runes[i] == r {
+			i++
+		}
+	}
+	return i == len(runes)
+}
+
+// searchDocs returns the components whose names match query, best match
+// first. Substring matching is the minimum unless fuzzy is set. A positive
+// limit caps the number of results.
+func searchDocs(components []*DocComponent, query string, fuzzy bool, limit int) []*DocComponent {
+	threshold := docMatchSubstring
+	if fuzzy {
+		threshold = docMatchFuzzy
+	}
+
 	var matches []*DocComponent
+	scores := make(map[*DocComponent]int)
 	for _, comp := range components {
-		if strings.Contains(strings.ToLower(comp.Name), queryLower) {
+		if score := scoreDocName(comp.Name, query); score >= threshold {
 			matches = append(matches, comp)
+			scores[comp] = score
 		}
 	}
+
+	// Stable so equally good matches keep their file order
+	sort.SliceStable(matches, func(i, j int) bool {
+		return scores[matches[i]] > scores[matches[j]]
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
 	return matches
 }
 
@@ -90,104 +162,253 @@ func formatDocsSearchOutput(matches []*DocComponent) string {
 	return buf.String()
 }
 
-// loadDocs reads all documentation files from spec/third/.
-func loadDocs() ([]*DocComponent, error) {
+// loadDocs reads all documentation components from spec/third/, including
+// their content. Files that were skipped are returned as problems for the
+// caller to report; see printDocProblems.
+func loadDocs() ([]*DocComponent, []error, error) {
+	components, problems, err := loadDocNames()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := loadDocContent(components); err != nil {
+		return nil, nil, err
+	}
+	return components, problems, nil
+}
+
+// loadDocNames reads the documentation components from spec/third/. When a
+// docs index exists it is refreshed and used instead of parsing every file,
+// and the returned components have no content until loadDocContent is
+// called. Files that could not be read or are not text are skipped and
+// returned as problems rather than failing the load.
+func loadDocNames() ([]*DocComponent, []error, error) {
+	debugf("loading documentation from %s", docsPath)
 	info, err := os.Stat(docsPath)
 	if os.IsNotExist(err) {
-		return []*DocComponent{}, nil
+		return []*DocComponent{}, nil, nil
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to access docs directory: %w", err)
+		return nil, nil, fmt.Errorf("failed to access docs directory: %w", err)
 	}
 
 	if !info.IsDir() {
-		return nil, fmt.Errorf("%s is not a directory", docsPath)
+		return nil, nil, fmt.Errorf("%s is not a directory", docsPath)
+	}
+
+	if workspace.FileExists(filepath.Join(docsPath, docsIndexFile)) {
+		return loadIndexedDocNames(docsPath)
 	}
 
 	entries, err := os.ReadDir(docsPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read docs directory: %w", err)
+		return nil, nil, fmt.Errorf("failed to read docs directory: %w", err)
 	}
 
-	var components []*DocComponent
-
+	var files []string
 	for _, entry := range entries {
-		if entry.IsDir() {
+		if entry.IsDir() || entry.Name() == docsIndexFile {
 			continue
 		}
+		files = append(files, entry.Name())
+	}
+
+	components, problems := parseDocFiles(docsPath, files)
+	return components, problems, nil
+}
 
-		filePath := filepath.Join(docsPath, entry.Name())
-		fileComponents, err := parseDocFile(filePath)
-		if err != nil {
-			printError(fmt.Sprintf("Error reading %s: %v", entry.Name(), err))
+// parseDocFiles parses files in dir concurrently with a bounded number of
+// workers. Components are returned in the order of files (os.ReadDir sorts
+// by filename) and then in file order, regardless of which worker finishes
+// first. A file that cannot be read is skipped and returned as a problem.
+func parseDocFiles(dir string, files []string) ([]*DocComponent, []error) {
+	type parsed struct {
+		components []*DocComponent
+		err        error
+	}
+	results := make([]parsed, len(files))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(files)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				components, err := parseDocFile(filepath.Join(dir, files[i]))
+				results[i] = parsed{components, err}
+			}
+		}()
+	}
+	for i := range files {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var components []*DocComponent
+	var problems []error
+	for i, result := range results {
+		if result.err != nil {
+			problems = append(problems, fmt.Errorf("%s: %w", files[i], result.err))
 			continue
 		}
+		components = append(components, result.components...)
+	}
+	return components, problems
+}
 
-		components = append(components, fileComponents...)
+// printDocProblems reports the files skipped while loading docs. The
+// loaders leave this to CLI commands so the MCP server's stdout stays
+// reserved for JSON-RPC.
+func printDocProblems(problems []error) {
+	for _, problem := range problems {
+		if errors.Is(problem, errBinaryDocFile) {
+			printWarning(fmt.Sprintf("Skipping %v", problem))
+			continue
+		}
+		printError(fmt.Sprintf("Error reading %v", problem))
 	}
+}
 
-	return components, nil
+// errBinaryDocFile is returned by parseDocFile for files that are not text.
+var errBinaryDocFile = errors.New("not a text file")
+
+// binarySniffLen is how much of a docs file is checked for a null byte.
+const binarySniffLen = 8000
+
+// isBinaryDoc reports whether data looks like a binary file, judged by a
+// null byte near the start as git and grep do.
+func isBinaryDoc(data []byte) bool {
+	return bytes.IndexByte(data[:min(len(data), binarySniffLen)], 0) != -1
 }
 
 // parseDocFile extracts components from a file. Sections are delimited by ---.
+// Lines may be any length. Binary files return errBinaryDocFile.
 func parseDocFile(filePath string) ([]*DocComponent, error) {
-	file, err := os.Open(filePath)
+	data, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
+	if isBinaryDoc(data) {
+		return nil, errBinaryDocFile
+	}
 
 	sourceFile := filepath.Base(filePath)
 	var components []*DocComponent
-	var currentContent strings.Builder
-	var currentName string
-	inContent := false
+	for _, section := range splitDocSections(data) {
+		components = append(components, &DocComponent{
+			Name:    section.Name,
+			Content: docSectionContent(data[section.Offset : section.Offset+section.Length]),
+			Source:  sourceFile,
+		})
+	}
+	return components, nil
+}
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
+// appendDocs adds content to dir/<slug of name>.md, creating the directory
+// and file as needed. Content without a leading "# " heading is headed with
+// "# name"; an existing file gets a --- separator first. Returns the file
+// path and the number of components it now holds.
+func appendDocs(dir, name, content string) (string, int, error) {
+	slug := nameToSlug(name)
+	if slug == "" {
+		return "", 0, fmt.Errorf("invalid docs name: must contain at least one alphanumeric character")
+	}
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", 0, fmt.Errorf("content must not be empty")
+	}
+	if !strings.HasPrefix(content, "# ") {
+		content = "# " + name + "\n\n" + content
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", 0, fmt.Errorf("failed to create docs directory: %w", err)
+	}
+
+	filePath := filepath.Join(dir, slug+".md")
+	existing, err := os.ReadFile(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return "", 0, fmt.Errorf("failed to read %s: %w", filePath, err)
+	}
+
+	var b strings.Builder
+	if trimmed := strings.TrimRight(string(existing), "\n"); trimmed != "" {
+		b.WriteString(trimmed)
+		b.WriteString("\n\n---\n\n")
+	}
+	b.WriteString(content)
+	b.WriteString("\n")
+
+	if err := os.WriteFile(filePath, []byte(b.String()), 0644); err != nil {
+		return "", 0, fmt.Errorf("failed to write %s: %w", filePath, err)
+	}
+
+	components, err := parseDocFile(filePath)
+	if err != nil {
+		return filePath, 0, err
+	}
+	return filePath, len(components), nil
+}
 
-		if strings.TrimSpace(line) == "---" {
+// splitDocSections returns the name and byte range of each component in a
+// doc file. Sections are delimited by --- and named by their "# " heading;
+// sections without a heading are skipped.
+func splitDocSections(data []byte) []DocIndexEntry {
+	var sections []DocIndexEntry
+	var currentName string
+	var sectionStart int64
+
+	for pos := int64(0); pos < int64(len(data)); {
+		lineEnd := pos + int64(bytes.IndexByte(data[pos:], '\n'))
+		next := lineEnd + 1
+		if lineEnd < pos {
+			lineEnd = int64(len(data))
+			next = lineEnd
+		}
+		line := strings.TrimSpace(string(data[pos:lineEnd]))
+
+		if line == "---" {
 			if currentName != "" {
-				components = append(components, &DocComponent{
-					Name:    currentName,
-					Content: strings.TrimSpace(currentContent.String()),
-					Source:  sourceFile,
-				})
+				sections = append(sections, DocIndexEntry{Name: currentName, Offset: sectionStart, Length: pos - sectionStart})
 			}
 			currentName = ""
-			currentContent.Reset()
-			inContent = false
-			continue
+			sectionStart = next
+		} else if strings.HasPrefix(line, "# ") {
+			currentName = strings.TrimSpace(strings.TrimPrefix(line, "#"))
 		}
+		pos = next
+	}
+
+	if currentName != "" {
+		sections = append(sections, DocIndexEntry{Name: currentName, Offset: sectionStart, Length: int64(len(data)) - sectionStart})
+	}
+	return sections
+}
 
+// docSectionContent returns the content of a section: the lines after its
+// first heading, excluding heading lines. Invalid UTF-8 is replaced with
+// U+FFFD so that non-UTF-8 files still render and serialize.
+func docSectionContent(section []byte) string {
+	var content strings.Builder
+	inContent := false
+
+	for _, line := range strings.Split(strings.ToValidUTF8(string(section), "\uFFFD"), "\n") {
+		line = strings.TrimSuffix(line, "\r")
 		if strings.HasPrefix(strings.TrimSpace(line), "# ") {
-			currentName = strings.TrimSpace(strings.TrimPrefix(line, "#"))
 			inContent = true
 			continue
 		}
 
 		if inContent {
-			if currentContent.Len() > 0 {
-				currentContent.WriteString("\n")
+			if content.Len() > 0 {
+				content.WriteString("\n")
 			}
-			currentContent.WriteString(line)
+			content.WriteString(line)
 		}
 	}
 
-	if currentName != "" {
-		components = append(components, &DocComponent{
-			Name:    currentName,
-			Content: strings.TrimSpace(currentContent.String()),
-			Source:  sourceFile,
-		})
-	}
-
-	if err := scanner.Err(); err != nil {
-		return nil, err
-	}
-
-	return components, nil
+	return strings.TrimSpace(content.String())
 }
 
 var docsListCmd = &cobra.Command{
@@ -197,15 +418,16 @@ var docsListCmd = &cobra.Command{
 }
 
 func runDocsList(cmd *cobra.Command, args []string) {
-	components, err := loadDocs()
+	components, problems, err := loadDocs()
 	if err != nil {
 		printError(fmt.Sprintf("Failed to load docs: %v", err))
 		return
 	}
+	printDocProblems(problems)
 
 	if len(components) == 0 {
 		printDim("No documentation found")
-		if !fileExists(docsPath) {
+		if !workspace.FileExists(docsPath) {
 			fmt.Println()
 			printInfo(fmt.Sprintf("Create %s directory and add documentation files", docsPath))
 		}
@@ -232,27 +454,51 @@ var docsSearchCmd = &cobra.Command{
 	Short: "Search documentation by component name",
 	Args:  cobra.ExactArgs(1),
 	Run:   runDocsSearch,
+
+	ValidArgsFunction: completeDocNames,
+}
+
+func completeDocNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	components, _, err := loadDocNames()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := make([]string, 0, len(components))
+	for _, comp := range components {
+		names = append(names, comp.Name)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
 }
 
 func runDocsSearch(cmd *cobra.Command, args []string) {
-	components, err := loadDocs()
+	components, problems, err := loadDocNames()
 	if err != nil {
 		printError(fmt.Sprintf("Failed to load docs: %v", err))
 		return
 	}
+	printDocProblems(problems)
 
 	if len(components) == 0 {
 		printDim("No documentation found")
 		return
 	}
 
-	matches := searchDocs(components, args[0])
+	matches := searchDocs(components, args[0], docsSearchFuzzy, docsSearchLimit)
 	if len(matches) == 0 {
 		printDim(fmt.Sprintf("No components found matching '%s'", args[0]))
 		fmt.Println()
 		printDim("Use 'nocturnal docs list' to see all available components")
 		return
 	}
+	if err := loadDocContent(matches); err != nil {
+		printError(fmt.Sprintf("Failed to load docs: %v", err))
+		return
+	}
 
 	fmt.Println()
 	fmt.Println(boldStyle.Render(fmt.Sprintf("Found %d result(s)", len(matches))))
@@ -1,9 +1,14 @@
 package cmd
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestParseDocFile(t *testing.T) {
@@ -43,3 +48,270 @@ func TestParseDocFile(t *testing.T) {
 		t.Fatalf("component[1].Content = %q", components[1].Content)
 	}
 }
+
+func TestParseDocFileLongLine(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "minified.md")
+	line := `{"data":"` + strings.Repeat("x", 256*1024) + `"}`
+	if err := os.WriteFile(path, []byte("# Schema\n"+line+"\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	components, err := parseDocFile(path)
+	if err != nil {
+		t.Fatalf("parseDocFile error: %v", err)
+	}
+	if len(components) != 1 || components[0].Content != line {
+		t.Fatalf("expected one component holding the long line, got %d", len(components))
+	}
+}
+
+func TestLoadDocsSkipsBinaryFiles(t *testing.T) {
+	dir := t.TempDir()
+	oldDocsPath := docsPath
+	docsPath = dir
+	t.Cleanup(func() { docsPath = oldDocsPath })
+
+	if err := os.WriteFile(filepath.Join(dir, "lib.md"), []byte("# Client\nUsage \xff\xfe notes\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	binary := append([]byte("# Logo\n"), 0x89, 'P', 'N', 'G', 0x00, 0x1a)
+	if err := os.WriteFile(filepath.Join(dir, "logo.png"), binary, 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	if _, err := parseDocFile(filepath.Join(dir, "logo.png")); !errors.Is(err, errBinaryDocFile) {
+		t.Fatalf("parseDocFile error = %v, want errBinaryDocFile", err)
+	}
+
+	var components []*DocComponent
+	var problems []error
+	out := captureStdout(t, func() {
+		var err error
+		components, problems, err = loadDocs()
+		if err != nil {
+			t.Fatalf("loadDocs error: %v", err)
+		}
+	})
+	if out != "" {
+		t.Errorf("loadDocs wrote to stdout: %q", out)
+	}
+	if len(components) != 1 || components[0].Name != "Client" {
+		t.Fatalf("expected only the text component, got %+v", components)
+	}
+	if components[0].Content != "Usage \uFFFD notes" {
+		t.Errorf("content = %q, want invalid UTF-8 replaced", components[0].Content)
+	}
+	if len(problems) != 1 || !errors.Is(problems[0], errBinaryDocFile) {
+		t.Fatalf("problems = %v, want the binary file", problems)
+	}
+
+	out = captureStdout(t, func() { printDocProblems(problems) })
+	if !strings.Contains(out, "Skipping logo.png") {
+		t.Errorf("expected the binary file to be reported, got %q", out)
+	}
+}
+
+func TestSearchDocsRanking(t *testing.T) {
+	t.Parallel()
+
+	components := []*DocComponent{
+		{Name: "color-bars"},
+		{Name: "cobra-flags"},
+		{Name: "viper-config"},
+		{Name: "using-cobra"},
+		{Name: "cobra"},
+	}
+
+	names := func(matches []*DocComponent) []string {
+		var out []string
+		for _, comp := range matches {
+			out = append(out, comp.Name)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		fuzzy bool
+		limit int
+		want  []string
+	}{
+		{name: "exact outranks prefix and substring", query: "Cobra", want: []string{"cobra", "cobra-flags", "using-cobra"}},
+		{name: "substring is the default threshold", query: "cbr", want: nil},
+		{name: "prefix before substring", query: "co", want: []string{"color-bars", "cobra-flags", "cobra", "viper-config", "using-cobra"}},
+		{name: "fuzzy subsequence", query: "cbr", fuzzy: true, want: []string{"color-bars", "cobra-flags", "using-cobra", "cobra"}},
+		{name: "fuzzy ranks below substring", query: "ra", fuzzy: true, want: []string{"cobra-flags", "using-cobra", "cobra", "color-bars"}},
+		{name: "limit", query: "cobra", limit: 2, want: []string{"cobra", "cobra-flags"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := names(searchDocs(components, tt.query, tt.fuzzy, tt.limit))
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("searchDocs(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDocIndexReindexesStaleSources(t *testing.T) {
+	dir := t.TempDir()
+	oldDocsPath := docsPath
+	docsPath = dir
+	t.Cleanup(func() { docsPath = oldDocsPath })
+
+	path := filepath.Join(dir, "lib.md")
+	if err := os.WriteFile(path, []byte("# cobra-basics\nCobra intro\n---\n# cobra-flags\nFlags\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	index := loadDocIndex(dir)
+	if _, _, err := refreshDocIndex(dir, index); err != nil {
+		t.Fatalf("refreshDocIndex error: %v", err)
+	}
+	if err := saveDocIndex(dir, index); err != nil {
+		t.Fatalf("saveDocIndex error: %v", err)
+	}
+
+	// Unchanged sources are served from the index without their content
+	components, _, err := loadDocNames()
+	if err != nil {
+		t.Fatalf("loadDocNames error: %v", err)
+	}
+	if len(components) != 2 || components[1].Name != "cobra-flags" || components[1].Content != "" {
+		t.Fatalf("unexpected indexed components: %+v", components)
+	}
+
+	// A source modified after indexing is re-indexed on the next load
+	if err := os.WriteFile(path, []byte("# viper-config\nConfig files\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+
+	components, _, err = loadDocs()
+	if err != nil {
+		t.Fatalf("loadDocs error: %v", err)
+	}
+	if len(components) != 1 || components[0].Name != "viper-config" || components[0].Content != "Config files" {
+		t.Fatalf("expected re-indexed component, got %+v", components)
+	}
+
+	saved := loadDocIndex(dir)
+	if file := saved.Files["lib.md"]; file == nil || len(file.Components) != 1 || !file.ModTime.Equal(later) {
+		t.Fatalf("expected saved index to be refreshed, got %+v", file)
+	}
+}
+
+// writeDocFiles writes n doc files to dir, each with perFile components
+// named <file>-<i>, and returns the component names in expected order.
+func writeDocFiles(tb testing.TB, dir string, n, perFile int) []string {
+	tb.Helper()
+	var want []string
+	for f := range n {
+		name := fmt.Sprintf("lib%03d", f)
+		var sections []string
+		for c := range perFile {
+			component := fmt.Sprintf("%s-%d", name, c)
+			sections = append(sections, fmt.Sprintf("# %s\n%s\n", component, strings.Repeat("Some documentation text. ", 50)))
+			want = append(want, component)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name+".md"), []byte(strings.Join(sections, "---\n")), 0o644); err != nil {
+			tb.Fatalf("write file: %v", err)
+		}
+	}
+	return want
+}
+
+func TestLoadDocsOrderIsStable(t *testing.T) {
+	dir := t.TempDir()
+	oldDocsPath := docsPath
+	docsPath = dir
+	t.Cleanup(func() { docsPath = oldDocsPath })
+
+	want := writeDocFiles(t, dir, 40, 3)
+
+	for range 5 {
+		components, _, err := loadDocs()
+		if err != nil {
+			t.Fatalf("loadDocs error: %v", err)
+		}
+		var got []string
+		for _, comp := range components {
+			got = append(got, comp.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Fatalf("loadDocs order = %v, want %v", got, want)
+		}
+	}
+}
+
+func BenchmarkLoadDocs(b *testing.B) {
+	dir := b.TempDir()
+	oldDocsPath := docsPath
+	docsPath = dir
+	b.Cleanup(func() { docsPath = oldDocsPath })
+
+	writeDocFiles(b, dir, 200, 10)
+
+	for b.Loop() {
+		if _, _, err := loadDocs(); err != nil {
+			b.Fatalf("loadDocs error: %v", err)
+		}
+	}
+}
+
+func TestDocIndexNotSavedInReadOnlyMode(t *testing.T) {
+	dir := t.TempDir()
+	oldDocsPath := docsPath
+	docsPath = dir
+	t.Cleanup(func() { docsPath = oldDocsPath })
+	mcpReadOnly = true
+	t.Cleanup(func() { mcpReadOnly = false })
+
+	path := filepath.Join(dir, "lib.md")
+	if err := os.WriteFile(path, []byte("# cobra-basics\nCobra intro\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	index := loadDocIndex(dir)
+	if _, _, err := refreshDocIndex(dir, index); err != nil {
+		t.Fatalf("refreshDocIndex error: %v", err)
+	}
+	if err := saveDocIndex(dir, index); err != nil {
+		t.Fatalf("saveDocIndex error: %v", err)
+	}
+	before, err := os.ReadFile(filepath.Join(dir, docsIndexFile))
+	if err != nil {
+		t.Fatalf("read index: %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("# viper-config\nConfig files\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+
+	// The stale source is still re-indexed for the search itself
+	components, _, err := loadDocNames()
+	if err != nil {
+		t.Fatalf("loadDocNames error: %v", err)
+	}
+	if len(components) != 1 || components[0].Name != "viper-config" {
+		t.Fatalf("expected re-indexed component, got %+v", components)
+	}
+
+	after, err := os.ReadFile(filepath.Join(dir, docsIndexFile))
+	if err != nil {
+		t.Fatalf("read index: %v", err)
+	}
+	if !bytes.Equal(before, after) {
+		t.Error("docs index was rewritten in read-only mode")
+	}
+}
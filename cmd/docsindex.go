@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+// docsIndexFile caches component names and offsets within spec/third/.
+const docsIndexFile = ".index.json"
+
+var docsIndexCmd = &cobra.Command{
+	Use:   "index",
+	Short: "Build an index of documentation components for faster searches",
+	Args:  cobra.NoArgs,
+	Run:   runDocsIndex,
+}
+
+func init() {
+	docsIndexCmd.Long = helpText("agent-docs-index")
+	docsCmd.AddCommand(docsIndexCmd)
+}
+
+// DocIndex records the components of each file in spec/third/.
+type DocIndex struct {
+	Files map[string]*DocIndexFile `json:"files"`
+}
+
+// DocIndexFile is the indexed state of a single doc file. The entry is stale
+// when the file's modification time or size no longer match.
+type DocIndexFile struct {
+	ModTime    time.Time       `json:"mod_time"`
+	Size       int64           `json:"size"`
+	Components []DocIndexEntry `json:"components"`
+}
+
+// DocIndexEntry is a component's name and the byte range of its section.
+type DocIndexEntry struct {
+	Name   string `json:"name"`
+	Offset int64  `json:"offset"`
+	Length int64  `json:"length"`
+}
+
+func runDocsIndex(cmd *cobra.Command, args []string) {
+	if !workspace.FileExists(docsPath) {
+		printError(fmt.Sprintf("%s does not exist", docsPath))
+		return
+	}
+
+	// Rebuild from scratch so a corrupt index is never carried over
+	index := &DocIndex{Files: make(map[string]*DocIndexFile)}
+	_, problems, err := refreshDocIndex(docsPath, index)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to index docs: %v", err))
+		return
+	}
+	printDocProblems(problems)
+	if err := saveDocIndex(docsPath, index); err != nil {
+		printError(err.Error())
+		return
+	}
+
+	count := 0
+	for _, file := range index.Files {
+		count += len(file.Components)
+	}
+	printSuccess(fmt.Sprintf("Indexed %d component(s) from %d file(s)", count, len(index.Files)))
+	printDim(fmt.Sprintf("Index written to %s", filepath.Join(docsPath, docsIndexFile)))
+}
+
+// loadDocIndex reads the docs index from dir. A missing or unreadable index
+// is treated as empty.
+func loadDocIndex(dir string) *DocIndex {
+	index := &DocIndex{Files: make(map[string]*DocIndexFile)}
+	data, err := os.ReadFile(filepath.Join(dir, docsIndexFile))
+	if err != nil {
+		return index
+	}
+	if err := json.Unmarshal(data, index); err != nil || index.Files == nil {
+		return &DocIndex{Files: make(map[string]*DocIndexFile)}
+	}
+	return index
+}
+
+// saveDocIndex writes the docs index to dir.
+func saveDocIndex(dir string, index *DocIndex) error {
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize docs index: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, docsIndexFile), data, 0644); err != nil {
+		return fmt.Errorf("failed to write docs index: %w", err)
+	}
+	return nil
+}
+
+// refreshDocIndex re-indexes files in dir that are new or whose modification
+// time or size changed, and drops entries for removed files. It reports
+// whether the index changed, and returns the files it skipped as problems.
+func refreshDocIndex(dir string, index *DocIndex) (bool, []error, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to read docs directory: %w", err)
+	}
+
+	changed := false
+	var problems []error
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		if entry.IsDir() || entry.Name() == docsIndexFile {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return false, nil, err
+		}
+		seen[entry.Name()] = true
+
+		if cached, ok := index.Files[entry.Name()]; ok && cached.ModTime.Equal(info.ModTime()) && cached.Size == info.Size() {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			problems = append(problems, fmt.Errorf("%s: %w", entry.Name(), err))
+			delete(index.Files, entry.Name())
+			changed = true
+			continue
+		}
+		var components []DocIndexEntry
+		if isBinaryDoc(data) {
+			// Indexed without components so it isn't re-read until it changes
+			problems = append(problems, fmt.Errorf("%s: %w", entry.Name(), errBinaryDocFile))
+		} else {
+			components = splitDocSections(data)
+		}
+		index.Files[entry.Name()] = &DocIndexFile{
+			ModTime:    info.ModTime(),
+			Size:       info.Size(),
+			Components: components,
+		}
+		changed = true
+	}
+
+	for name := range index.Files {
+		if !seen[name] {
+			delete(index.Files, name)
+			changed = true
+		}
+	}
+
+	return changed, problems, nil
+}
+
+// loadIndexedDocNames returns the components recorded in dir's docs index,
+// refreshing stale entries first. The refreshed index is saved unless the
+// MCP server runs with --read-only, which must not touch the workspace; it
+// is then only refreshed in memory. Content is left empty for
+// loadDocContent.
+func loadIndexedDocNames(dir string) ([]*DocComponent, []error, error) {
+	index := loadDocIndex(dir)
+	changed, problems, err := refreshDocIndex(dir, index)
+	if err != nil {
+		return nil, nil, err
+	}
+	if changed && !mcpReadOnly {
+		// A read-only workspace can still be searched; the index is
+		// simply rebuilt again next time
+		_ = saveDocIndex(dir, index)
+	}
+
+	files := make([]string, 0, len(index.Files))
+	for name := range index.Files {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+
+	var components []*DocComponent
+	for _, name := range files {
+		for _, entry := range index.Files[name].Components {
+			components = append(components, &DocComponent{
+				Name:   entry.Name,
+				Source: name,
+				offset: entry.Offset,
+				length: entry.Length,
+			})
+		}
+	}
+	return components, problems, nil
+}
+
+// loadDocContent fills in the content of components loaded from the docs
+// index, reading each source file at most once.
+func loadDocContent(components []*DocComponent) error {
+	files := make(map[string][]byte)
+	for _, comp := range components {
+		if comp.Content != "" || comp.length == 0 {
+			continue
+		}
+
+		data, ok := files[comp.Source]
+		if !ok {
+			var err error
+			data, err = os.ReadFile(filepath.Join(docsPath, comp.Source))
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", comp.Source, err)
+			}
+			files[comp.Source] = data
+		}
+
+		if comp.offset+comp.length > int64(len(data)) {
+			return fmt.Errorf("docs index is out of date for %s; run 'nocturnal docs index'", comp.Source)
+		}
+		comp.Content = docSectionContent(data[comp.offset : comp.offset+comp.length])
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ProposalDocument describes one document scaffolded into each proposal.
+type ProposalDocument struct {
+	Name     string `yaml:"name"`     // Heading used when documents are combined
+	File     string `yaml:"file"`     // Filename inside the proposal directory
+	Template string `yaml:"template"` // Template path relative to spec/, or an embedded template
+}
+
+// defaultProposalDocuments is the standard specification/design/implementation set.
+func defaultProposalDocuments() []ProposalDocument {
+	return []ProposalDocument{
+		{Name: "Specification", File: "specification.md", Template: "templates/proposal/specification.md"},
+		{Name: "Design", File: "design.md", Template: "templates/proposal/design.md"},
+		{Name: "Implementation", File: "implementation.md", Template: "templates/proposal/implementation.md"},
+	}
+}
+
+// proposalDocuments returns the configured proposal document set for the
+// workspace, falling back to the defaults when none is configured.
+func proposalDocuments(specPath string) []ProposalDocument {
+	docs := loadConfigOrDefault(specPath).Proposal.Documents
+	if len(docs) == 0 {
+		return defaultProposalDocuments()
+	}
+	return docs
+}
+
+// proposalDocumentsAt returns the document set for the workspace containing
+// the proposal at proposalPath (spec/proposal/<slug>).
+func proposalDocumentsAt(proposalPath string) []ProposalDocument {
+	return proposalDocuments(filepath.Dir(filepath.Dir(proposalPath)))
+}
+
+// proposalDocFilenames returns the filenames of the given documents.
+func proposalDocFilenames(docs []ProposalDocument) []string {
+	files := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		files = append(files, doc.File)
+	}
+	return files
+}
+
+// archivedDocFilenames returns the documents archived on completion: every
+// configured document except specification.md, which is promoted instead.
+func archivedDocFilenames(specPath string) []string {
+	var files []string
+	for _, doc := range proposalDocuments(specPath) {
+		if doc.File != "specification.md" {
+			files = append(files, doc.File)
+		}
+	}
+	return files
+}
+
+// renderProposalDocument renders a document's scaffolding template. Templates
+// are looked up relative to the spec directory first, then in the embedded
+// templates. Documents without a template get a bare title.
+func renderProposalDocument(specPath string, doc ProposalDocument, data any) (string, error) {
+	if doc.Template == "" {
+		return fmt.Sprintf("# %s\n", doc.Name), nil
+	}
+
+	if content, err := os.ReadFile(filepath.Join(specPath, doc.Template)); err == nil {
+		return renderTemplateFromString(doc.File, string(content), data)
+	}
+
+	return renderTemplate(doc.Template, data)
+}
+
+// scaffoldProposalDocuments writes every configured document into proposalPath.
+func scaffoldProposalDocuments(specPath, proposalPath string, data any) error {
+	for _, doc := range proposalDocuments(specPath) {
+		content, err := renderProposalDocument(specPath, doc, data)
+		if err != nil {
+			return fmt.Errorf("failed to render %s: %w", doc.File, err)
+		}
+		if err := os.WriteFile(filepath.Join(proposalPath, doc.File), []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to create %s: %w", doc.File, err)
+		}
+	}
+	return nil
+}
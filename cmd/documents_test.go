@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestProposalDocumentsDefault(t *testing.T) {
+	t.Parallel()
+
+	docs := proposalDocuments(t.TempDir())
+	want := []string{"specification.md", "design.md", "implementation.md"}
+	if got := proposalDocFilenames(docs); !reflect.DeepEqual(got, want) {
+		t.Fatalf("default documents = %v, want %v", got, want)
+	}
+}
+
+func TestScaffoldCustomProposalDocuments(t *testing.T) {
+	t.Parallel()
+
+	specPath := t.TempDir()
+	config := DefaultConfig()
+	config.Proposal.Documents = []ProposalDocument{
+		{Name: "Specification", File: "specification.md", Template: "templates/proposal/specification.md"},
+		{Name: "Testing", File: "testing.md", Template: "templates/testing.md"},
+		{Name: "Rollout", File: "rollout.md"},
+	}
+	if err := saveConfig(specPath, config); err != nil {
+		t.Fatalf("saveConfig error: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(specPath, "templates"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(specPath, "templates", "testing.md"), []byte("# Testing: {{.Name}}\n"), 0o644); err != nil {
+		t.Fatalf("write template: %v", err)
+	}
+
+	proposalPath := filepath.Join(specPath, proposalDir, "feature")
+	if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	data := proposalTemplateData{Name: "Feature", Slug: "feature"}
+	if err := scaffoldProposalDocuments(specPath, proposalPath, data); err != nil {
+		t.Fatalf("scaffoldProposalDocuments error: %v", err)
+	}
+
+	entries, err := os.ReadDir(proposalPath)
+	if err != nil {
+		t.Fatalf("read dir: %v", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		files = append(files, entry.Name())
+	}
+	want := []string{"rollout.md", "specification.md", "testing.md"}
+	if !reflect.DeepEqual(files, want) {
+		t.Fatalf("scaffolded files = %v, want %v", files, want)
+	}
+
+	testingDoc, err := os.ReadFile(filepath.Join(proposalPath, "testing.md"))
+	if err != nil {
+		t.Fatalf("read testing.md: %v", err)
+	}
+	if string(testingDoc) != "# Testing: Feature\n" {
+		t.Errorf("testing.md = %q, want workspace template output", testingDoc)
+	}
+
+	rollout, err := os.ReadFile(filepath.Join(proposalPath, "rollout.md"))
+	if err != nil {
+		t.Fatalf("read rollout.md: %v", err)
+	}
+	if string(rollout) != "# Rollout\n" {
+		t.Errorf("rollout.md = %q, want bare title", rollout)
+	}
+
+	// Hashing and reading follow the configured set
+	hashes, err := computeProposalHashes(proposalPath)
+	if err != nil {
+		t.Fatalf("computeProposalHashes error: %v", err)
+	}
+	if _, ok := hashes["testing.md"]; !ok {
+		t.Errorf("expected testing.md to be hashed, got %v", hashes)
+	}
+	combined, err := readProposalDocs(proposalPath)
+	if err != nil {
+		t.Fatalf("readProposalDocs error: %v", err)
+	}
+	if !strings.Contains(combined, "## Rollout") || strings.Contains(combined, "## Design") {
+		t.Errorf("combined docs do not follow configured set:\n%s", combined)
+	}
+}
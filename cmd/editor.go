@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+
+	"gitlab.com/caffeinatedjack/nocturnal/cmd/tui"
+)
+
+// multiFileEditors are editors known to open several files given as
+// arguments in one invocation.
+var multiFileEditors = map[string]bool{
+	"vim": true, "nvim": true, "vi": true, "gvim": true, "mvim": true,
+	"nano": true, "emacs": true, "hx": true, "helix": true, "kak": true,
+	"micro": true, "code": true, "codium": true, "subl": true,
+}
+
+// resolveEditor returns the editor command for the workspace, preferring
+// flagEditor, then ui.editor from nocturnal.yaml, then $EDITOR.
+func resolveEditor(specPath, flagEditor string) string {
+	return tui.ResolveEditor(flagEditor, loadConfigOrDefault(specPath).UI.Editor)
+}
+
+// editorOpensMultipleFiles reports whether editor can be given several files
+// at once. Unknown editors are opened once per file.
+func editorOpensMultipleFiles(editor string) bool {
+	parts := strings.Fields(editor)
+	if len(parts) == 0 {
+		return false
+	}
+	return multiFileEditors[filepath.Base(parts[0])]
+}
+
+// runEditor opens paths in editor, in one invocation when the editor
+// supports it and one file after another otherwise.
+func runEditor(editor string, paths []string, sequential bool) error {
+	if !sequential && editorOpensMultipleFiles(editor) {
+		debugf("opening %d file(s) in one %s invocation", len(paths), editor)
+		return tui.EditorRun(editor, paths...)
+	}
+	for _, path := range paths {
+		debugf("opening %s in %s", path, editor)
+		if err := tui.EditorRun(editor, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
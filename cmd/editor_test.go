@@ -0,0 +1,78 @@
+package cmd
+
+import "testing"
+
+func TestResolveEditorPrecedence(t *testing.T) {
+	tests := []struct {
+		name         string
+		flagEditor   string
+		configEditor string
+		envEditor    string
+		want         string
+	}{
+		{
+			name:         "flag wins over everything",
+			flagEditor:   "nano",
+			configEditor: "nvim",
+			envEditor:    "emacs",
+			want:         "nano",
+		},
+		{
+			name:         "config wins over env",
+			configEditor: "code --wait",
+			envEditor:    "emacs",
+			want:         "code --wait",
+		},
+		{
+			name:      "env used when nothing configured",
+			envEditor: "emacs",
+			want:      "emacs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			specPath := t.TempDir()
+			t.Setenv("EDITOR", tt.envEditor)
+
+			if tt.configEditor != "" {
+				config := DefaultConfig()
+				config.UI.Editor = tt.configEditor
+				if err := saveConfig(specPath, config); err != nil {
+					t.Fatalf("saveConfig failed: %v", err)
+				}
+			}
+
+			if got := resolveEditor(specPath, tt.flagEditor); got != tt.want {
+				t.Errorf("resolveEditor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveEditorFallback(t *testing.T) {
+	t.Setenv("EDITOR", "")
+
+	if got := resolveEditor(t.TempDir(), ""); got == "" {
+		t.Error("expected a fallback editor, got empty string")
+	}
+}
+
+func TestEditorOpensMultipleFiles(t *testing.T) {
+	tests := []struct {
+		editor string
+		want   bool
+	}{
+		{editor: "vim", want: true},
+		{editor: "/usr/local/bin/nvim", want: true},
+		{editor: "code --wait", want: true},
+		{editor: "ed", want: false},
+		{editor: "", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := editorOpensMultipleFiles(tt.editor); got != tt.want {
+			t.Errorf("editorOpensMultipleFiles(%q) = %v, want %v", tt.editor, got, tt.want)
+		}
+	}
+}
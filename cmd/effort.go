@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	estimatedEffortField = "Estimated effort"
+	actualEffortField    = "Actual effort"
+)
+
+var effortActual bool
+
+var specProposalEffortCmd = &cobra.Command{
+	Use:               "effort <slug> <value>",
+	Short:             "Set a proposal's estimated or actual effort",
+	Args:              cobra.ExactArgs(2),
+	Run:               runSpecProposalEffort,
+	ValidArgsFunction: completeProposalNames,
+}
+
+func init() {
+	specProposalEffortCmd.Long = helpText("spec-proposal-effort")
+	specProposalEffortCmd.Flags().BoolVar(&effortActual, "actual", false, "Set the actual effort instead of the estimate")
+	specProposalCmd.AddCommand(specProposalEffortCmd)
+}
+
+func runSpecProposalEffort(cmd *cobra.Command, args []string) {
+	slug := args[0]
+	value := strings.TrimSpace(args[1])
+
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	proposalPath, err := checkProposal(specPath, slug)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	days, err := parseEffort(value)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	field := estimatedEffortField
+	if effortActual {
+		field = actualEffortField
+	}
+
+	specFile := filepath.Join(proposalPath, "specification.md")
+	content, err := os.ReadFile(specFile)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to read specification.md: %v", err))
+		return
+	}
+
+	updated := setSpecField(string(content), field, formatEffort(days))
+	if err := os.WriteFile(specFile, []byte(updated), 0644); err != nil {
+		printError(fmt.Sprintf("Failed to write specification.md: %v", err))
+		return
+	}
+
+	printSuccess(fmt.Sprintf("Set %s for '%s' to %s", strings.ToLower(field), slug, formatEffort(days)))
+}
+
+// getProposalEffort returns the estimated and actual effort in working days
+// from a proposal's specification.md. Missing or invalid fields are zero.
+func getProposalEffort(proposalPath string) (estimated, actual float64) {
+	content, err := os.ReadFile(filepath.Join(proposalPath, "specification.md"))
+	if err != nil {
+		return 0, 0
+	}
+	estimated, _ = parseEffort(parseSpecField(string(content), estimatedEffortField))
+	actual, _ = parseEffort(parseSpecField(string(content), actualEffortField))
+	return estimated, actual
+}
+
+// parseSpecField extracts the value of a "**Label**:" field from content,
+// ignoring trailing comments. Returns "" if the field is absent or unset.
+func parseSpecField(content, label string) string {
+	boldPrefix := "**" + strings.ToLower(label) + "**:"
+	plainPrefix := strings.ToLower(label) + ":"
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		lower := strings.ToLower(trimmed)
+		if !strings.HasPrefix(lower, boldPrefix) && !strings.HasPrefix(lower, plainPrefix) {
+			continue
+		}
+		value := strings.TrimSpace(trimmed[strings.Index(trimmed, ":")+1:])
+		if commentIdx := strings.Index(value, "<!--"); commentIdx != -1 {
+			value = strings.TrimSpace(value[:commentIdx])
+		}
+		return value
+	}
+	return ""
+}
+
+// setSpecField replaces the "**Label**:" field in content, or inserts it
+// after the last header field (or the title) if it is missing.
+func setSpecField(content, label, value string) string {
+	newLine := fmt.Sprintf("**%s**: %s", label, value)
+	boldPrefix := "**" + strings.ToLower(label) + "**:"
+	plainPrefix := strings.ToLower(label) + ":"
+
+	lines := strings.Split(content, "\n")
+	insertAt := -1
+	for i, line := range lines {
+		lower := strings.ToLower(strings.TrimSpace(line))
+		if strings.HasPrefix(lower, boldPrefix) || strings.HasPrefix(lower, plainPrefix) {
+			lines[i] = newLine
+			return strings.Join(lines, "\n")
+		}
+		if strings.HasPrefix(lower, "## ") {
+			break
+		}
+		if strings.HasPrefix(lower, "# ") || (strings.HasPrefix(lower, "**") && strings.Contains(lower, "**:")) {
+			insertAt = i + 1
+		}
+	}
+
+	if insertAt == -1 {
+		return newLine + "\n\n" + content
+	}
+	if strings.HasPrefix(strings.TrimSpace(lines[insertAt-1]), "# ") {
+		// Directly under the title: keep a blank line between them
+		lines = append(lines[:insertAt], append([]string{"", newLine}, lines[insertAt:]...)...)
+		return strings.Join(lines, "\n")
+	}
+	lines = append(lines[:insertAt], append([]string{newLine}, lines[insertAt:]...)...)
+	return strings.Join(lines, "\n")
+}
+
+// parseEffort parses an effort value such as "4h", "3d", "2w", or "1.5d"
+// into working days. A working day is 8 hours and a week is 5 days.
+func parseEffort(value string) (float64, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return 0, fmt.Errorf("effort must not be empty")
+	}
+
+	units := map[byte]float64{'h': 1.0 / 8, 'd': 1, 'w': 5}
+	multiplier, ok := units[value[len(value)-1]]
+	if !ok {
+		return 0, fmt.Errorf("invalid effort: %s (use e.g. 4h, 3d, 2w)", value)
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSpace(value[:len(value)-1]), 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid effort: %s (use e.g. 4h, 3d, 2w)", value)
+	}
+	return n * multiplier, nil
+}
+
+// formatEffort renders working days in the largest whole unit that fits.
+func formatEffort(days float64) string {
+	switch {
+	case days == 0:
+		return "0d"
+	case days >= 5 && days/5 == float64(int(days/5)):
+		return fmt.Sprintf("%dw", int(days/5))
+	case days >= 1:
+		return strconv.FormatFloat(days, 'f', -1, 64) + "d"
+	default:
+		return strconv.FormatFloat(days*8, 'f', -1, 64) + "h"
+	}
+}
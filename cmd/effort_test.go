@@ -0,0 +1,101 @@
+package cmd
+
+import "testing"
+
+func TestParseEffort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in      string
+		want    float64
+		wantErr bool
+	}{
+		{in: "3d", want: 3},
+		{in: "2w", want: 10},
+		{in: "4h", want: 0.5},
+		{in: "1.5d", want: 1.5},
+		{in: " 2W ", want: 10},
+		{in: "", wantErr: true},
+		{in: "3", wantErr: true},
+		{in: "xd", wantErr: true},
+		{in: "-1d", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseEffort(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("parseEffort(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Fatalf("parseEffort(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFormatEffort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		days float64
+		want string
+	}{
+		{days: 0, want: "0d"},
+		{days: 0.5, want: "4h"},
+		{days: 3, want: "3d"},
+		{days: 7, want: "7d"},
+		{days: 10, want: "2w"},
+	}
+
+	for _, tt := range tests {
+		if got := formatEffort(tt.days); got != tt.want {
+			t.Fatalf("formatEffort(%v) = %q, want %q", tt.days, got, tt.want)
+		}
+	}
+}
+
+func TestParseSpecField(t *testing.T) {
+	t.Parallel()
+
+	content := "# X\n\n**Depends on**: none\n**Estimated effort**: 3d <!-- e.g. 2w -->\n"
+	if got := parseSpecField(content, estimatedEffortField); got != "3d" {
+		t.Fatalf("parseSpecField() = %q, want %q", got, "3d")
+	}
+	if got := parseSpecField(content, actualEffortField); got != "" {
+		t.Fatalf("parseSpecField() = %q, want empty", got)
+	}
+}
+
+func TestSetSpecField(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "replaces_existing",
+			content: "# X\n\n**Estimated effort**: 1d\n\n## Abstract\n",
+			want:    "# X\n\n**Estimated effort**: 2w\n\n## Abstract\n",
+		},
+		{
+			name:    "inserts_after_header_fields",
+			content: "# X\n\n**Depends on**: none\n**Affected files**: a.go\n\n## Abstract\n",
+			want:    "# X\n\n**Depends on**: none\n**Affected files**: a.go\n**Estimated effort**: 2w\n\n## Abstract\n",
+		},
+		{
+			name:    "inserts_under_title",
+			content: "# X\n\n## Abstract\n",
+			want:    "# X\n\n**Estimated effort**: 2w\n\n## Abstract\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := setSpecField(tt.content, estimatedEffortField, "2w"); got != tt.want {
+				t.Fatalf("setSpecField() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,12 +1,20 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
+// errGitNotInstalled is returned by initWorkspaceGit when git is not on PATH.
+var errGitNotInstalled = errors.New("git is not installed")
+
 // GitSnapshotManager handles git commits for task execution
 type GitSnapshotManager struct {
 	specPath     string
@@ -55,6 +63,7 @@ func (g *GitSnapshotManager) CommitChanges(taskText string) error {
 
 // hasUncommittedChanges checks if there are uncommitted changes in the repo
 func (g *GitSnapshotManager) hasUncommittedChanges() (bool, error) {
+	debugf("running git status --porcelain")
 	cmd := exec.Command("git", "status", "--porcelain")
 	output, err := cmd.Output()
 	if err != nil {
@@ -65,6 +74,7 @@ func (g *GitSnapshotManager) hasUncommittedChanges() (bool, error) {
 
 // gitAddAll stages all changes
 func (g *GitSnapshotManager) gitAddAll() error {
+	debugf("running git add -A")
 	cmd := exec.Command("git", "add", "-A")
 	if err := cmd.Run(); err != nil {
 		return err
@@ -74,6 +84,7 @@ func (g *GitSnapshotManager) gitAddAll() error {
 
 // gitCommit creates a commit with the given message
 func (g *GitSnapshotManager) gitCommit(message string) error {
+	debugf("running git commit for task %s", g.taskID)
 	cmd := exec.Command("git", "commit", "-m", message)
 	if err := cmd.Run(); err != nil {
 		return err
@@ -96,3 +107,93 @@ func (g *GitSnapshotManager) generateCommitMessage(taskText string) string {
 Proposal: %s
 Completed: %s`, g.taskID, taskText, g.proposalSlug, timestamp)
 }
+
+// workspaceGitignore lists the generated workspace files that should not be
+// committed, relative to the directory containing the workspace.
+func workspaceGitignore() []string {
+	return []string{
+		filepath.ToSlash(filepath.Join(specDir, workspace.StateFile)),
+		filepath.ToSlash(filepath.Join(specDir, docsDir, docsIndexFile)),
+	}
+}
+
+// gitUserName returns the git user.name configured for the directory
+// containing specPath, or "" if git or the setting is unavailable.
+func gitUserName(specPath string) string {
+	cmd := exec.Command("git", "config", "user.name")
+	cmd.Dir = filepath.Dir(specPath)
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// initWorkspaceGit creates a git repository in the directory containing
+// specPath, ignores the generated workspace files, and commits the scaffold.
+// It returns false without changes if that directory is already inside a
+// repository.
+func initWorkspaceGit(specPath string) (bool, error) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return false, errGitNotInstalled
+	}
+
+	root := filepath.Dir(specPath)
+	git := func(args ...string) error {
+		debugf("running git %s", strings.Join(args, " "))
+		cmd := exec.Command("git", args...)
+		cmd.Dir = root
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("git %s: %s", args[0], strings.TrimSpace(string(output)))
+		}
+		return nil
+	}
+
+	if git("rev-parse", "--is-inside-work-tree") == nil {
+		return false, nil
+	}
+
+	if err := git("init"); err != nil {
+		return false, err
+	}
+	if err := appendGitignore(filepath.Join(root, ".gitignore"), workspaceGitignore()); err != nil {
+		return true, err
+	}
+	if err := git("add", ".gitignore", specDir); err != nil {
+		return true, err
+	}
+	if err := git("commit", "-m", "Initialize nocturnal specification workspace"); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// appendGitignore adds each entry to the .gitignore at path unless it is
+// already listed, creating the file if needed.
+func appendGitignore(path string, entries []string) error {
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read .gitignore: %w", err)
+	}
+
+	listed := make(map[string]bool)
+	for _, line := range strings.Split(string(existing), "\n") {
+		listed[strings.TrimSpace(line)] = true
+	}
+
+	var b strings.Builder
+	b.Write(existing)
+	if len(existing) > 0 && !strings.HasSuffix(string(existing), "\n") {
+		b.WriteString("\n")
+	}
+	for _, entry := range entries {
+		if !listed[entry] {
+			b.WriteString(entry + "\n")
+		}
+	}
+
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write .gitignore: %w", err)
+	}
+	return nil
+}
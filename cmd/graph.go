@@ -1,16 +1,35 @@
 package cmd
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
-var graphFormat string
+var (
+	graphFormat          string
+	graphHighlightCycles bool
+	graphOut             string
+)
+
+// errDotNotInstalled is returned by renderSVGGraph when Graphviz is not on PATH.
+var errDotNotInstalled = errors.New("svg output requires the Graphviz 'dot' command, which is not installed; use --format dot and render it elsewhere")
+
+var specProposalTreeCmd = &cobra.Command{
+	Use:               "tree [slug]",
+	Short:             "Show proposal dependencies as an indented tree",
+	Args:              cobra.MaximumNArgs(1),
+	Run:               runSpecProposalTree,
+	ValidArgsFunction: completeProposalNames,
+}
 
 var specProposalGraphCmd = &cobra.Command{
 	Use:               "graph [slug]",
@@ -22,8 +41,12 @@ var specProposalGraphCmd = &cobra.Command{
 
 func init() {
 	specProposalGraphCmd.Long = helpText("spec-proposal-graph")
-	specProposalGraphCmd.Flags().StringVarP(&graphFormat, "format", "f", "ascii", "Output format: ascii or dot")
+	specProposalTreeCmd.Long = helpText("spec-proposal-tree")
+	specProposalGraphCmd.Flags().StringVarP(&graphFormat, "format", "f", "ascii", "Output format: ascii, tree, dot, mermaid, or svg")
+	specProposalGraphCmd.Flags().BoolVar(&graphHighlightCycles, "highlight-cycles", false, "Color edges that form a dependency cycle red (dot, mermaid, and svg)")
+	specProposalGraphCmd.Flags().StringVarP(&graphOut, "out", "o", "", "Write the graph to this file instead of stdout")
 	specProposalCmd.AddCommand(specProposalGraphCmd)
+	specProposalCmd.AddCommand(specProposalTreeCmd)
 }
 
 // ProposalNode represents a proposal in the dependency graph.
@@ -32,9 +55,20 @@ type ProposalNode struct {
 	Dependencies []string
 	IsCompleted  bool
 	IsActive     bool
+	IsMissing    bool // Referenced as a dependency but matches no proposal or spec
 }
 
 func runSpecProposalGraph(cmd *cobra.Command, args []string) {
+	printProposalGraph(args, graphFormat)
+}
+
+func runSpecProposalTree(cmd *cobra.Command, args []string) {
+	printProposalGraph(args, "tree")
+}
+
+// printProposalGraph renders the dependency graph, or the part of it
+// reachable from the slug in args, in format.
+func printProposalGraph(args []string, format string) {
 	specPath, err := checkSpecWorkspace()
 	if err != nil {
 		printWorkspaceError()
@@ -56,12 +90,18 @@ func runSpecProposalGraph(cmd *cobra.Command, args []string) {
 	var filterSlug string
 	if len(args) > 0 {
 		filterSlug = args[0]
-		if _, exists := nodes[filterSlug]; !exists {
+		if node, exists := nodes[filterSlug]; !exists || node.IsMissing {
 			printError(fmt.Sprintf("Proposal '%s' not found", filterSlug))
 			return
 		}
 	}
 
+	// Report dependencies that match no proposal or completed spec
+	if missing := missingDependencies(nodes); len(missing) > 0 {
+		printWarning(fmt.Sprintf("Unresolved dependencies: %s", strings.Join(missing, ", ")))
+		fmt.Println()
+	}
+
 	// Detect circular dependencies
 	cycles := detectCycles(nodes)
 	if len(cycles) > 0 {
@@ -72,14 +112,81 @@ func runSpecProposalGraph(cmd *cobra.Command, args []string) {
 		fmt.Println()
 	}
 
-	switch graphFormat {
+	var highlight map[graphEdge]bool
+	if graphHighlightCycles {
+		highlight = cycleEdges(cycles)
+	}
+
+	output, err := renderGraph(nodes, filterSlug, format, highlight)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	if graphOut != "" {
+		if err := os.WriteFile(graphOut, []byte(output), 0644); err != nil {
+			printError(fmt.Sprintf("Failed to write graph: %v", err))
+			return
+		}
+		printDim(fmt.Sprintf("Graph written to %s", graphOut))
+		return
+	}
+	fmt.Print(output)
+}
+
+// graphEdge is a dependency edge from a proposal to one of its dependencies.
+type graphEdge struct {
+	From, To string
+}
+
+// cycleEdges returns the set of edges that participate in the given cycles,
+// as reported by detectCycles.
+func cycleEdges(cycles [][]string) map[graphEdge]bool {
+	edges := make(map[graphEdge]bool)
+	for _, cycle := range cycles {
+		for i := 0; i+1 < len(cycle); i++ {
+			edges[graphEdge{From: cycle[i], To: cycle[i+1]}] = true
+		}
+	}
+	return edges
+}
+
+// renderGraph renders the dependency graph in the given format. Edges in
+// highlight are colored red by the dot, mermaid, and svg renderers; it may
+// be nil.
+func renderGraph(nodes map[string]*ProposalNode, filterSlug, format string, highlight map[graphEdge]bool) (string, error) {
+	switch format {
 	case "dot":
-		fmt.Print(renderDotGraph(nodes, filterSlug))
+		return renderDotGraph(nodes, filterSlug, highlight), nil
+	case "mermaid":
+		return renderMermaidGraph(nodes, filterSlug, highlight), nil
+	case "svg":
+		return renderSVGGraph(nodes, filterSlug, highlight)
 	case "ascii":
-		renderAsciiGraph(nodes, filterSlug)
+		return renderAsciiGraph(nodes, filterSlug), nil
+	case "tree":
+		return renderTreeGraph(nodes, filterSlug), nil
 	default:
-		printError(fmt.Sprintf("Unknown format: %s (use 'ascii' or 'dot')", graphFormat))
+		return "", fmt.Errorf("unknown format: %s (use 'ascii', 'tree', 'dot', 'mermaid', or 'svg')", format)
+	}
+}
+
+// renderSVGGraph renders the dot graph to SVG with the Graphviz dot command.
+func renderSVGGraph(nodes map[string]*ProposalNode, filterSlug string, highlight map[graphEdge]bool) (string, error) {
+	dotPath, err := exec.LookPath("dot")
+	if err != nil {
+		return "", errDotNotInstalled
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(dotPath, "-Tsvg")
+	cmd.Stdin = strings.NewReader(renderDotGraph(nodes, filterSlug, highlight))
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("dot failed: %s", strings.TrimSpace(stderr.String()))
 	}
+	return string(output), nil
 }
 
 func buildDependencyGraph(specPath string) (map[string]*ProposalNode, error) {
@@ -118,72 +225,209 @@ func buildDependencyGraph(specPath string) (map[string]*ProposalNode, error) {
 
 		slug := entry.Name()
 		proposalPath := filepath.Join(proposalsPath, slug)
-		deps, _ := getProposalDependencies(proposalPath)
+		deps, _ := workspace.ProposalDependencies(proposalPath)
 
 		nodes[slug] = &ProposalNode{
 			Slug:         slug,
 			Dependencies: deps,
 			IsCompleted:  false,
-			IsActive:     state.isProposalActive(slug),
+			IsActive:     state.IsProposalActive(slug),
 		}
 	}
 
+	addMissingNodes(nodes)
 	return nodes, nil
 }
 
+// addMissingNodes adds a phantom node for every dependency that matches no
+// proposal or completed spec, so renderers can mark it explicitly.
+func addMissingNodes(nodes map[string]*ProposalNode) {
+	var missing []string
+	for _, node := range nodes {
+		for _, dep := range node.Dependencies {
+			if _, exists := nodes[dep]; !exists {
+				missing = append(missing, dep)
+			}
+		}
+	}
+	for _, slug := range missing {
+		nodes[slug] = &ProposalNode{Slug: slug, IsMissing: true}
+	}
+}
+
+// missingDependencies returns the sorted slugs of all phantom nodes.
+func missingDependencies(nodes map[string]*ProposalNode) []string {
+	var missing []string
+	for slug, node := range nodes {
+		if node.IsMissing {
+			missing = append(missing, slug)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// resolveNewDependencies parses a comma-separated dependency list for the
+// proposal slug. It returns the dependencies, those that match no proposal
+// or completed spec, and an error if they would create a cycle.
+func resolveNewDependencies(specPath, slug, value string) (deps, missing []string, err error) {
+	seen := make(map[string]bool)
+	for _, dep := range strings.Split(value, ",") {
+		dep = strings.TrimSpace(dep)
+		if dep == "" || strings.EqualFold(dep, "none") || seen[dep] {
+			continue
+		}
+		seen[dep] = true
+		deps = append(deps, dep)
+	}
+	if len(deps) == 0 {
+		return nil, nil, nil
+	}
+
+	nodes, err := buildDependencyGraph(specPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build dependency graph: %w", err)
+	}
+
+	for _, dep := range deps {
+		if node, exists := nodes[dep]; (!exists || node.IsMissing) && dep != slug {
+			missing = append(missing, dep)
+		}
+	}
+
+	// An existing proposal may already depend on slug, so check the graph
+	// with the new node in place
+	nodes[slug] = &ProposalNode{Slug: slug, Dependencies: deps}
+	for _, cycle := range detectCycles(nodes) {
+		for _, s := range cycle {
+			if s == slug {
+				return nil, nil, fmt.Errorf("dependencies would create a cycle: %s", strings.Join(cycle, " -> "))
+			}
+		}
+	}
+
+	return deps, missing, nil
+}
+
+// detectCycles returns every distinct dependency cycle, including
+// self-dependencies. Each cycle starts and ends at its lexicographically
+// smallest slug (e.g. [a b a]), so rotations of the same cycle are reported once.
 func detectCycles(nodes map[string]*ProposalNode) [][]string {
 	var cycles [][]string
-	visited := make(map[string]bool)
-	recStack := make(map[string]bool)
-	path := []string{}
+	seen := make(map[string]bool)
 
-	var dfs func(slug string) bool
-	dfs = func(slug string) bool {
-		visited[slug] = true
-		recStack[slug] = true
-		path = append(path, slug)
+	slugs := make([]string, 0, len(nodes))
+	for slug := range nodes {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
 
-		node, exists := nodes[slug]
-		if exists {
+	for _, start := range slugs {
+		onPath := map[string]bool{start: true}
+		path := []string{start}
+
+		var dfs func(slug string)
+		dfs = func(slug string) {
+			node, exists := nodes[slug]
+			if !exists {
+				return
+			}
 			for _, dep := range node.Dependencies {
-				if !visited[dep] {
-					if dfs(dep) {
-						return true
-					}
-				} else if recStack[dep] {
-					// Found cycle - extract it
-					cycleStart := -1
-					for i, s := range path {
-						if s == dep {
-							cycleStart = i
-							break
-						}
-					}
-					if cycleStart >= 0 {
-						cycle := append([]string{}, path[cycleStart:]...)
-						cycle = append(cycle, dep)
-						cycles = append(cycles, cycle)
+				if dep == start {
+					cycle := canonicalCycle(path)
+					key := strings.Join(cycle, "->")
+					if !seen[key] {
+						seen[key] = true
+						cycles = append(cycles, append(cycle, cycle[0]))
 					}
-					return true
+					continue
+				}
+				// Cycles through smaller slugs were found from those starts
+				if onPath[dep] || dep < start {
+					continue
+				}
+				onPath[dep] = true
+				path = append(path, dep)
+				dfs(dep)
+				path = path[:len(path)-1]
+				onPath[dep] = false
+			}
+		}
+		dfs(start)
+	}
+
+	return cycles
+}
+
+// dependencyLayers groups the graph's nodes by depth: layer 0 holds nodes
+// without dependencies, and every other node sits one layer above its
+// deepest dependency. Nodes on a cycle, or depending on one, have no layer
+// and are returned sorted in unlayered instead.
+func dependencyLayers(nodes map[string]*ProposalNode) (layers [][]string, unlayered []string) {
+	layerOf := make(map[string]int)
+	for len(layerOf) < len(nodes) {
+		var next []string
+		for slug, node := range nodes {
+			if _, done := layerOf[slug]; done {
+				continue
+			}
+			ready := true
+			for _, dep := range node.Dependencies {
+				if _, done := layerOf[dep]; !done {
+					ready = false
+					break
 				}
 			}
+			if ready {
+				next = append(next, slug)
+			}
+		}
+		if len(next) == 0 {
+			break
 		}
 
-		path = path[:len(path)-1]
-		recStack[slug] = false
-		return false
+		// Assign after scanning so each pass adds whole layers at once
+		for _, slug := range next {
+			layer := 0
+			for _, dep := range nodes[slug].Dependencies {
+				layer = max(layer, layerOf[dep]+1)
+			}
+			layerOf[slug] = layer
+			for len(layers) <= layer {
+				layers = append(layers, nil)
+			}
+			layers[layer] = append(layers[layer], slug)
+		}
 	}
 
+	for _, layer := range layers {
+		sort.Strings(layer)
+	}
 	for slug := range nodes {
-		if !visited[slug] {
-			dfs(slug)
+		if _, done := layerOf[slug]; !done {
+			unlayered = append(unlayered, slug)
 		}
 	}
+	sort.Strings(unlayered)
+	return layers, unlayered
+}
 
-	return cycles
+// canonicalCycle rotates a cycle (without its closing node) so that it
+// begins at its lexicographically smallest slug.
+func canonicalCycle(cycle []string) []string {
+	minIdx := 0
+	for i, slug := range cycle {
+		if slug < cycle[minIdx] {
+			minIdx = i
+		}
+	}
+	rotated := make([]string, 0, len(cycle)+1)
+	rotated = append(rotated, cycle[minIdx:]...)
+	rotated = append(rotated, cycle[:minIdx]...)
+	return rotated
 }
 
-func renderDotGraph(nodes map[string]*ProposalNode, filterSlug string) string {
+func renderDotGraph(nodes map[string]*ProposalNode, filterSlug string, highlight map[graphEdge]bool) string {
 	var buf strings.Builder
 	buf.WriteString("digraph dependencies {\n")
 	buf.WriteString("  rankdir=BT;\n")
@@ -198,7 +442,9 @@ func renderDotGraph(nodes map[string]*ProposalNode, filterSlug string) string {
 	// Define node styles
 	for slug, node := range relevantNodes {
 		var style string
-		if node.IsCompleted {
+		if node.IsMissing {
+			style = "style=dashed,color=red,label=\"" + slug + " (missing)\""
+		} else if node.IsCompleted {
 			style = "style=filled,fillcolor=lightgreen"
 		} else if node.IsActive {
 			style = "style=filled,fillcolor=lightblue"
@@ -213,6 +459,10 @@ func renderDotGraph(nodes map[string]*ProposalNode, filterSlug string) string {
 	// Define edges
 	for slug, node := range relevantNodes {
 		for _, dep := range node.Dependencies {
+			if highlight[graphEdge{From: slug, To: dep}] {
+				buf.WriteString(fmt.Sprintf("  \"%s\" -> \"%s\" [color=red,penwidth=2];\n", slug, dep))
+				continue
+			}
 			buf.WriteString(fmt.Sprintf("  \"%s\" -> \"%s\";\n", slug, dep))
 		}
 	}
@@ -221,17 +471,17 @@ func renderDotGraph(nodes map[string]*ProposalNode, filterSlug string) string {
 	return buf.String()
 }
 
-func renderAsciiGraph(nodes map[string]*ProposalNode, filterSlug string) {
-	fmt.Println()
-	fmt.Println(boldStyle.Render("Dependency Graph"))
-	fmt.Println()
+func renderAsciiGraph(nodes map[string]*ProposalNode, filterSlug string) string {
+	var buf strings.Builder
+	buf.WriteString("\n")
+	buf.WriteString(boldStyle.Render("Dependency Graph") + "\n\n")
 
 	// Legend
-	fmt.Printf("  %s completed  %s active  %s pending\n",
+	buf.WriteString(fmt.Sprintf("  %s completed  %s active  %s pending  %s missing\n\n",
 		successStyle.Render("*"),
 		infoStyle.Render("*"),
-		dimStyle.Render("*"))
-	fmt.Println()
+		dimStyle.Render("*"),
+		errorStyle.Render("*")))
 
 	// Collect relevant nodes
 	relevantNodes := nodes
@@ -260,7 +510,9 @@ func renderAsciiGraph(nodes map[string]*ProposalNode, filterSlug string) {
 
 		// Style the node name
 		var styledName string
-		if node.IsCompleted {
+		if node.IsMissing {
+			styledName = errorStyle.Render(slug + " (missing)")
+		} else if node.IsCompleted {
 			styledName = successStyle.Render(slug)
 		} else if node.IsActive {
 			styledName = infoStyle.Render(slug)
@@ -268,7 +520,7 @@ func renderAsciiGraph(nodes map[string]*ProposalNode, filterSlug string) {
 			styledName = slug
 		}
 
-		fmt.Printf("  %s\n", styledName)
+		buf.WriteString(fmt.Sprintf("  %s\n", styledName))
 
 		// Show dependencies (what this depends on)
 		if len(node.Dependencies) > 0 {
@@ -279,14 +531,14 @@ func renderAsciiGraph(nodes map[string]*ProposalNode, filterSlug string) {
 				}
 				depNode, exists := nodes[dep]
 				var depStatus string
-				if !exists {
+				if !exists || depNode.IsMissing {
 					depStatus = errorStyle.Render("(missing)")
 				} else if depNode.IsCompleted {
 					depStatus = successStyle.Render("(completed)")
 				} else {
 					depStatus = dimStyle.Render("(pending)")
 				}
-				fmt.Printf("    %s depends on: %s %s\n", dimStyle.Render(prefix), dep, depStatus)
+				buf.WriteString(fmt.Sprintf("    %s depends on: %s %s\n", dimStyle.Render(prefix), dep, depStatus))
 			}
 		}
 
@@ -297,12 +549,151 @@ func renderAsciiGraph(nodes map[string]*ProposalNode, filterSlug string) {
 				if i == len(deps)-1 {
 					prefix = "└──"
 				}
-				fmt.Printf("    %s blocks: %s\n", dimStyle.Render(prefix), dep)
+				buf.WriteString(fmt.Sprintf("    %s blocks: %s\n", dimStyle.Render(prefix), dep))
 			}
 		}
 
-		fmt.Println()
+		buf.WriteString("\n")
+	}
+
+	return buf.String()
+}
+
+// renderTreeGraph renders dependencies as an indented tree rooted at
+// filterSlug, or at every node nothing depends on. Nodes already on the
+// current branch are marked as cycles instead of being expanded again.
+func renderTreeGraph(nodes map[string]*ProposalNode, filterSlug string) string {
+	var buf strings.Builder
+
+	var roots []string
+	if filterSlug != "" {
+		roots = []string{filterSlug}
+	} else {
+		hasDependents := make(map[string]bool)
+		for _, node := range nodes {
+			for _, dep := range node.Dependencies {
+				hasDependents[dep] = true
+			}
+		}
+		for slug := range nodes {
+			if !hasDependents[slug] {
+				roots = append(roots, slug)
+			}
+		}
+		// Every node is part of a cycle; start from all of them
+		if len(roots) == 0 {
+			for slug := range nodes {
+				roots = append(roots, slug)
+			}
+		}
+		sort.Strings(roots)
+	}
+
+	label := func(slug string) string {
+		node, exists := nodes[slug]
+		switch {
+		case !exists || node.IsMissing:
+			return errorStyle.Render(slug + " (missing)")
+		case node.IsCompleted:
+			return successStyle.Render(slug) + " " + dimStyle.Render("(completed)")
+		case node.IsActive:
+			return infoStyle.Render(slug) + " " + dimStyle.Render("(active)")
+		default:
+			return slug
+		}
 	}
+
+	onBranch := make(map[string]bool)
+	var walk func(slug, indent string)
+	walk = func(slug, indent string) {
+		node, exists := nodes[slug]
+		if !exists {
+			return
+		}
+		onBranch[slug] = true
+		for i, dep := range node.Dependencies {
+			connector, childIndent := "├── ", indent+"│   "
+			if i == len(node.Dependencies)-1 {
+				connector, childIndent = "└── ", indent+"    "
+			}
+			if onBranch[dep] {
+				buf.WriteString(fmt.Sprintf("%s%s%s %s\n", indent, dimStyle.Render(connector), dep, warningStyle.Render("(cycle)")))
+				continue
+			}
+			buf.WriteString(fmt.Sprintf("%s%s%s\n", indent, dimStyle.Render(connector), label(dep)))
+			walk(dep, childIndent)
+		}
+		onBranch[slug] = false
+	}
+
+	buf.WriteString("\n")
+	for _, root := range roots {
+		buf.WriteString(fmt.Sprintf("  %s\n", label(root)))
+		walk(root, "  ")
+		buf.WriteString("\n")
+	}
+
+	return buf.String()
+}
+
+func renderMermaidGraph(nodes map[string]*ProposalNode, filterSlug string, highlight map[graphEdge]bool) string {
+	var buf strings.Builder
+	buf.WriteString("graph BT\n")
+
+	// Collect relevant nodes
+	relevantNodes := nodes
+	if filterSlug != "" {
+		relevantNodes = getRelevantNodes(nodes, filterSlug)
+	}
+
+	// Sort nodes by name for stable output
+	slugs := make([]string, 0, len(relevantNodes))
+	for slug := range relevantNodes {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	// Define nodes
+	for _, slug := range slugs {
+		node := relevantNodes[slug]
+		if node.IsMissing {
+			buf.WriteString(fmt.Sprintf("  %s[\"%s (missing)\"]\n", mermaidID(slug), slug))
+			buf.WriteString(fmt.Sprintf("  class %s missing\n", mermaidID(slug)))
+			continue
+		}
+		buf.WriteString(fmt.Sprintf("  %s[\"%s\"]\n", mermaidID(slug), slug))
+		if node.IsCompleted {
+			buf.WriteString(fmt.Sprintf("  class %s completed\n", mermaidID(slug)))
+		} else if node.IsActive {
+			buf.WriteString(fmt.Sprintf("  class %s active\n", mermaidID(slug)))
+		}
+	}
+
+	// Define edges, remembering the index of highlighted ones for linkStyle
+	var highlighted []string
+	edgeIndex := 0
+	for _, slug := range slugs {
+		for _, dep := range relevantNodes[slug].Dependencies {
+			buf.WriteString(fmt.Sprintf("  %s --> %s\n", mermaidID(slug), mermaidID(dep)))
+			if highlight[graphEdge{From: slug, To: dep}] {
+				highlighted = append(highlighted, fmt.Sprint(edgeIndex))
+			}
+			edgeIndex++
+		}
+	}
+	if len(highlighted) > 0 {
+		buf.WriteString(fmt.Sprintf("  linkStyle %s stroke:#ff0000,stroke-width:2px\n", strings.Join(highlighted, ",")))
+	}
+
+	buf.WriteString("  classDef completed fill:#90ee90\n")
+	buf.WriteString("  classDef active fill:#add8e6\n")
+	buf.WriteString("  classDef missing stroke:#ff0000,stroke-dasharray:5\n")
+	return buf.String()
+}
+
+// mermaidID converts a slug into a valid Mermaid node identifier.
+func mermaidID(slug string) string {
+	return strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace(slug)
 }
 
 // getRelevantNodes returns nodes related to the given slug (ancestors and descendants).
@@ -1,6 +1,12 @@
 package cmd
 
 import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -64,6 +70,93 @@ func TestDetectCycles(t *testing.T) {
 	}
 }
 
+func TestDetectCyclesDistinct(t *testing.T) {
+	tests := []struct {
+		name  string
+		nodes map[string]*ProposalNode
+		want  [][]string
+	}{
+		{
+			name: "two node cycle reported once",
+			nodes: map[string]*ProposalNode{
+				"a": {Slug: "a", Dependencies: []string{"b"}},
+				"b": {Slug: "b", Dependencies: []string{"a"}},
+			},
+			want: [][]string{{"a", "b", "a"}},
+		},
+		{
+			name: "rotation canonicalized to smallest slug",
+			nodes: map[string]*ProposalNode{
+				"c": {Slug: "c", Dependencies: []string{"a"}},
+				"a": {Slug: "a", Dependencies: []string{"b"}},
+				"b": {Slug: "b", Dependencies: []string{"c"}},
+			},
+			want: [][]string{{"a", "b", "c", "a"}},
+		},
+		{
+			name: "self loop",
+			nodes: map[string]*ProposalNode{
+				"a": {Slug: "a", Dependencies: []string{"a"}},
+			},
+			want: [][]string{{"a", "a"}},
+		},
+		{
+			name: "overlapping cycles both reported",
+			nodes: map[string]*ProposalNode{
+				"a": {Slug: "a", Dependencies: []string{"b"}},
+				"b": {Slug: "b", Dependencies: []string{"a", "c"}},
+				"c": {Slug: "c", Dependencies: []string{"b"}},
+			},
+			want: [][]string{{"a", "b", "a"}, {"b", "c", "b"}},
+		},
+		{
+			name: "duplicate dependency entries",
+			nodes: map[string]*ProposalNode{
+				"a": {Slug: "a", Dependencies: []string{"b", "b"}},
+				"b": {Slug: "b", Dependencies: []string{"a"}},
+			},
+			want: [][]string{{"a", "b", "a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := detectCycles(tt.nodes)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("detectCycles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDependencyLayers(t *testing.T) {
+	nodes := map[string]*ProposalNode{
+		"storage":  {Slug: "storage", IsCompleted: true},
+		"logging":  {Slug: "logging"},
+		"sessions": {Slug: "sessions", Dependencies: []string{"storage"}},
+		"oauth":    {Slug: "oauth", Dependencies: []string{"sessions", "logging"}},
+		"audit":    {Slug: "audit", Dependencies: []string{"storage", "oauth"}},
+		// A cycle, and a proposal that depends on it
+		"a":       {Slug: "a", Dependencies: []string{"b"}},
+		"b":       {Slug: "b", Dependencies: []string{"a"}},
+		"reports": {Slug: "reports", Dependencies: []string{"a", "storage"}},
+	}
+
+	layers, unlayered := dependencyLayers(nodes)
+	want := [][]string{
+		{"logging", "storage"},
+		{"sessions"},
+		{"oauth"},
+		{"audit"},
+	}
+	if !reflect.DeepEqual(layers, want) {
+		t.Errorf("layers = %v, want %v", layers, want)
+	}
+	if want := []string{"a", "b", "reports"}; !reflect.DeepEqual(unlayered, want) {
+		t.Errorf("unlayered = %v, want %v", unlayered, want)
+	}
+}
+
 func TestGetRelevantNodes(t *testing.T) {
 	nodes := map[string]*ProposalNode{
 		"a": {Slug: "a", Dependencies: []string{"b"}},
@@ -92,3 +185,221 @@ func TestGetRelevantNodes(t *testing.T) {
 		t.Error("expected 'e' NOT to be in relevant nodes (unrelated)")
 	}
 }
+
+func TestRenderMermaidGraph(t *testing.T) {
+	nodes := map[string]*ProposalNode{
+		"user-auth": {Slug: "user-auth", Dependencies: []string{"db"}, IsActive: true},
+		"db":        {Slug: "db", IsCompleted: true},
+	}
+
+	got := renderMermaidGraph(nodes, "", nil)
+
+	for _, want := range []string{
+		"graph BT\n",
+		"  user_auth[\"user-auth\"]\n",
+		"  class user_auth active\n",
+		"  class db completed\n",
+		"  user_auth --> db\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("renderMermaidGraph() missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestRenderGraphHighlightCycles(t *testing.T) {
+	nodes := map[string]*ProposalNode{
+		"api":  {Slug: "api", Dependencies: []string{"auth", "db"}},
+		"auth": {Slug: "auth", Dependencies: []string{"api"}},
+		"db":   {Slug: "db"},
+	}
+	highlight := cycleEdges(detectCycles(nodes))
+
+	dot := renderDotGraph(nodes, "", highlight)
+	for _, want := range []string{
+		"  \"api\" -> \"auth\" [color=red,penwidth=2];\n",
+		"  \"auth\" -> \"api\" [color=red,penwidth=2];\n",
+		"  \"api\" -> \"db\";\n",
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("renderDotGraph() missing %q in:\n%s", want, dot)
+		}
+	}
+
+	// Edges are emitted in slug order: api->auth (0), api->db (1), auth->api (2)
+	mermaid := renderMermaidGraph(nodes, "", highlight)
+	if want := "  linkStyle 0,2 stroke:#ff0000,stroke-width:2px\n"; !strings.Contains(mermaid, want) {
+		t.Errorf("renderMermaidGraph() missing %q in:\n%s", want, mermaid)
+	}
+
+	if plain := renderMermaidGraph(nodes, "", nil); strings.Contains(plain, "linkStyle") {
+		t.Errorf("expected no linkStyle without highlighting:\n%s", plain)
+	}
+}
+
+func TestRenderGraphUnknownFormat(t *testing.T) {
+	if _, err := renderGraph(map[string]*ProposalNode{}, "", "bogus", nil); err == nil {
+		t.Fatal("expected error for unknown format")
+	}
+}
+
+func TestRenderGraphSVG(t *testing.T) {
+	if _, err := exec.LookPath("dot"); err != nil {
+		t.Skip("graphviz dot not installed")
+	}
+	nodes := map[string]*ProposalNode{
+		"user-auth": {Slug: "user-auth", Dependencies: []string{"db"}},
+		"db":        {Slug: "db", IsCompleted: true},
+	}
+
+	got, err := renderGraph(nodes, "", "svg", nil)
+	if err != nil {
+		t.Fatalf("renderGraph: %v", err)
+	}
+	for _, want := range []string{"<svg", "user-auth", "</svg>"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("svg output missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestRenderGraphSVGWithoutDot(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	_, err := renderGraph(map[string]*ProposalNode{}, "", "svg", nil)
+	if !errors.Is(err, errDotNotInstalled) {
+		t.Fatalf("err = %v, want errDotNotInstalled", err)
+	}
+}
+
+func TestBuildDependencyGraphMissingDependency(t *testing.T) {
+	specPath := t.TempDir()
+	proposalPath := filepath.Join(specPath, proposalDir, "feature")
+	if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	spec := "# Feature\n\n**Depends on**: ghost\n"
+	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte(spec), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	nodes, err := buildDependencyGraph(specPath)
+	if err != nil {
+		t.Fatalf("buildDependencyGraph error: %v", err)
+	}
+
+	ghost, ok := nodes["ghost"]
+	if !ok {
+		t.Fatal("expected phantom node for unresolved dependency 'ghost'")
+	}
+	if !ghost.IsMissing {
+		t.Error("expected 'ghost' to be marked missing")
+	}
+	if nodes["feature"].IsMissing {
+		t.Error("expected 'feature' not to be marked missing")
+	}
+
+	if got := missingDependencies(nodes); !reflect.DeepEqual(got, []string{"ghost"}) {
+		t.Errorf("missingDependencies() = %v, want [ghost]", got)
+	}
+
+	if dot := renderDotGraph(nodes, "", nil); !strings.Contains(dot, "ghost (missing)") {
+		t.Errorf("dot output does not mark missing node:\n%s", dot)
+	}
+	if mermaid := renderMermaidGraph(nodes, "", nil); !strings.Contains(mermaid, "class ghost missing") {
+		t.Errorf("mermaid output does not mark missing node:\n%s", mermaid)
+	}
+}
+
+func TestRenderTreeGraph(t *testing.T) {
+	nodes := map[string]*ProposalNode{
+		"app":  {Slug: "app", Dependencies: []string{"api", "ui"}},
+		"api":  {Slug: "api", Dependencies: []string{"db"}},
+		"ui":   {Slug: "ui", Dependencies: []string{}},
+		"db":   {Slug: "db", Dependencies: []string{"api"}},
+		"solo": {Slug: "solo"},
+	}
+
+	got := renderTreeGraph(nodes, "")
+
+	for _, want := range []string{
+		"  app\n",
+		"  ├── api\n",
+		"  │   └── db\n",
+		"  │       └── api",
+		"(cycle)",
+		"  └── ui\n",
+		"  solo\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("renderTreeGraph() missing %q in:\n%s", want, got)
+		}
+	}
+
+	// Rooted at a single proposal
+	if rooted := renderTreeGraph(nodes, "api"); strings.Contains(rooted, "app") {
+		t.Errorf("expected tree rooted at api not to include app:\n%s", rooted)
+	}
+}
+
+func TestResolveNewDependencies(t *testing.T) {
+	specPath := t.TempDir()
+	for slug, dependsOn := range map[string]string{"base": "none", "child": "ghost"} {
+		proposalPath := filepath.Join(specPath, proposalDir, slug)
+		if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		spec := "# X\n\n**Depends on**: " + dependsOn + "\n"
+		if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte(spec), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		slug        string
+		value       string
+		wantDeps    []string
+		wantMissing []string
+		wantErr     bool
+	}{
+		{name: "empty", slug: "new", value: ""},
+		{name: "none", slug: "new", value: "none"},
+		{name: "existing", slug: "new", value: "base, base ,child", wantDeps: []string{"base", "child"}},
+		{name: "unresolved", slug: "new", value: "base,nope", wantDeps: []string{"base", "nope"}, wantMissing: []string{"nope"}},
+		{name: "self", slug: "new", value: "new", wantErr: true},
+		{name: "cycle through existing dependent", slug: "ghost", value: "child", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps, missing, err := resolveNewDependencies(specPath, tt.slug, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveNewDependencies error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(deps, tt.wantDeps) {
+				t.Errorf("deps = %v, want %v", deps, tt.wantDeps)
+			}
+			if !reflect.DeepEqual(missing, tt.wantMissing) {
+				t.Errorf("missing = %v, want %v", missing, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func TestProposalTreeLeavesGraphFormat(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	runSpecProposalAdd(specProposalAddCmd, []string{"feature"})
+
+	out := captureStdout(t, func() { runSpecProposalTree(specProposalTreeCmd, nil) })
+	if !strings.Contains(out, "feature") {
+		t.Errorf("tree output missing the proposal:\n%s", out)
+	}
+	if graphFormat != "ascii" {
+		t.Errorf("graphFormat = %q after 'spec proposal tree', want the flag default", graphFormat)
+	}
+}
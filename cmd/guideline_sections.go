@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// documentGuidelines maps documents to the guideline file whose "Validated
+// Sections" block lists the sections they must contain.
+var documentGuidelines = map[string]string{
+	"specification.md": "specification guidelines.md",
+	"design.md":        "design guidelines.md",
+}
+
+// loadGuidelineSections returns the sections checked in document, read from
+// the workspace's guideline file. The built-in defaults are used when the
+// guideline file is missing or has no parseable "Validated Sections" block.
+func loadGuidelineSections(specPath, document string) []guidelineSection {
+	defaults := guidelineSectionsByDocument[document]
+	filename, ok := documentGuidelines[document]
+	if !ok {
+		return defaults
+	}
+
+	content, err := os.ReadFile(filepath.Join(specPath, filename))
+	if err != nil {
+		return defaults
+	}
+	sections, ok := parseGuidelineSections(string(content))
+	if !ok {
+		debugf("no validated sections in %s, using defaults", filename)
+		return defaults
+	}
+	return sections
+}
+
+// parseGuidelineSections reads the "Validated Sections" block of a guideline
+// file. Items are "- Name: hint" list entries under a "Required" or
+// "Recommended" subheading; the hint is optional. It reports false when the
+// block is absent or lists no sections.
+func parseGuidelineSections(content string) ([]guidelineSection, bool) {
+	var sections []guidelineSection
+	blockLevel := 0
+	inList := false
+	required := false
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "#") {
+			level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+			title := headerTitle(trimmed)
+			switch {
+			case blockLevel == 0:
+				if strings.EqualFold(title, "Validated Sections") {
+					blockLevel = level
+				}
+			case level <= blockLevel:
+				return sections, len(sections) > 0
+			case strings.EqualFold(title, "Required"):
+				inList, required = true, true
+			case strings.EqualFold(title, "Recommended"):
+				inList, required = true, false
+			default:
+				inList = false
+			}
+			continue
+		}
+		if blockLevel == 0 || !inList {
+			continue
+		}
+
+		item, ok := strings.CutPrefix(trimmed, "- ")
+		if !ok {
+			item, ok = strings.CutPrefix(trimmed, "* ")
+		}
+		if !ok {
+			continue
+		}
+		name, hint, _ := strings.Cut(item, ":")
+		name, hint = strings.TrimSpace(name), strings.TrimSpace(hint)
+		if name == "" {
+			continue
+		}
+		if hint == "" {
+			hint = fmt.Sprintf("Add a %s section", name)
+		}
+		sections = append(sections, guidelineSection{Name: name, Hint: hint, Required: required})
+	}
+	return sections, len(sections) > 0
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseGuidelineSections(t *testing.T) {
+	content := "# Guidelines\n\n### 1. **Introduction** (Required)\n\n- Not a validated item\n\n" +
+		"## Validated Sections\n\nIntro text.\n\n### Required\n\n- Abstract: Summarise it\n* Rollout Plan\n\n" +
+		"### Notes\n\n- Ignored: not a list we read\n\n### Recommended\n\n- Examples: Show usage\n\n" +
+		"## Writing Style\n\n- Be brief\n"
+
+	got, ok := parseGuidelineSections(content)
+	if !ok {
+		t.Fatal("parseGuidelineSections reported no sections")
+	}
+	want := []guidelineSection{
+		{Name: "Abstract", Hint: "Summarise it", Required: true},
+		{Name: "Rollout Plan", Hint: "Add a Rollout Plan section", Required: true},
+		{Name: "Examples", Hint: "Show usage", Required: false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sections = %+v, want %+v", got, want)
+	}
+
+	for _, content := range []string{"# Guidelines\n\n- Abstract: no block\n", "## Validated Sections\n\n### Required\n\n"} {
+		if sections, ok := parseGuidelineSections(content); ok {
+			t.Errorf("parseGuidelineSections(%q) = %+v, want not ok", content, sections)
+		}
+	}
+}
+
+func TestShippedGuidelinesMatchDefaults(t *testing.T) {
+	for document, filename := range documentGuidelines {
+		content, err := readTemplate("templates/" + filename)
+		if err != nil {
+			t.Fatalf("readTemplate(%s): %v", filename, err)
+		}
+		got, ok := parseGuidelineSections(content)
+		if !ok {
+			t.Fatalf("%s has no validated sections", filename)
+		}
+		if want := guidelineSectionsByDocument[document]; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s sections = %+v, want the defaults %+v", filename, got, want)
+		}
+	}
+}
+
+func TestCustomGuidelineSectionIsEnforced(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+	proposalPath := filepath.Join(specPath, proposalDir, "oauth-login")
+
+	specErrors := func() string {
+		for _, result := range validateProposalDocuments(specPath, proposalPath) {
+			if result.Document == "specification.md" {
+				return strings.Join(result.Errors, "\n")
+			}
+		}
+		return ""
+	}
+
+	// Without guideline files the defaults apply
+	if errs := specErrors(); strings.Contains(errs, "Rollout Plan") {
+		t.Fatalf("unexpected Rollout Plan error with default guidelines:\n%s", errs)
+	}
+
+	guidelines := "# Specification Guidelines\n\n## Validated Sections\n\n### Required\n\n" +
+		"- Introduction: Explain the context\n- Rollout Plan: Describe how the change ships\n"
+	if err := os.WriteFile(filepath.Join(specPath, "specification guidelines.md"), []byte(guidelines), 0o644); err != nil {
+		t.Fatalf("write guidelines: %v", err)
+	}
+
+	errs := specErrors()
+	if !strings.Contains(errs, "Missing required section: Rollout Plan - Describe how the change ships") {
+		t.Errorf("custom required section not enforced, got errors:\n%s", errs)
+	}
+	if strings.Contains(errs, "Missing required section: Abstract") {
+		t.Errorf("default section still enforced after guidelines replaced the list:\n%s", errs)
+	}
+}
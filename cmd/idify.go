@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var specProposalIdifyCmd = &cobra.Command{
+	Use:               "idify <slug>",
+	Short:             "Assign stable {#id} markers to implementation tasks",
+	Args:              cobra.ExactArgs(1),
+	Run:               runSpecProposalIdify,
+	ValidArgsFunction: completeProposalNames,
+}
+
+func init() {
+	specProposalIdifyCmd.Long = helpText("spec-proposal-idify")
+	specProposalCmd.AddCommand(specProposalIdifyCmd)
+}
+
+// maxTaskIDWords caps how many words of a task's text go into its id.
+const maxTaskIDWords = 5
+
+// taskIDAssignment is an id given to an unmarked task by idify.
+type taskIDAssignment struct {
+	Line int
+	ID   string
+	Text string
+}
+
+func runSpecProposalIdify(cmd *cobra.Command, args []string) {
+	slug := args[0]
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	proposalPath, err := checkProposal(specPath, slug)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	implPath := filepath.Join(proposalPath, "implementation.md")
+	content, err := os.ReadFile(implPath)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to read implementation.md: %v", err))
+		return
+	}
+
+	updated, assigned := idifyImplementation(string(content))
+	if len(assigned) == 0 {
+		printDim(fmt.Sprintf("Every task in '%s' already has an id", slug))
+		return
+	}
+
+	if err := os.WriteFile(implPath, []byte(updated), 0644); err != nil {
+		printError(fmt.Sprintf("Failed to write implementation.md: %v", err))
+		return
+	}
+
+	printSuccess(fmt.Sprintf("Assigned ids to %d task(s) in '%s'", len(assigned), slug))
+	for _, a := range assigned {
+		fmt.Printf("  %s %s %s\n", dimStyle.Render(fmt.Sprintf("line %d:", a.Line)), infoStyle.Render("{#"+a.ID+"}"), a.Text)
+	}
+}
+
+// idifyImplementation adds a {#id} marker to every task that has none, or
+// whose marker is empty, and returns the updated content with the ids
+// assigned. Ids are derived from the task text and never reuse an id already
+// in the document, so running it again changes nothing.
+func idifyImplementation(content string) (string, []taskIDAssignment) {
+	phases := extractPhases(content)
+
+	used := make(map[string]bool)
+	for _, phase := range phases {
+		for _, task := range phase.Tasks {
+			if id, _ := parseTaskMarker(task.Text); id != "" {
+				used[id] = true
+			}
+		}
+	}
+
+	lines := strings.Split(content, "\n")
+	var assigned []taskIDAssignment
+	for _, phase := range phases {
+		for _, task := range phase.Tasks {
+			id, text := parseTaskMarker(task.Text)
+			if id != "" {
+				continue
+			}
+
+			id = uniqueTaskID(text, used)
+			used[id] = true
+			marker := "{#" + id + "}"
+
+			line := lines[task.Line-1]
+			if loc := taskMarkerPattern.FindStringIndex(line); loc != nil {
+				line = line[:loc[0]] + marker + line[loc[1]:]
+			} else {
+				line = strings.TrimRight(line, " \t") + " " + marker
+			}
+			lines[task.Line-1] = line
+
+			assigned = append(assigned, taskIDAssignment{Line: task.Line, ID: id, Text: text})
+		}
+	}
+
+	return strings.Join(lines, "\n"), assigned
+}
+
+// uniqueTaskID builds an id from the first words of text, adding a numeric
+// suffix if the id is already used.
+func uniqueTaskID(text string, used map[string]bool) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	})
+	if len(words) > maxTaskIDWords {
+		words = words[:maxTaskIDWords]
+	}
+	base := strings.Join(words, "-")
+	if base == "" {
+		base = "task"
+	}
+
+	id := base
+	for n := 2; used[id]; n++ {
+		id = fmt.Sprintf("%s-%d", base, n)
+	}
+	return id
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIdifyImplementation(t *testing.T) {
+	content := `# Implementation
+
+### Phase 1: Setup
+
+- [x] Add config.yaml loader {#add-config}
+- [ ] Add config
+- [ ] Add config
+  - [ ] Write the migration for the users table now
+- [ ] {#}
+
+### Phase 2: Wire up
+
+- [ ] Empty marker {#} here
+`
+	want := `# Implementation
+
+### Phase 1: Setup
+
+- [x] Add config.yaml loader {#add-config}
+- [ ] Add config {#add-config-2}
+- [ ] Add config {#add-config-3}
+  - [ ] Write the migration for the users table now {#write-the-migration-for-the}
+- [ ] {#task}
+
+### Phase 2: Wire up
+
+- [ ] Empty marker {#empty-marker-here} here
+`
+
+	got, assigned := idifyImplementation(content)
+	if got != want {
+		t.Errorf("idifyImplementation =\n%s\nwant\n%s", got, want)
+	}
+
+	wantAssigned := []taskIDAssignment{
+		{Line: 6, ID: "add-config-2", Text: "Add config"},
+		{Line: 7, ID: "add-config-3", Text: "Add config"},
+		{Line: 8, ID: "write-the-migration-for-the", Text: "Write the migration for the users table now"},
+		{Line: 9, ID: "task", Text: ""},
+		{Line: 13, ID: "empty-marker-here", Text: "Empty marker here"},
+	}
+	if !reflect.DeepEqual(assigned, wantAssigned) {
+		t.Errorf("assigned = %+v, want %+v", assigned, wantAssigned)
+	}
+
+	// Every id is unique, so lint finds no duplicates
+	for _, problem := range lintImplementation(got) {
+		if strings.Contains(problem.Message, "id") {
+			t.Errorf("lint problem after idify: line %d: %s", problem.Line, problem.Message)
+		}
+	}
+
+	// Running again is a no-op
+	again, assigned := idifyImplementation(got)
+	if again != got || len(assigned) != 0 {
+		t.Errorf("second run changed %d task(s):\n%s", len(assigned), again)
+	}
+}
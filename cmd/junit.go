@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// junitTestSuites is the root element of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite holds the results of one proposal.
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase holds the results of one proposal document.
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+// junitFailure lists a document's validation errors.
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// buildJUnitReport converts validation results into a JUnit report with one
+// testsuite per proposal and one testcase per document. Errors become
+// failures; warnings and waiver notes are kept as system-out.
+func buildJUnitReport(reports []ProposalValidation) junitTestSuites {
+	root := junitTestSuites{Name: "nocturnal"}
+
+	for _, report := range reports {
+		suite := junitTestSuite{Name: report.Slug}
+		for _, result := range report.Results {
+			tc := junitTestCase{Name: result.Document, ClassName: report.Slug}
+			if len(result.Errors) > 0 {
+				tc.Failure = &junitFailure{
+					Message: fmt.Sprintf("%d validation error(s)", len(result.Errors)),
+					Type:    "error",
+					Text:    strings.Join(result.Errors, "\n"),
+				}
+				suite.Failures++
+			}
+			var out []string
+			for _, warn := range result.Warnings {
+				out = append(out, "Warning: "+warn)
+			}
+			for _, note := range result.Notes {
+				out = append(out, "Note: "+note)
+			}
+			tc.SystemOut = strings.Join(out, "\n")
+			suite.Cases = append(suite.Cases, tc)
+			suite.Tests++
+		}
+		root.Suites = append(root.Suites, suite)
+		root.Tests += suite.Tests
+		root.Failures += suite.Failures
+	}
+
+	return root
+}
+
+// writeJUnitReport writes validation results to path as JUnit XML, creating
+// the parent directory if needed.
+func writeJUnitReport(path string, reports []ProposalValidation) error {
+	data, err := xml.MarshalIndent(buildJUnitReport(reports), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize JUnit report: %w", err)
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create report directory: %w", err)
+		}
+	}
+
+	content := xml.Header + string(data) + "\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+	return nil
+}
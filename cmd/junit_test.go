@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteJUnitReport(t *testing.T) {
+	reports := []ProposalValidation{
+		{Slug: "oauth-login", Results: []ValidationResult{
+			{Document: "specification.md", Errors: []string{"Missing required section: Abstract - Add a summary", "Missing required section: Introduction - Add context"}},
+			{Document: "design.md", Warnings: []string{"Missing recommended section: Open Questions - List items"}},
+		}},
+		{Slug: "sso", Results: []ValidationResult{
+			{Document: "specification.md"},
+		}},
+	}
+
+	path := filepath.Join(t.TempDir(), "reports", "spec.xml")
+	if err := writeJUnitReport(path, reports); err != nil {
+		t.Fatalf("writeJUnitReport error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	xml := string(data)
+
+	for _, want := range []string{
+		`<testsuites name="nocturnal" tests="3" failures="1">`,
+		`<testsuite name="oauth-login" tests="2" failures="1">`,
+		`<testcase name="specification.md" classname="oauth-login">`,
+		`<failure message="2 validation error(s)" type="error">Missing required section: Abstract - Add a summary&#xA;Missing required section: Introduction - Add context</failure>`,
+		`<system-out>Warning: Missing recommended section: Open Questions - List items</system-out>`,
+		`<testsuite name="sso" tests="1" failures="0">`,
+	} {
+		if !strings.Contains(xml, want) {
+			t.Errorf("report missing %s:\n%s", want, xml)
+		}
+	}
+	if strings.Count(xml, "<failure") != 1 {
+		t.Errorf("expected exactly one failure node:\n%s", xml)
+	}
+}
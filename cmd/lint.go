@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var specProposalLintCmd = &cobra.Command{
+	Use:               "lint <slug>",
+	Short:             "Check implementation.md for task-level problems",
+	Args:              cobra.ExactArgs(1),
+	Run:               runSpecProposalLint,
+	ValidArgsFunction: completeProposalNames,
+}
+
+func init() {
+	specProposalLintCmd.Long = helpText("spec-proposal-lint")
+	specProposalCmd.AddCommand(specProposalLintCmd)
+}
+
+// taskMarkerPattern matches an explicit task id marker such as {#add-login}.
+var taskMarkerPattern = regexp.MustCompile(`\{#([^}\s]*)\}`)
+
+// LintProblem is a single task hygiene issue in implementation.md.
+type LintProblem struct {
+	Line    int
+	Message string
+}
+
+func runSpecProposalLint(cmd *cobra.Command, args []string) {
+	slug := args[0]
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	proposalPath, err := checkProposal(specPath, slug)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	content, err := os.ReadFile(filepath.Join(proposalPath, "implementation.md"))
+	if err != nil {
+		printError(fmt.Sprintf("Failed to read implementation.md: %v", err))
+		os.Exit(1)
+	}
+
+	problems := lintImplementation(string(content))
+	if len(problems) == 0 {
+		printSuccess(fmt.Sprintf("No task problems in '%s'", slug))
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(errorStyle.Render(fmt.Sprintf("✗ implementation.md (%d problem(s))", len(problems))))
+	for _, problem := range problems {
+		fmt.Printf("  %s %s\n", dimStyle.Render(fmt.Sprintf("line %d:", problem.Line)), problem.Message)
+	}
+	fmt.Println()
+	os.Exit(1)
+}
+
+// lintImplementation reports phases without tasks, empty tasks, and task id
+// markers ({#id}) that are blank or used more than once.
+func lintImplementation(content string) []LintProblem {
+	var problems []LintProblem
+	seen := make(map[string]int)
+
+	for _, phase := range extractPhases(content) {
+		if len(phase.Tasks) == 0 {
+			problems = append(problems, LintProblem{
+				Line:    phase.Line,
+				Message: fmt.Sprintf("phase '%s' has no tasks", phase.Name),
+			})
+			continue
+		}
+
+		for _, task := range phase.Tasks {
+			id, text := parseTaskMarker(task.Text)
+			if text == "" {
+				problems = append(problems, LintProblem{Line: task.Line, Message: fmt.Sprintf("task %s is empty", task.ID)})
+			}
+			if !taskMarkerPattern.MatchString(task.Text) {
+				continue
+			}
+			if id == "" {
+				problems = append(problems, LintProblem{Line: task.Line, Message: fmt.Sprintf("task %s has an empty id marker", task.ID)})
+				continue
+			}
+			if first, ok := seen[id]; ok {
+				problems = append(problems, LintProblem{
+					Line:    task.Line,
+					Message: fmt.Sprintf("duplicate task id '%s' (first used on line %d)", id, first),
+				})
+				continue
+			}
+			seen[id] = task.Line
+		}
+	}
+
+	return problems
+}
+
+// parseTaskMarker splits a task's text into its {#id} marker, if any, and the
+// remaining text.
+func parseTaskMarker(text string) (id, rest string) {
+	match := taskMarkerPattern.FindStringSubmatchIndex(text)
+	if match == nil {
+		return "", strings.TrimSpace(text)
+	}
+	id = text[match[2]:match[3]]
+	rest = strings.Join(strings.Fields(text[:match[0]]+" "+text[match[1]:]), " ")
+	return id, rest
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLintImplementation(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []LintProblem
+	}{
+		{
+			name: "clean",
+			content: `### Phase 1: Setup
+
+- [x] Create package {#create-package}
+- [ ] Add loader
+`,
+			want: nil,
+		},
+		{
+			name: "duplicate ids",
+			content: `### Phase 1: Setup
+
+- [ ] Create package {#setup}
+- [ ] Add loader {#setup}
+
+### Phase 2: Wire up
+
+- [ ] Register command {#setup}
+`,
+			want: []LintProblem{
+				{Line: 4, Message: "duplicate task id 'setup' (first used on line 3)"},
+				{Line: 8, Message: "duplicate task id 'setup' (first used on line 3)"},
+			},
+		},
+		{
+			name: "empty phase",
+			content: `### Phase 1: Setup
+
+**Goal**: Nothing yet
+
+### Phase 2: Build
+
+- [ ] Build it
+`,
+			want: []LintProblem{
+				{Line: 1, Message: "phase 'Setup' has no tasks"},
+			},
+		},
+		{
+			name: "empty tasks",
+			content: `### Phase 1: Setup
+
+- [ ]
+- [ ] {#only-id}
+- [ ] Something {#}
+`,
+			want: []LintProblem{
+				{Line: 3, Message: "task 1.1 is empty"},
+				{Line: 4, Message: "task 1.2 is empty"},
+				{Line: 5, Message: "task 1.3 has an empty id marker"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := lintImplementation(tt.content)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("lintImplementation() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTaskMarker(t *testing.T) {
+	id, rest := parseTaskMarker("Add login {#add-login} form")
+	if id != "add-login" || rest != "Add login form" {
+		t.Errorf("parseTaskMarker = (%q, %q), want (add-login, Add login form)", id, rest)
+	}
+
+	id, rest = parseTaskMarker("  Plain task ")
+	if id != "" || rest != "Plain task" {
+		t.Errorf("parseTaskMarker = (%q, %q), want (\"\", Plain task)", id, rest)
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
 var maintenanceCmd = &cobra.Command{
@@ -16,6 +18,17 @@ var maintenanceCmd = &cobra.Command{
 	Short: "Manage maintenance requirements",
 }
 
+var (
+	maintenanceAddFreq string
+	maintenanceAddFrom string
+
+	maintenanceListFormat string
+
+	maintenanceDueSince    string
+	maintenanceDueAll      bool
+	maintenanceDueExitCode bool
+)
+
 var maintenanceAddCmd = &cobra.Command{
 	Use:   "add <name-or-slug>",
 	Short: "Create a new maintenance item",
@@ -30,31 +43,40 @@ var maintenanceListCmd = &cobra.Command{
 }
 
 var maintenanceShowCmd = &cobra.Command{
-	Use:   "show <slug>",
-	Short: "Show a maintenance item",
-	Args:  cobra.ExactArgs(1),
-	Run:   runMaintenanceShow,
+	Use:               "show <slug>",
+	Short:             "Show a maintenance item",
+	Args:              cobra.ExactArgs(1),
+	Run:               runMaintenanceShow,
+	ValidArgsFunction: completeMaintenanceNames,
 }
 
 var maintenanceDueCmd = &cobra.Command{
-	Use:   "due <slug>",
-	Short: "Show due requirements for a maintenance item",
-	Args:  cobra.ExactArgs(1),
-	Run:   runMaintenanceDue,
+	Use:               "due [slug]",
+	Short:             "Show due requirements for a maintenance item",
+	Args:              cobra.MaximumNArgs(1),
+	Run:               runMaintenanceDue,
+	ValidArgsFunction: completeMaintenanceNames,
 }
 
+var (
+	maintenanceActionedAllDue bool
+	maintenanceActionedNote   string
+)
+
 var maintenanceActionedCmd = &cobra.Command{
-	Use:   "actioned <slug> <id>",
-	Short: "Mark a requirement as actioned",
-	Args:  cobra.ExactArgs(2),
-	Run:   runMaintenanceActioned,
+	Use:               "actioned <slug> [id]",
+	Short:             "Mark a requirement as actioned",
+	Args:              cobra.RangeArgs(1, 2),
+	Run:               runMaintenanceActioned,
+	ValidArgsFunction: completeMaintenanceNames,
 }
 
 var maintenanceRemoveCmd = &cobra.Command{
-	Use:   "remove <slug>",
-	Short: "Remove a maintenance item",
-	Args:  cobra.ExactArgs(1),
-	Run:   runMaintenanceRemove,
+	Use:               "remove <slug>",
+	Short:             "Remove a maintenance item",
+	Args:              cobra.ExactArgs(1),
+	Run:               runMaintenanceRemove,
+	ValidArgsFunction: completeMaintenanceNames,
 }
 
 func init() {
@@ -65,6 +87,15 @@ func init() {
 	maintenanceDueCmd.Long = helpText("spec-maintenance-due")
 	maintenanceActionedCmd.Long = helpText("spec-maintenance-actioned")
 
+	maintenanceAddCmd.Flags().StringVar(&maintenanceAddFreq, "freq", "", "Default frequency for the scaffolded requirements")
+	maintenanceAddCmd.Flags().StringVar(&maintenanceAddFrom, "from", "", "Seed requirements from a file with one requirement per line")
+	maintenanceListCmd.Flags().StringVar(&maintenanceListFormat, "format", "text", "Output format: text or csv")
+	maintenanceDueCmd.Flags().StringVar(&maintenanceDueSince, "since", "", "List requirements actioned within this window instead (e.g. 7d, 2w, 72h)")
+	maintenanceDueCmd.Flags().BoolVar(&maintenanceDueAll, "all", false, "Show due requirements for every maintenance item")
+	maintenanceDueCmd.Flags().BoolVar(&maintenanceDueExitCode, "exit-code", false, "Exit with status 1 when any requirement is due; --quiet suppresses the listing")
+	maintenanceActionedCmd.Flags().BoolVar(&maintenanceActionedAllDue, "all-due", false, "Mark every currently due requirement as actioned")
+	maintenanceActionedCmd.Flags().StringVar(&maintenanceActionedNote, "note", "", "Record what was done alongside the timestamp")
+
 	maintenanceCmd.AddCommand(maintenanceAddCmd)
 	maintenanceCmd.AddCommand(maintenanceListCmd)
 	maintenanceCmd.AddCommand(maintenanceShowCmd)
@@ -75,158 +106,28 @@ func init() {
 	specCmd.AddCommand(maintenanceCmd)
 }
 
-// MaintenanceRequirement represents a parsed requirement from a maintenance file.
-type MaintenanceRequirement struct {
-	ID           string
-	Text         string
-	Freq         string // daily, weekly, biweekly, monthly, quarterly, yearly, or empty (always)
-	Due          bool
-	LastActioned string // RFC3339 timestamp or empty
-	Line         int    // 1-indexed line number in file
-}
-
-var allowedFreqs = map[string]bool{
-	"daily":     true,
-	"weekly":    true,
-	"biweekly":  true,
-	"monthly":   true,
-	"quarterly": true,
-	"yearly":    true,
+// MaintenanceGroup is a named group of requirements, from a "### " heading
+// in the Requirements section. Requirements before any heading are in the
+// group named "".
+type MaintenanceGroup struct {
+	Name         string
+	Requirements []workspace.MaintenanceRequirement
 }
 
-// parseMaintenanceFile reads and parses a maintenance file.
-func parseMaintenanceFile(filePath string, state *State, slug string) ([]MaintenanceRequirement, error) {
-	content, err := os.ReadFile(filePath)
-	if err != nil {
-		return nil, err
-	}
-
-	lines := strings.Split(string(content), "\n")
-	var requirements []MaintenanceRequirement
-	inRequirements := false
-	seenIDs := make(map[string]int) // id -> line number
-
-	// Regex to extract tokens: [id=...] [freq=...]
-	idPattern := regexp.MustCompile(`\[id=([^\]]+)\]`)
-	freqPattern := regexp.MustCompile(`\[freq=([^\]]+)\]`)
-
-	for lineNum, line := range lines {
-		trimmed := strings.TrimSpace(line)
-
-		// Detect start of Requirements section
-		if strings.HasPrefix(trimmed, "## Requirements") {
-			inRequirements = true
-			continue
-		}
-
-		// Stop at next section
-		if inRequirements && strings.HasPrefix(trimmed, "## ") {
-			break
-		}
-
-		// Parse requirement lines
-		if inRequirements && (strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ")) {
-			// Extract ID
-			idMatch := idPattern.FindStringSubmatch(trimmed)
-			if len(idMatch) < 2 {
-				return nil, fmt.Errorf("line %d: requirement missing [id=...]: %s", lineNum+1, trimmed)
-			}
-			id := strings.TrimSpace(idMatch[1])
-
-			// Check for duplicate IDs
-			if prevLine, exists := seenIDs[id]; exists {
-				return nil, fmt.Errorf("line %d: duplicate id '%s' (first seen on line %d)", lineNum+1, id, prevLine)
-			}
-			seenIDs[id] = lineNum + 1
-
-			// Extract frequency (optional)
-			freq := ""
-			freqMatch := freqPattern.FindStringSubmatch(trimmed)
-			if len(freqMatch) >= 2 {
-				freq = strings.TrimSpace(freqMatch[1])
-				if !allowedFreqs[freq] {
-					return nil, fmt.Errorf("line %d: unknown frequency '%s' (allowed: daily, weekly, biweekly, monthly, quarterly, yearly)", lineNum+1, freq)
-				}
-			}
-
-			// Strip tokens to get clean text
-			text := trimmed
-			text = idPattern.ReplaceAllString(text, "")
-			text = freqPattern.ReplaceAllString(text, "")
-			text = strings.TrimSpace(text)
-			// Remove leading bullet
-			text = strings.TrimPrefix(text, "- ")
-			text = strings.TrimPrefix(text, "* ")
-			text = strings.TrimSpace(text)
-
-			// Get last actioned time from state
-			lastActioned := ""
-			if state != nil && state.Maintenance != nil {
-				if slugMap, ok := state.Maintenance[slug]; ok {
-					if reqState, ok := slugMap[id]; ok {
-						lastActioned = reqState.LastActioned
-					}
-				}
-			}
-
-			// Compute due status
-			due := computeDue(freq, lastActioned)
-
-			requirements = append(requirements, MaintenanceRequirement{
-				ID:           id,
-				Text:         text,
-				Freq:         freq,
-				Due:          due,
-				LastActioned: lastActioned,
-				Line:         lineNum + 1,
-			})
+// groupRequirements splits reqs into groups in order of first appearance.
+func groupRequirements(reqs []workspace.MaintenanceRequirement) []MaintenanceGroup {
+	var groups []MaintenanceGroup
+	index := make(map[string]int)
+	for _, req := range reqs {
+		i, ok := index[req.Group]
+		if !ok {
+			i = len(groups)
+			index[req.Group] = i
+			groups = append(groups, MaintenanceGroup{Name: req.Group})
 		}
+		groups[i].Requirements = append(groups[i].Requirements, req)
 	}
-
-	return requirements, nil
-}
-
-// computeDue determines if a requirement is due based on frequency and last actioned time.
-func computeDue(freq string, lastActioned string) bool {
-	// No freq => always due
-	if freq == "" {
-		return true
-	}
-
-	// Never actioned => due
-	if lastActioned == "" {
-		return true
-	}
-
-	// Parse last actioned time
-	lastTime, err := time.Parse(time.RFC3339, lastActioned)
-	if err != nil {
-		// Invalid timestamp => treat as never actioned
-		return true
-	}
-
-	now := time.Now()
-	var nextDue time.Time
-
-	switch freq {
-	case "daily":
-		nextDue = lastTime.AddDate(0, 0, 1)
-	case "weekly":
-		nextDue = lastTime.AddDate(0, 0, 7)
-	case "biweekly":
-		nextDue = lastTime.AddDate(0, 0, 14)
-	case "monthly":
-		nextDue = lastTime.AddDate(0, 1, 0)
-	case "quarterly":
-		nextDue = lastTime.AddDate(0, 3, 0)
-	case "yearly":
-		nextDue = lastTime.AddDate(1, 0, 0)
-	default:
-		// Unknown freq => always due
-		return true
-	}
-
-	return now.After(nextDue) || now.Equal(nextDue)
+	return groups
 }
 
 // listMaintenanceFiles returns sorted maintenance file slugs.
@@ -250,51 +151,150 @@ func listMaintenanceFiles(specPath string) ([]string, error) {
 }
 
 func runMaintenanceAdd(cmd *cobra.Command, args []string) {
-	name := args[0]
-	slug := nameToSlug(name)
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
 
-	if slug == "" {
-		printError("Invalid maintenance name: must contain at least one alphanumeric character")
+	if maintenanceAddFreq != "" && !workspace.MaintenanceFrequencies[maintenanceAddFreq] {
+		printError(fmt.Sprintf("Unknown frequency '%s' (allowed: daily, weekly, biweekly, monthly, quarterly, yearly)", maintenanceAddFreq))
 		return
 	}
 
-	specPath, err := checkSpecWorkspace()
+	var requirements []string
+	if maintenanceAddFrom != "" {
+		seed, err := os.ReadFile(maintenanceAddFrom)
+		if err != nil {
+			printError(fmt.Sprintf("Failed to read %s: %v", maintenanceAddFrom, err))
+			return
+		}
+		requirements, err = seedMaintenanceRequirements(string(seed), maintenanceAddFreq)
+		if err != nil {
+			printError(err.Error())
+			return
+		}
+	}
+
+	slug, err := createMaintenanceItem(specPath, args[0], maintenanceAddFreq, requirements)
 	if err != nil {
-		printWorkspaceError()
+		printError(err.Error())
 		return
 	}
 
+	if len(requirements) > 0 {
+		printSuccess(fmt.Sprintf("Created maintenance item '%s' with %d requirement(s)", slug, len(requirements)))
+	} else {
+		printSuccess(fmt.Sprintf("Created maintenance item '%s'", slug))
+	}
+	printDim(fmt.Sprintf("Location: %s", filepath.Join(specPath, maintenanceDir, slug+".md")))
+}
+
+// createMaintenanceItem scaffolds maintenance/<slug>.md from the maintenance
+// template and returns the slug derived from name. freq and requirements are
+// passed to the template and may be empty.
+func createMaintenanceItem(specPath, name, freq string, requirements []string) (string, error) {
+	slug := nameToSlug(name)
+	if slug == "" {
+		return "", fmt.Errorf("invalid maintenance name: must contain at least one alphanumeric character")
+	}
+
 	maintenancePath := filepath.Join(specPath, maintenanceDir)
 	if err := os.MkdirAll(maintenancePath, 0755); err != nil {
-		printError(fmt.Sprintf("Failed to create maintenance directory: %v", err))
-		return
+		return "", fmt.Errorf("failed to create maintenance directory: %w", err)
 	}
 
 	filePath := filepath.Join(maintenancePath, slug+".md")
-	if fileExists(filePath) {
-		printError(fmt.Sprintf("Maintenance item '%s' already exists", slug))
-		return
+	if workspace.FileExists(filePath) {
+		return "", fmt.Errorf("maintenance item '%s' already exists", slug)
 	}
 
-	// Render template
 	data := struct {
-		Name string
-		Slug string
-	}{Name: name, Slug: slug}
+		Name         string
+		Slug         string
+		Freq         string
+		Requirements []string
+	}{Name: name, Slug: slug, Freq: freq, Requirements: requirements}
 
 	content, err := renderTemplate("templates/maintenance.md", data)
 	if err != nil {
-		printError(fmt.Sprintf("Failed to render template: %v", err))
-		return
+		return "", fmt.Errorf("failed to render template: %w", err)
 	}
 
 	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
-		printError(fmt.Sprintf("Failed to create maintenance item: %v", err))
-		return
+		return "", fmt.Errorf("failed to create maintenance item: %w", err)
 	}
+	return slug, nil
+}
+
+// seedMaintenanceRequirements turns a list of requirements, one per line,
+// into requirement lines. Blank lines and lines starting with # are skipped
+// and list bullets are optional. Lines without an [id=...] get one derived
+// from their text, made unique with a numeric suffix; lines without a
+// [freq=...] get freq, if set.
+func seedMaintenanceRequirements(seed, freq string) ([]string, error) {
+	type seedLine struct {
+		text string
+		id   string
+		line int
+	}
+	var entries []seedLine
+	used := make(map[string]bool)
+
+	// Explicit ids are reserved first so generated ones never collide
+	for lineNum, line := range strings.Split(seed, "\n") {
+		text := strings.TrimSpace(line)
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		text = strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(text, "- "), "* "))
 
-	printSuccess(fmt.Sprintf("Created maintenance item '%s'", slug))
-	printDim(fmt.Sprintf("Location: %s", filePath))
+		if match := workspace.MaintenanceFreqPattern.FindStringSubmatch(text); match != nil && !workspace.MaintenanceFrequencies[strings.TrimSpace(match[1])] {
+			return nil, fmt.Errorf("line %d: unknown frequency '%s' (allowed: daily, weekly, biweekly, monthly, quarterly, yearly)", lineNum+1, strings.TrimSpace(match[1]))
+		}
+
+		entry := seedLine{text: text, line: lineNum + 1}
+		if match := workspace.MaintenanceIDPattern.FindStringSubmatch(text); match != nil {
+			entry.id = strings.TrimSpace(match[1])
+			if used[entry.id] {
+				return nil, fmt.Errorf("line %d: duplicate id '%s'", lineNum+1, entry.id)
+			}
+			used[entry.id] = true
+		}
+		entries = append(entries, entry)
+	}
+
+	var requirements []string
+	for _, entry := range entries {
+		text := entry.text
+		if entry.id == "" {
+			base := requirementIDFromText(workspace.MaintenanceFreqPattern.ReplaceAllString(text, ""))
+			id := base
+			for n := 2; used[id]; n++ {
+				id = fmt.Sprintf("%s-%d", base, n)
+			}
+			used[id] = true
+			text += fmt.Sprintf(" [id=%s]", id)
+		}
+		if freq != "" && !workspace.MaintenanceFreqPattern.MatchString(text) {
+			text += fmt.Sprintf(" [freq=%s]", freq)
+		}
+		requirements = append(requirements, "- "+text)
+	}
+	return requirements, nil
+}
+
+// requirementIDFromText derives a slug-like id from the first few words of
+// a requirement.
+func requirementIDFromText(text string) string {
+	id := nameToSlug(text)
+	if parts := strings.Split(id, "-"); len(parts) > 4 {
+		id = strings.Join(parts[:4], "-")
+	}
+	if id == "" {
+		return "req"
+	}
+	return id
 }
 
 func runMaintenanceList(cmd *cobra.Command, args []string) {
@@ -304,13 +304,17 @@ func runMaintenanceList(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	if !checkListFormat(maintenanceListFormat) {
+		return
+	}
+
 	slugs, err := listMaintenanceFiles(specPath)
 	if err != nil {
 		printError(fmt.Sprintf("Failed to list maintenance items: %v", err))
 		return
 	}
 
-	if len(slugs) == 0 {
+	if len(slugs) == 0 && maintenanceListFormat == "text" {
 		printDim("No maintenance items found")
 		printDim("Use 'nocturnal spec maintenance add <name>' to create one")
 		return
@@ -322,35 +326,50 @@ func runMaintenanceList(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	fmt.Println()
-	fmt.Println(boldStyle.Render(fmt.Sprintf("Maintenance Items (%d)", len(slugs))))
-	fmt.Println()
-
+	var rows, csvRows [][]string
 	for _, slug := range slugs {
 		filePath := filepath.Join(specPath, maintenanceDir, slug+".md")
-		reqs, err := parseMaintenanceFile(filePath, state, slug)
+		reqs, err := workspace.ParseMaintenanceFile(filePath, state, slug)
 		if err != nil {
 			printError(fmt.Sprintf("Error parsing %s: %v", slug, err))
 			continue
 		}
 
-		dueCount := 0
-		for _, req := range reqs {
-			if req.Due {
-				dueCount++
-			}
-		}
+		dueCount := workspace.CountDue(reqs)
+		rows = append(rows, []string{infoStyle.Render(slug), renderDueCount(dueCount, len(reqs))})
+		csvRows = append(csvRows, []string{slug, strconv.Itoa(len(reqs)), strconv.Itoa(dueCount)})
 
-		dueText := fmt.Sprintf("%d/%d due", dueCount, len(reqs))
-		if dueCount > 0 {
-			dueText = warningStyle.Render(dueText)
-		} else {
-			dueText = dimStyle.Render(dueText)
+		// Grouped items get a row per group beneath the item
+		if groups := groupRequirements(reqs); len(groups) > 1 || (len(groups) == 1 && groups[0].Name != "") {
+			for _, g := range groups {
+				name := g.Name
+				if name == "" {
+					name = "(ungrouped)"
+				}
+				rows = append(rows, []string{"  " + name, renderDueCount(workspace.CountDue(g.Requirements), len(g.Requirements))})
+			}
 		}
+	}
 
-		fmt.Printf("  %s  %s\n", infoStyle.Render(slug), dueText)
+	if maintenanceListFormat == "csv" {
+		printCSV([]string{"slug", "total", "due"}, csvRows)
+		return
 	}
+
+	fmt.Println()
+	fmt.Println(boldStyle.Render(fmt.Sprintf("Maintenance Items (%d)", len(slugs))))
 	fmt.Println()
+	fmt.Print(renderTable(nil, rows))
+	fmt.Println()
+}
+
+// renderDueCount renders "due/total due", highlighted when anything is due.
+func renderDueCount(due, total int) string {
+	text := fmt.Sprintf("%d/%d due", due, total)
+	if due > 0 {
+		return warningStyle.Render(text)
+	}
+	return dimStyle.Render(text)
 }
 
 func runMaintenanceShow(cmd *cobra.Command, args []string) {
@@ -362,7 +381,7 @@ func runMaintenanceShow(cmd *cobra.Command, args []string) {
 	}
 
 	filePath := filepath.Join(specPath, maintenanceDir, slug+".md")
-	if !fileExists(filePath) {
+	if !workspace.FileExists(filePath) {
 		printError(fmt.Sprintf("Maintenance item '%s' does not exist", slug))
 		return
 	}
@@ -377,38 +396,105 @@ func runMaintenanceShow(cmd *cobra.Command, args []string) {
 }
 
 func runMaintenanceDue(cmd *cobra.Command, args []string) {
-	slug := args[0]
 	specPath, err := checkSpecWorkspace()
 	if err != nil {
 		printWorkspaceError()
+		exitMaintenanceDueError()
 		return
 	}
 
-	filePath := filepath.Join(specPath, maintenanceDir, slug+".md")
-	if !fileExists(filePath) {
-		printError(fmt.Sprintf("Maintenance item '%s' does not exist", slug))
+	if maintenanceDueExitCode && maintenanceDueSince != "" {
+		printError("--exit-code cannot be used with --since")
+		exitMaintenanceDueError()
+		return
+	}
+	if maintenanceDueAll && len(args) > 0 {
+		printError("Specify a maintenance item or --all, not both")
+		exitMaintenanceDueError()
+		return
+	}
+	if maintenanceDueSince != "" {
+		runMaintenanceRecentlyActioned(specPath, args)
 		return
 	}
 
-	state, err := loadState(specPath)
-	if err != nil {
-		printError(fmt.Sprintf("Failed to load state: %v", err))
+	var slugs []string
+	switch {
+	case maintenanceDueAll:
+		slugs, err = listMaintenanceFiles(specPath)
+		if err != nil {
+			printError(fmt.Sprintf("Failed to list maintenance items: %v", err))
+			exitMaintenanceDueError()
+			return
+		}
+	case len(args) == 0:
+		printError("Specify a maintenance item, --all, or --since to list recent actions across items")
+		exitMaintenanceDueError()
 		return
+	default:
+		slugs = args
 	}
 
-	reqs, err := parseMaintenanceFile(filePath, state, slug)
+	due, err := reportMaintenanceDue(specPath, slugs)
 	if err != nil {
-		printError(fmt.Sprintf("Failed to parse maintenance file: %v", err))
+		printError(err.Error())
+		exitMaintenanceDueError()
 		return
 	}
+	if code := maintenanceDueExitStatus(due); code != 0 {
+		os.Exit(code)
+	}
+}
 
-	dueReqs := []MaintenanceRequirement{}
-	for _, req := range reqs {
-		if req.Due {
-			dueReqs = append(dueReqs, req)
+// reportMaintenanceDue prints the due requirements of each maintenance item
+// and returns how many are due in total. With --exit-code and --quiet
+// nothing is printed.
+func reportMaintenanceDue(specPath string, slugs []string) (int, error) {
+	for _, slug := range slugs {
+		if !workspace.FileExists(filepath.Join(specPath, maintenanceDir, slug+".md")) {
+			return 0, fmt.Errorf("maintenance item '%s' does not exist", slug)
+		}
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load state: %w", err)
+	}
+
+	silent := maintenanceDueExitCode && quiet
+	if maintenanceDueAll && len(slugs) == 0 && !silent {
+		printDim("No maintenance items found")
+	}
+
+	total := 0
+	for _, slug := range slugs {
+		reqs, err := workspace.ParseMaintenanceFile(filepath.Join(specPath, maintenanceDir, slug+".md"), state, slug)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse maintenance file '%s': %w", slug, err)
+		}
+
+		dueReqs := []workspace.MaintenanceRequirement{}
+		for _, req := range reqs {
+			if req.Due {
+				dueReqs = append(dueReqs, req)
+			}
+		}
+		total += len(dueReqs)
+
+		if silent || (maintenanceDueAll && len(dueReqs) == 0) {
+			continue
 		}
+		printDueRequirements(slug, dueReqs)
+	}
+	if maintenanceDueAll && len(slugs) > 0 && total == 0 && !silent {
+		printDim("No requirements due")
 	}
+	return total, nil
+}
 
+// printDueRequirements prints one maintenance item's due requirements,
+// beneath their group names.
+func printDueRequirements(slug string, dueReqs []workspace.MaintenanceRequirement) {
 	fmt.Println()
 	fmt.Println(boldStyle.Render(fmt.Sprintf("Due Requirements: %s", slug)))
 	fmt.Println()
@@ -418,21 +504,146 @@ func runMaintenanceDue(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	for _, req := range dueReqs {
-		fmt.Printf("  %s  %s\n", successStyle.Render("["+req.ID+"]"), req.Text)
-		if req.Freq != "" {
-			fmt.Printf("      %s\n", dimStyle.Render("freq: "+req.Freq))
+	for _, group := range groupRequirements(dueReqs) {
+		if group.Name != "" {
+			fmt.Println(topicStyle.Render(group.Name))
+			fmt.Println()
 		}
-		if req.LastActioned != "" {
-			fmt.Printf("      %s\n", dimStyle.Render("last: "+req.LastActioned))
+		for _, req := range group.Requirements {
+			fmt.Printf("  %s  %s\n", successStyle.Render("["+req.ID+"]"), req.Text)
+			if req.Freq != "" {
+				fmt.Printf("      %s\n", dimStyle.Render("freq: "+req.Freq))
+			}
+			if req.LastActioned != "" {
+				fmt.Printf("      %s\n", dimStyle.Render("last: "+req.LastActioned))
+			}
+			if req.Note != "" {
+				fmt.Printf("      %s\n", dimStyle.Render("note: "+req.Note))
+			}
+			fmt.Println()
 		}
-		fmt.Println()
 	}
 }
 
+// maintenanceDueExitStatus returns the exit status for --exit-code: 1 when
+// any requirement is due, otherwise 0. Without --exit-code it is always 0.
+func maintenanceDueExitStatus(due int) int {
+	if maintenanceDueExitCode && due > 0 {
+		return 1
+	}
+	return 0
+}
+
+// exitMaintenanceDueError exits with status 2 under --exit-code, so a
+// failed check is not mistaken for "nothing due".
+func exitMaintenanceDueError() {
+	if maintenanceDueExitCode {
+		os.Exit(2)
+	}
+}
+
+// ActionedRequirement is a requirement with the time it was last actioned.
+type ActionedRequirement struct {
+	Slug        string
+	Requirement workspace.MaintenanceRequirement
+	Actioned    time.Time
+}
+
+// runMaintenanceRecentlyActioned lists the requirements actioned within the
+// --since window, for one maintenance item or, without args, all of them.
+func runMaintenanceRecentlyActioned(specPath string, args []string) {
+	since, err := parseRetentionDuration(maintenanceDueSince)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	slugs := args
+	if len(slugs) == 0 {
+		slugs, err = listMaintenanceFiles(specPath)
+		if err != nil {
+			printError(fmt.Sprintf("Failed to list maintenance items: %v", err))
+			return
+		}
+	} else if !workspace.FileExists(filepath.Join(specPath, maintenanceDir, slugs[0]+".md")) {
+		printError(fmt.Sprintf("Maintenance item '%s' does not exist", slugs[0]))
+		return
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to load state: %v", err))
+		return
+	}
+
+	actioned, err := recentlyActioned(specPath, state, slugs, time.Now().Add(-since))
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	if len(actioned) == 0 {
+		printDim(fmt.Sprintf("No requirements actioned in the last %s", maintenanceDueSince))
+		return
+	}
+
+	var rows [][]string
+	for _, a := range actioned {
+		rows = append(rows, []string{
+			formatEventTime(a.Requirement.LastActioned),
+			a.Slug,
+			successStyle.Render(a.Requirement.ID),
+			a.Requirement.Text,
+			a.Requirement.Note,
+		})
+	}
+
+	fmt.Println()
+	fmt.Println(boldStyle.Render(fmt.Sprintf("Actioned in the last %s (%d)", maintenanceDueSince, len(actioned))))
+	fmt.Println()
+	fmt.Print(renderTable([]string{"TIME", "ITEM", "ID", "REQUIREMENT", "NOTE"}, rows))
+	fmt.Println()
+}
+
+// recentlyActioned returns the requirements of the given maintenance items
+// last actioned at or after cutoff, newest first. Actions recorded for
+// requirements no longer in their file are skipped.
+func recentlyActioned(specPath string, state *workspace.State, slugs []string, cutoff time.Time) ([]ActionedRequirement, error) {
+	var actioned []ActionedRequirement
+	for _, slug := range slugs {
+		reqs, err := workspace.ParseMaintenanceFile(filepath.Join(specPath, maintenanceDir, slug+".md"), state, slug)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse maintenance item '%s': %w", slug, err)
+		}
+		for _, req := range reqs {
+			if req.LastActioned == "" {
+				continue
+			}
+			t, err := time.Parse(time.RFC3339, req.LastActioned)
+			if err != nil || t.Before(cutoff) {
+				continue
+			}
+			actioned = append(actioned, ActionedRequirement{Slug: slug, Requirement: req, Actioned: t})
+		}
+	}
+
+	sort.SliceStable(actioned, func(i, j int) bool {
+		return actioned[i].Actioned.After(actioned[j].Actioned)
+	})
+	return actioned, nil
+}
+
 func runMaintenanceActioned(cmd *cobra.Command, args []string) {
 	slug := args[0]
-	id := args[1]
+
+	if maintenanceActionedAllDue && len(args) > 1 {
+		printError("Specify either a requirement ID or --all-due, not both")
+		return
+	}
+	if !maintenanceActionedAllDue && len(args) < 2 {
+		printError("Specify a requirement ID or use --all-due")
+		return
+	}
 
 	specPath, err := checkSpecWorkspace()
 	if err != nil {
@@ -441,7 +652,7 @@ func runMaintenanceActioned(cmd *cobra.Command, args []string) {
 	}
 
 	filePath := filepath.Join(specPath, maintenanceDir, slug+".md")
-	if !fileExists(filePath) {
+	if !workspace.FileExists(filePath) {
 		printError(fmt.Sprintf("Maintenance item '%s' does not exist", slug))
 		return
 	}
@@ -453,12 +664,32 @@ func runMaintenanceActioned(cmd *cobra.Command, args []string) {
 	}
 
 	// Parse file to validate ID exists
-	reqs, err := parseMaintenanceFile(filePath, state, slug)
+	reqs, err := workspace.ParseMaintenanceFile(filePath, state, slug)
 	if err != nil {
 		printError(fmt.Sprintf("Failed to parse maintenance file: %v", err))
 		return
 	}
 
+	if maintenanceActionedAllDue {
+		actioned := markAllDueActioned(state, slug, reqs, time.Now(), maintenanceActionedNote)
+		if len(actioned) == 0 {
+			printDim("No requirements due")
+			return
+		}
+
+		if err := saveState(specPath, state); err != nil {
+			printError(fmt.Sprintf("Failed to save state: %v", err))
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Marked %d requirement(s) as actioned", len(actioned)))
+		for _, id := range actioned {
+			fmt.Printf("  %s\n", successStyle.Render("["+id+"]"))
+		}
+		return
+	}
+
+	id := args[1]
 	found := false
 	var reqText string
 	for _, req := range reqs {
@@ -474,17 +705,7 @@ func runMaintenanceActioned(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	// Update state
-	if state.Maintenance == nil {
-		state.Maintenance = make(map[string]map[string]MaintenanceState)
-	}
-	if state.Maintenance[slug] == nil {
-		state.Maintenance[slug] = make(map[string]MaintenanceState)
-	}
-
-	state.Maintenance[slug][id] = MaintenanceState{
-		LastActioned: time.Now().Format(time.RFC3339),
-	}
+	markActioned(state, slug, id, time.Now(), maintenanceActionedNote)
 
 	if err := saveState(specPath, state); err != nil {
 		printError(fmt.Sprintf("Failed to save state: %v", err))
@@ -493,6 +714,39 @@ func runMaintenanceActioned(cmd *cobra.Command, args []string) {
 
 	printSuccess(fmt.Sprintf("Marked '%s' as actioned", id))
 	printDim(reqText)
+	if maintenanceActionedNote != "" {
+		printDim("note: " + strings.TrimSpace(maintenanceActionedNote))
+	}
+}
+
+// markActioned records now as the last actioned time for a requirement,
+// replacing any note from the previous action with note.
+func markActioned(state *workspace.State, slug, id string, now time.Time, note string) {
+	if state.Maintenance == nil {
+		state.Maintenance = make(map[string]map[string]workspace.MaintenanceState)
+	}
+	if state.Maintenance[slug] == nil {
+		state.Maintenance[slug] = make(map[string]workspace.MaintenanceState)
+	}
+
+	state.Maintenance[slug][id] = workspace.MaintenanceState{
+		LastActioned: now.Format(time.RFC3339),
+		Note:         strings.TrimSpace(note),
+	}
+}
+
+// markAllDueActioned marks every due requirement in reqs as actioned with
+// note and returns their IDs in file order.
+func markAllDueActioned(state *workspace.State, slug string, reqs []workspace.MaintenanceRequirement, now time.Time, note string) []string {
+	var actioned []string
+	for _, req := range reqs {
+		if !req.Due {
+			continue
+		}
+		markActioned(state, slug, req.ID, now, note)
+		actioned = append(actioned, req.ID)
+	}
+	return actioned
 }
 
 func runMaintenanceRemove(cmd *cobra.Command, args []string) {
@@ -505,7 +759,7 @@ func runMaintenanceRemove(cmd *cobra.Command, args []string) {
 	}
 
 	filePath := filepath.Join(specPath, maintenanceDir, slug+".md")
-	if !fileExists(filePath) {
+	if !workspace.FileExists(filePath) {
 		printError(fmt.Sprintf("Maintenance item '%s' does not exist", slug))
 		return
 	}
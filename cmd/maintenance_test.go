@@ -3,207 +3,81 @@ package cmd
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
-func TestParseMaintenanceFile(t *testing.T) {
+func TestParseMaintenanceFileGroups(t *testing.T) {
 	tests := []struct {
-		name          string
-		content       string
-		wantErr       bool
-		wantErrMsg    string
-		wantReqCount  int
-		wantFirstID   string
-		wantFirstFreq string
+		name       string
+		content    string
+		wantGroups map[string]string // id -> group
+		wantOrder  []string          // group names in order
 	}{
 		{
-			name: "valid requirements with frequencies",
-			content: `# Maintenance: Test
+			name: "flat",
+			content: `# Maintenance: Flat
 
 ## Requirements
-- Run tests [id=test] [freq=weekly]
-- Update deps [id=deps] [freq=monthly]
+- Run tests [id=test]
+- Update deps [id=deps]
 `,
-			wantReqCount:  2,
-			wantFirstID:   "test",
-			wantFirstFreq: "weekly",
+			wantGroups: map[string]string{"test": "", "deps": ""},
+			wantOrder:  []string{""},
 		},
 		{
-			name: "requirement without frequency (always due)",
-			content: `# Maintenance: Test
+			name: "grouped",
+			content: `# Maintenance: Ops
 
 ## Requirements
-- Do something [id=always]
-`,
-			wantReqCount:  1,
-			wantFirstID:   "always",
-			wantFirstFreq: "",
-		},
-		{
-			name: "missing id",
-			content: `# Maintenance: Test
+- Read the runbook [id=runbook]
 
-## Requirements
-- Run tests [freq=weekly]
-`,
-			wantErr:    true,
-			wantErrMsg: "missing [id=...]",
-		},
-		{
-			name: "duplicate id",
-			content: `# Maintenance: Test
+### Security
+- Rotate keys [id=keys] [freq=quarterly]
+- Audit access [id=access]
 
-## Requirements
-- First [id=dup]
-- Second [id=dup]
-`,
-			wantErr:    true,
-			wantErrMsg: "duplicate id",
-		},
-		{
-			name: "unknown frequency",
-			content: `# Maintenance: Test
-
-## Requirements
-- Test [id=test] [freq=hourly]
-`,
-			wantErr:    true,
-			wantErrMsg: "unknown frequency",
-		},
-		{
-			name: "tokens in any order",
-			content: `# Maintenance: Test
-
-## Requirements
-- First [freq=daily] [id=first]
-- Second [id=second] [freq=weekly]
-`,
-			wantReqCount: 2,
-			wantFirstID:  "first",
-		},
-		{
-			name: "stops at next section",
-			content: `# Maintenance: Test
-
-## Requirements
-- First [id=first]
+### Backups
+- Test restore [id=restore] [freq=monthly]
 
 ## Notes
-- Not a requirement [id=ignored]
+### Not a group
+- Ignored [id=ignored]
 `,
-			wantReqCount: 1,
-			wantFirstID:  "first",
+			wantGroups: map[string]string{"runbook": "", "keys": "Security", "access": "Security", "restore": "Backups"},
+			wantOrder:  []string{"", "Security", "Backups"},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Create temp file
-			tmpDir := t.TempDir()
-			filePath := filepath.Join(tmpDir, "test.md")
+			filePath := filepath.Join(t.TempDir(), "ops.md")
 			if err := os.WriteFile(filePath, []byte(tt.content), 0644); err != nil {
 				t.Fatalf("failed to write test file: %v", err)
 			}
 
-			// Parse with empty state
-			state := &State{Maintenance: make(map[string]map[string]MaintenanceState)}
-			reqs, err := parseMaintenanceFile(filePath, state, "test")
-
-			if tt.wantErr {
-				if err == nil {
-					t.Fatalf("expected error containing %q, got nil", tt.wantErrMsg)
-				}
-				if !strings.Contains(err.Error(), tt.wantErrMsg) {
-					t.Fatalf("expected error containing %q, got %q", tt.wantErrMsg, err.Error())
-				}
-				return
-			}
-
+			reqs, err := workspace.ParseMaintenanceFile(filePath, nil, "ops")
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
-
-			if len(reqs) != tt.wantReqCount {
-				t.Fatalf("expected %d requirements, got %d", tt.wantReqCount, len(reqs))
+			if len(reqs) != len(tt.wantGroups) {
+				t.Fatalf("expected %d requirements, got %d", len(tt.wantGroups), len(reqs))
 			}
-
-			if tt.wantReqCount > 0 {
-				if reqs[0].ID != tt.wantFirstID {
-					t.Errorf("expected first ID %q, got %q", tt.wantFirstID, reqs[0].ID)
-				}
-				if tt.wantFirstFreq != "" && reqs[0].Freq != tt.wantFirstFreq {
-					t.Errorf("expected first freq %q, got %q", tt.wantFirstFreq, reqs[0].Freq)
+			for _, req := range reqs {
+				if want := tt.wantGroups[req.ID]; req.Group != want {
+					t.Errorf("%s: group = %q, want %q", req.ID, req.Group, want)
 				}
 			}
-		})
-	}
-}
-
-func TestComputeDue(t *testing.T) {
-	now := time.Now()
-
-	tests := []struct {
-		name         string
-		freq         string
-		lastActioned string
-		wantDue      bool
-	}{
-		{
-			name:    "no freq is always due",
-			freq:    "",
-			wantDue: true,
-		},
-		{
-			name:         "never actioned is due",
-			freq:         "weekly",
-			lastActioned: "",
-			wantDue:      true,
-		},
-		{
-			name:         "weekly - actioned yesterday is not due",
-			freq:         "weekly",
-			lastActioned: now.AddDate(0, 0, -1).Format(time.RFC3339),
-			wantDue:      false,
-		},
-		{
-			name:         "weekly - actioned 8 days ago is due",
-			freq:         "weekly",
-			lastActioned: now.AddDate(0, 0, -8).Format(time.RFC3339),
-			wantDue:      true,
-		},
-		{
-			name:         "daily - actioned yesterday is due",
-			freq:         "daily",
-			lastActioned: now.AddDate(0, 0, -1).Format(time.RFC3339),
-			wantDue:      true,
-		},
-		{
-			name:         "monthly - actioned 20 days ago is not due",
-			freq:         "monthly",
-			lastActioned: now.AddDate(0, 0, -20).Format(time.RFC3339),
-			wantDue:      false,
-		},
-		{
-			name:         "monthly - actioned 35 days ago is due",
-			freq:         "monthly",
-			lastActioned: now.AddDate(0, 0, -35).Format(time.RFC3339),
-			wantDue:      true,
-		},
-		{
-			name:         "invalid timestamp is due",
-			freq:         "weekly",
-			lastActioned: "invalid",
-			wantDue:      true,
-		},
-	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := computeDue(tt.freq, tt.lastActioned)
-			if got != tt.wantDue {
-				t.Errorf("computeDue() = %v, want %v", got, tt.wantDue)
+			var order []string
+			for _, group := range groupRequirements(reqs) {
+				order = append(order, group.Name)
+			}
+			if !reflect.DeepEqual(order, tt.wantOrder) {
+				t.Errorf("groups = %q, want %q", order, tt.wantOrder)
 			}
 		})
 	}
@@ -226,7 +100,7 @@ func TestMaintenanceStateLoad(t *testing.T) {
 	t.Run("load state without maintenance field", func(t *testing.T) {
 		// Write old-style state
 		stateContent := `{"version":1,"active":[],"primary":""}`
-		statePath := filepath.Join(tmpDir, stateFile)
+		statePath := filepath.Join(tmpDir, workspace.StateFile)
 		if err := os.WriteFile(statePath, []byte(stateContent), 0644); err != nil {
 			t.Fatalf("failed to write state: %v", err)
 		}
@@ -241,15 +115,15 @@ func TestMaintenanceStateLoad(t *testing.T) {
 	})
 
 	t.Run("save and load state with maintenance", func(t *testing.T) {
-		state := &State{
+		state := &workspace.State{
 			Version:     1,
 			Active:      []string{},
 			Primary:     "",
 			Hashes:      make(map[string]map[string]string),
-			Maintenance: make(map[string]map[string]MaintenanceState),
+			Maintenance: make(map[string]map[string]workspace.MaintenanceState),
 		}
 
-		state.Maintenance["test"] = map[string]MaintenanceState{
+		state.Maintenance["test"] = map[string]workspace.MaintenanceState{
 			"req1": {LastActioned: "2026-01-18T10:00:00Z"},
 		}
 
@@ -267,3 +141,309 @@ func TestMaintenanceStateLoad(t *testing.T) {
 		}
 	})
 }
+
+func TestMarkAllDueActioned(t *testing.T) {
+	tmpDir := t.TempDir()
+	filePath := filepath.Join(tmpDir, "chores.md")
+	content := `# Maintenance: Chores
+
+## Requirements
+- Run tests [id=test] [freq=weekly]
+- Update deps [id=deps] [freq=monthly]
+- Rotate keys [id=keys] [freq=yearly]
+`
+	if err := os.WriteFile(filePath, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	recent := time.Now().Add(-24 * time.Hour).Format(time.RFC3339)
+	state := &workspace.State{
+		Maintenance: map[string]map[string]workspace.MaintenanceState{
+			"chores": {"keys": {LastActioned: recent}},
+		},
+	}
+
+	reqs, err := workspace.ParseMaintenanceFile(filePath, state, "chores")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actioned := markAllDueActioned(state, "chores", reqs, time.Now(), "")
+	if strings.Join(actioned, ",") != "test,deps" {
+		t.Errorf("actioned = %v, want [test deps]", actioned)
+	}
+	if state.Maintenance["chores"]["keys"].LastActioned != recent {
+		t.Error("not-due requirement should be untouched")
+	}
+
+	reqs, err = workspace.ParseMaintenanceFile(filePath, state, "chores")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, req := range reqs {
+		if req.Due {
+			t.Errorf("requirement %s still due after --all-due", req.ID)
+		}
+	}
+}
+
+func TestRecentlyActioned(t *testing.T) {
+	specPath := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(specPath, maintenanceDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	items := map[string]string{
+		"chores":   "# Maintenance: Chores\n\n## Requirements\n- Run tests [id=test] [freq=weekly]\n- Rotate keys [id=keys] [freq=yearly]\n",
+		"security": "# Maintenance: Security\n\n## Requirements\n- Review access [id=access] [freq=monthly]\n- Never actioned [id=pending]\n",
+	}
+	for slug, content := range items {
+		if err := os.WriteFile(filepath.Join(specPath, maintenanceDir, slug+".md"), []byte(content), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+
+	now := time.Now()
+	ago := func(d time.Duration) workspace.MaintenanceState {
+		return workspace.MaintenanceState{LastActioned: now.Add(-d).Format(time.RFC3339)}
+	}
+	state := &workspace.State{
+		Maintenance: map[string]map[string]workspace.MaintenanceState{
+			"chores":   {"test": ago(2 * time.Hour), "keys": ago(30 * 24 * time.Hour), "removed": ago(time.Hour)},
+			"security": {"access": ago(time.Hour)},
+		},
+	}
+
+	actioned, err := recentlyActioned(specPath, state, []string{"chores", "security"}, now.Add(-7*24*time.Hour))
+	if err != nil {
+		t.Fatalf("recentlyActioned: %v", err)
+	}
+	var got []string
+	for _, a := range actioned {
+		got = append(got, a.Slug+"/"+a.Requirement.ID)
+	}
+	if want := []string{"security/access", "chores/test"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("actioned = %v, want %v", got, want)
+	}
+}
+
+func TestMaintenanceActionedNote(t *testing.T) {
+	specPath := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(specPath, maintenanceDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	filePath := filepath.Join(specPath, maintenanceDir, "deps.md")
+	content := "# Maintenance: Deps\n\n## Requirements\n- Update Go [id=go] [freq=monthly]\n- Audit [id=audit] [freq=yearly]\n"
+	if err := os.WriteFile(filePath, []byte(content), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	// State written before notes existed still loads
+	legacy := `{"version": 1, "active": [], "primary": "", "maintenance": {"deps": {"audit": {"last_actioned": "2026-01-19T10:15:00Z"}}}}`
+	if err := os.WriteFile(workspace.StatePath(specPath), []byte(legacy), 0o644); err != nil {
+		t.Fatalf("write state: %v", err)
+	}
+	state, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+
+	markActioned(state, "deps", "go", time.Now(), "  Bumped to 1.24.2  ")
+	if err := saveState(specPath, state); err != nil {
+		t.Fatalf("saveState: %v", err)
+	}
+
+	loaded, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	reqs, err := workspace.ParseMaintenanceFile(filePath, loaded, "deps")
+	if err != nil {
+		t.Fatalf("ParseMaintenanceFile: %v", err)
+	}
+	notes := map[string]string{}
+	for _, req := range reqs {
+		notes[req.ID] = req.Note
+	}
+	if want := map[string]string{"go": "Bumped to 1.24.2", "audit": ""}; !reflect.DeepEqual(notes, want) {
+		t.Errorf("notes = %v, want %v", notes, want)
+	}
+	if loaded.Maintenance["deps"]["audit"].LastActioned != "2026-01-19T10:15:00Z" {
+		t.Errorf("legacy entry = %+v, want its timestamp kept", loaded.Maintenance["deps"]["audit"])
+	}
+
+	// Actioning again without a note clears the old one
+	markActioned(loaded, "deps", "go", time.Now(), "")
+	if note := loaded.Maintenance["deps"]["go"].Note; note != "" {
+		t.Errorf("note = %q after actioning without --note, want empty", note)
+	}
+}
+
+func TestMaintenanceDueExitCode(t *testing.T) {
+	maintenanceDueAll, maintenanceDueExitCode, quiet = true, true, true
+	t.Cleanup(func() { maintenanceDueAll, maintenanceDueExitCode, quiet = false, false, false })
+
+	specPath := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(specPath, maintenanceDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	items := map[string]string{
+		"deps":  "# Maintenance: Deps\n\n## Requirements\n- Update Go [id=go] [freq=monthly]\n",
+		"audit": "# Maintenance: Audit\n\n## Requirements\n- Review access [id=access] [freq=yearly]\n",
+	}
+	for slug, content := range items {
+		if err := os.WriteFile(filepath.Join(specPath, maintenanceDir, slug+".md"), []byte(content), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	slugs, err := listMaintenanceFiles(specPath)
+	if err != nil {
+		t.Fatalf("listMaintenanceFiles: %v", err)
+	}
+
+	status := func() int {
+		t.Helper()
+		var due int
+		out := captureStdout(t, func() {
+			due, err = reportMaintenanceDue(specPath, slugs)
+		})
+		if err != nil {
+			t.Fatalf("reportMaintenanceDue: %v", err)
+		}
+		if out != "" {
+			t.Errorf("output = %q, want none under --quiet", out)
+		}
+		return maintenanceDueExitStatus(due)
+	}
+
+	if got := status(); got != 1 {
+		t.Errorf("exit status with requirements due = %d, want 1", got)
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	markActioned(state, "deps", "go", time.Now(), "")
+	markActioned(state, "audit", "access", time.Now(), "")
+	if err := saveState(specPath, state); err != nil {
+		t.Fatalf("saveState: %v", err)
+	}
+
+	if got := status(); got != 0 {
+		t.Errorf("exit status with nothing due = %d, want 0", got)
+	}
+
+	maintenanceDueExitCode = false
+	if got := maintenanceDueExitStatus(1); got != 0 {
+		t.Errorf("exit status without --exit-code = %d, want 0", got)
+	}
+}
+
+func TestSeedMaintenanceRequirements(t *testing.T) {
+	seed := `# Go dependencies
+- Update Go toolchain in CI
+* Run security audit [freq=yearly]
+
+Update Go toolchain in CI
+Review dependencies [id=update-go-toolchain-in]
+!!!
+`
+	requirements, err := seedMaintenanceRequirements(seed, "monthly")
+	if err != nil {
+		t.Fatalf("seedMaintenanceRequirements error: %v", err)
+	}
+
+	data := struct {
+		Name         string
+		Slug         string
+		Freq         string
+		Requirements []string
+	}{Name: "Go deps", Slug: "go-deps", Freq: "monthly", Requirements: requirements}
+	content, err := renderTemplate("templates/maintenance.md", data)
+	if err != nil {
+		t.Fatalf("renderTemplate error: %v", err)
+	}
+
+	filePath := filepath.Join(t.TempDir(), "go-deps.md")
+	if err := os.WriteFile(filePath, []byte(content), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	reqs, err := workspace.ParseMaintenanceFile(filePath, nil, "go-deps")
+	if err != nil {
+		t.Fatalf("seeded file does not parse: %v\n%s", err, content)
+	}
+
+	want := []struct{ id, text, freq string }{
+		{"update-go-toolchain-in-2", "Update Go toolchain in CI", "monthly"},
+		{"run-security-audit", "Run security audit", "yearly"},
+		{"update-go-toolchain-in-3", "Update Go toolchain in CI", "monthly"},
+		{"update-go-toolchain-in", "Review dependencies", "monthly"},
+		{"req", "!!!", "monthly"},
+	}
+	if len(reqs) != len(want) {
+		t.Fatalf("got %d requirements, want %d:\n%s", len(reqs), len(want), content)
+	}
+	for i, w := range want {
+		if reqs[i].ID != w.id || reqs[i].Text != w.text || reqs[i].Freq != w.freq {
+			t.Errorf("requirement %d = {%s %q %s}, want {%s %q %s}", i, reqs[i].ID, reqs[i].Text, reqs[i].Freq, w.id, w.text, w.freq)
+		}
+	}
+}
+
+func TestSeedMaintenanceRequirementsErrors(t *testing.T) {
+	if _, err := seedMaintenanceRequirements("Check [freq=hourly]\n", ""); err == nil {
+		t.Error("expected error for unknown frequency")
+	}
+	if _, err := seedMaintenanceRequirements("A [id=x]\nB [id=x]\n", ""); err == nil {
+		t.Error("expected error for duplicate explicit id")
+	}
+}
+
+func TestCreateWorkspaceItems(t *testing.T) {
+	specPath := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(specPath, ruleDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		create func(specPath, name string) (string, error)
+		dir    string
+	}{
+		{name: "rule", create: createRule, dir: ruleDir},
+		{
+			name: "maintenance",
+			create: func(specPath, name string) (string, error) {
+				return createMaintenanceItem(specPath, name, "", nil)
+			},
+			dir: maintenanceDir,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slug, err := tt.create(specPath, "Go Dependencies")
+			if err != nil {
+				t.Fatalf("create error: %v", err)
+			}
+			if slug != "go-dependencies" {
+				t.Errorf("slug = %q, want go-dependencies", slug)
+			}
+			content, err := os.ReadFile(filepath.Join(specPath, tt.dir, slug+".md"))
+			if err != nil {
+				t.Fatalf("expected scaffolded file: %v", err)
+			}
+			if !strings.Contains(string(content), "Go Dependencies") {
+				t.Errorf("expected name in scaffolded file:\n%s", content)
+			}
+
+			if _, err := tt.create(specPath, "go dependencies"); err == nil || !strings.Contains(err.Error(), "already exists") {
+				t.Errorf("duplicate error = %v, want already exists", err)
+			}
+			if _, err := tt.create(specPath, "!!!"); err == nil || !strings.Contains(err.Error(), "invalid") {
+				t.Errorf("empty slug error = %v, want invalid name", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+// manifestSchemaVersion is the version of the list-workspace output. It is
+// bumped whenever a field is renamed, removed, or changes meaning; new fields
+// may be added without a bump.
+const manifestSchemaVersion = 1
+
+var specListWorkspaceCmd = &cobra.Command{
+	Use:   "list-workspace",
+	Short: "Print the whole workspace structure as JSON",
+	Args:  cobra.NoArgs,
+	Run:   runSpecListWorkspace,
+}
+
+func init() {
+	specListWorkspaceCmd.Long = helpText("spec-list-workspace")
+	specCmd.AddCommand(specListWorkspaceCmd)
+}
+
+// WorkspaceManifest describes everything in a specification workspace.
+type WorkspaceManifest struct {
+	SchemaVersion int                   `json:"schema_version"`
+	Primary       string                `json:"primary"`
+	Proposals     []ManifestProposal    `json:"proposals"`
+	Sections      []ManifestSection     `json:"sections"`
+	Rules         []string              `json:"rules"`
+	Maintenance   []ManifestMaintenance `json:"maintenance"`
+	Docs          []ManifestDoc         `json:"docs"`
+}
+
+// ManifestProposal is a proposal's stats and the slugs it depends on.
+type ManifestProposal struct {
+	ProposalStats
+	DependsOn []string `json:"depends_on"`
+}
+
+// ManifestSection is a completed specification.
+type ManifestSection struct {
+	Slug         string `json:"slug"`
+	Requirements int    `json:"requirements"`
+}
+
+// ManifestMaintenance is a maintenance item and how many of its
+// requirements are due.
+type ManifestMaintenance struct {
+	Slug         string `json:"slug"`
+	Requirements int    `json:"requirements"`
+	Due          int    `json:"due"`
+}
+
+// ManifestDoc is a documentation component in spec/third/.
+type ManifestDoc struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+}
+
+func runSpecListWorkspace(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	manifest, err := gatherWorkspaceManifest(specPath)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+	printJSON(manifest)
+}
+
+// gatherWorkspaceManifest assembles the workspace manifest. Lists are empty
+// rather than nil so that clients always see arrays.
+func gatherWorkspaceManifest(specPath string) (*WorkspaceManifest, error) {
+	manifest := &WorkspaceManifest{
+		SchemaVersion: manifestSchemaVersion,
+		Proposals:     []ManifestProposal{},
+		Sections:      []ManifestSection{},
+		Rules:         []string{},
+		Maintenance:   []ManifestMaintenance{},
+		Docs:          []ManifestDoc{},
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load state: %w", err)
+	}
+	manifest.Primary = state.Primary
+
+	proposals, err := gatherProposalStats(specPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range proposals {
+		deps, err := workspace.ProposalDependencies(filepath.Join(specPath, proposalDir, p.Slug))
+		if err != nil {
+			return nil, err
+		}
+		if deps == nil {
+			deps = []string{}
+		}
+		manifest.Proposals = append(manifest.Proposals, ManifestProposal{ProposalStats: p, DependsOn: deps})
+	}
+
+	sectionPath := filepath.Join(specPath, sectionDir)
+	sections, err := listMarkdownFiles(sectionPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read section directory: %w", err)
+	}
+	for _, filename := range sections {
+		requirements := 0
+		if content, err := os.ReadFile(filepath.Join(sectionPath, filename)); err == nil {
+			must, should, may := countRequirementsByType(string(content))
+			requirements = must + should + may
+		}
+		manifest.Sections = append(manifest.Sections, ManifestSection{
+			Slug:         strings.TrimSuffix(filename, ".md"),
+			Requirements: requirements,
+		})
+	}
+
+	rules, err := listMarkdownFiles(filepath.Join(specPath, ruleDir))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read rule directory: %w", err)
+	}
+	for _, filename := range rules {
+		manifest.Rules = append(manifest.Rules, strings.TrimSuffix(filename, ".md"))
+	}
+
+	maintenance, err := listMaintenanceFiles(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance items: %w", err)
+	}
+	for _, slug := range maintenance {
+		reqs, err := workspace.ParseMaintenanceFile(filepath.Join(specPath, maintenanceDir, slug+".md"), state, slug)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse maintenance item %s: %w", slug, err)
+		}
+		item := ManifestMaintenance{Slug: slug, Requirements: len(reqs)}
+		for _, req := range reqs {
+			if req.Due {
+				item.Due++
+			}
+		}
+		manifest.Maintenance = append(manifest.Maintenance, item)
+	}
+
+	components, _, err := loadDocNames()
+	if err != nil {
+		return nil, err
+	}
+	for _, comp := range components {
+		manifest.Docs = append(manifest.Docs, ManifestDoc{Name: comp.Name, Source: comp.Source})
+	}
+
+	return manifest, nil
+}
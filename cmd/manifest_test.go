@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestListWorkspaceManifest(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	for _, dir := range []string{proposalDir, sectionDir, ruleDir, maintenanceDir, docsDir} {
+		if err := os.MkdirAll(filepath.Join(specPath, dir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	oldDocsPath := docsPath
+	docsPath = filepath.Join(specPath, docsDir)
+	t.Cleanup(func() { docsPath = oldDocsPath })
+
+	files := map[string]string{
+		filepath.Join(sectionDir, "oauth-login.md"):      "# OAuth Login\n\nUsers MUST sign in.\n",
+		filepath.Join(ruleDir, "no-external-deps.md"):    "# No External Deps\n",
+		filepath.Join(maintenanceDir, "dependencies.md"): "# Maintenance: Dependencies\n\n## Requirements\n- Update modules [id=update] [freq=weekly]\n",
+		filepath.Join(docsDir, "ui.md"):                  "# Button\n\nA button.\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(specPath, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	runSpecProposalAdd(specProposalAddCmd, []string{"refresh-tokens"})
+
+	out := captureStdout(t, func() {
+		runSpecListWorkspace(specListWorkspaceCmd, nil)
+	})
+
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(out), &top); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	var keys []string
+	for key := range top {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	want := "docs,maintenance,primary,proposals,rules,schema_version,sections"
+	if got := strings.Join(keys, ","); got != want {
+		t.Fatalf("top-level keys = %s, want %s", got, want)
+	}
+
+	var manifest WorkspaceManifest
+	if err := json.Unmarshal([]byte(out), &manifest); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if manifest.SchemaVersion != manifestSchemaVersion {
+		t.Errorf("schema_version = %d", manifest.SchemaVersion)
+	}
+	if len(manifest.Proposals) != 1 || manifest.Proposals[0].Slug != "refresh-tokens" || manifest.Proposals[0].Status != "pending" {
+		t.Errorf("proposals = %+v", manifest.Proposals)
+	}
+	if len(manifest.Sections) != 1 || manifest.Sections[0].Slug != "oauth-login" {
+		t.Errorf("sections = %+v", manifest.Sections)
+	}
+	if len(manifest.Rules) != 1 || manifest.Rules[0] != "no-external-deps" {
+		t.Errorf("rules = %v", manifest.Rules)
+	}
+	if len(manifest.Maintenance) != 1 || manifest.Maintenance[0].Due != 1 {
+		t.Errorf("maintenance = %+v", manifest.Maintenance)
+	}
+	if len(manifest.Docs) == 0 || manifest.Docs[0].Source != "ui.md" {
+		t.Errorf("docs = %+v", manifest.Docs)
+	}
+}
@@ -3,14 +3,17 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
 const integrityWarning = `WARNING: Proposal files have changed since activation.
@@ -18,7 +21,7 @@ const integrityWarning = `WARNING: Proposal files have changed since activation.
 Changed files: %s
 
 User confirmation is required before continuing. Please ask the user to either:
-1. Re-activate the proposal to update the file hashes: nocturnal spec proposal activate %s
+1. Accept the changes and update the file hashes: nocturnal spec proposal touch %s
 2. Or confirm they want to proceed with the modified files
 
 Do not proceed with implementation until the user confirms.`
@@ -29,34 +32,68 @@ var mcpCmd = &cobra.Command{
 	Run:   runMCP,
 }
 
+var (
+	mcpReadOnly bool
+	mcpLogFile  string
+)
+
 func init() {
 	mcpCmd.Long = helpText("mcp")
+	mcpCmd.Flags().BoolVar(&mcpReadOnly, "read-only", false, "Only expose tools and prompts that do not modify the workspace")
+	mcpCmd.Flags().StringVar(&mcpLogFile, "log-file", "", "Append a JSON log line for each tool call to this file")
 	rootCmd.AddCommand(mcpCmd)
 }
 
+// mcpRegistration is a tool or prompt exposed by the MCP server. Mutating
+// entries change the workspace, or direct the agent to do so through a
+// mutating tool, and are skipped in read-only mode.
+type mcpRegistration struct {
+	name     string
+	mutating bool
+	register func(*server.MCPServer)
+}
+
+var mcpTools = []mcpRegistration{
+	{name: "context", register: registerContextTool},
+	{name: "tasks", register: registerTasksTool},
+	{name: "task_complete", mutating: true, register: registerTaskCompleteTool},
+	{name: "docs_list", register: registerDocsListTool},
+	{name: "docs_search", register: registerDocsSearchTool},
+	{name: "docs_add", mutating: true, register: registerDocsAddTool},
+	{name: "maintenance_list", register: registerMaintenanceListTool},
+	{name: "maintenance_due", register: registerMaintenanceDueTool},
+	{name: "stats", register: registerStatsTool},
+	{name: "graph", register: registerGraphTool},
+}
+
+var mcpPrompts = []mcpRegistration{
+	{name: "add-third-party-docs", mutating: true, register: registerAddThirdPartyDocsPrompt},
+	{name: "elaborate-spec", register: registerElaborateSpecPrompt},
+	{name: "start-implementation", mutating: true, register: registerStartImplementationPrompt},
+	{name: "lazy", mutating: true, register: registerLazyPrompt},
+	{name: "start-maintenance", mutating: true, register: registerStartMaintenancePrompt},
+	{name: "populate-spec-sections", register: registerPopulateSpecSectionsPrompt},
+	{name: "draft-proposal", register: registerDraftProposalPrompt},
+}
+
 func runMCP(cmd *cobra.Command, args []string) {
-	s := server.NewMCPServer(
-		"nocturnal",
-		Version,
-		server.WithToolCapabilities(true),
-		server.WithPromptCapabilities(true),
-	)
+	var logger *slog.Logger
+	if mcpLogFile != "" {
+		var f *os.File
+		var err error
+		logger, f, err = openMCPLog(mcpLogFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "MCP server error: %v\n", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+	}
 
-	// Tools
-	registerContextTool(s)
-	registerTasksTool(s)
-	registerTaskCompleteTool(s)
-	registerDocsListTool(s)
-	registerDocsSearchTool(s)
-	registerMaintenanceListTool(s)
-
-	// Prompts
-	registerAddThirdPartyDocsPrompt(s)
-	registerElaborateSpecPrompt(s)
-	registerStartImplementationPrompt(s)
-	registerLazyPrompt(s)
-	registerStartMaintenancePrompt(s)
-	registerPopulateSpecSectionsPrompt(s)
+	s := newMCPServer(mcpReadOnly, logger)
+
+	if mcpReadOnly {
+		fmt.Fprintln(os.Stderr, "nocturnal MCP server running in read-only mode")
+	}
 
 	if err := server.ServeStdio(s); err != nil {
 		fmt.Fprintf(os.Stderr, "MCP server error: %v\n", err)
@@ -64,15 +101,44 @@ func runMCP(cmd *cobra.Command, args []string) {
 	}
 }
 
+// newMCPServer creates the MCP server with all tools and prompts registered,
+// leaving out mutating ones when readOnly is set. Tool calls are logged to
+// logger unless it is nil.
+func newMCPServer(readOnly bool, logger *slog.Logger) *server.MCPServer {
+	options := []server.ServerOption{
+		server.WithToolCapabilities(true),
+		server.WithPromptCapabilities(true),
+	}
+	if logger != nil {
+		options = append(options, server.WithToolHandlerMiddleware(logToolCalls(logger)))
+	}
+	s := server.NewMCPServer("nocturnal", Version, options...)
+
+	for _, entries := range [][]mcpRegistration{mcpTools, mcpPrompts} {
+		for _, entry := range entries {
+			if readOnly && entry.mutating {
+				continue
+			}
+			entry.register(s)
+		}
+	}
+
+	return s
+}
+
 func registerContextTool(s *server.MCPServer) {
 	tool := mcp.NewTool("context",
-		mcp.WithDescription("Get project context for implementing the active proposal: rules, project design, specification, and design docs. Optionally include maintenance context."),
+		mcp.WithDescription("Get project context for implementing the active proposal: rules, project design, specification, and design docs. Set include_implementation to also get the current phase tasks from implementation.md (as returned by the tasks tool). Optionally include maintenance context."),
 		mcp.WithString("maintenance_slug",
 			mcp.Description("Optional: maintenance item slug to include maintenance context"),
 		),
+		mcp.WithBoolean("include_implementation",
+			mcp.Description("Optional: also include the current phase tasks from implementation.md (default false)"),
+		),
+		withMaxBytesArg(),
 	)
 
-	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	s.AddTool(tool, limitOutput(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		specPath, err := checkSpecWorkspace()
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
@@ -97,7 +163,7 @@ func registerContextTool(s *server.MCPServer) {
 			}
 			// Check if project.md exists
 			projectPath := filepath.Join(specPath, projectFile)
-			if fileExists(projectPath) {
+			if workspace.FileExists(projectPath) {
 				projectExists = true
 			}
 		}
@@ -107,7 +173,7 @@ func registerContextTool(s *server.MCPServer) {
 		if maintenanceSlug != "" {
 			// Maintenance context
 			filePath := filepath.Join(specPath, maintenanceDir, maintenanceSlug+".md")
-			if !fileExists(filePath) {
+			if !workspace.FileExists(filePath) {
 				return mcp.NewToolResultError(fmt.Sprintf("Maintenance item '%s' does not exist", maintenanceSlug)), nil
 			}
 
@@ -116,7 +182,7 @@ func registerContextTool(s *server.MCPServer) {
 				return mcp.NewToolResultError(fmt.Sprintf("Failed to load state: %v", err)), nil
 			}
 
-			reqs, err := parseMaintenanceFile(filePath, state, maintenanceSlug)
+			reqs, err := workspace.ParseMaintenanceFile(filePath, state, maintenanceSlug)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Failed to parse maintenance file: %v", err)), nil
 			}
@@ -133,7 +199,7 @@ func registerContextTool(s *server.MCPServer) {
 			}
 
 			// Separate due and not-due requirements
-			var dueReqs, notDueReqs []MaintenanceRequirement
+			var dueReqs, notDueReqs []workspace.MaintenanceRequirement
 			for _, req := range reqs {
 				if req.Due {
 					dueReqs = append(dueReqs, req)
@@ -229,6 +295,16 @@ func registerContextTool(s *server.MCPServer) {
 			sections = append(sections, activeHeader+"\n(No specification.md or design.md found)")
 		}
 
+		// Implementation tasks, on request
+		includeImplementation, _ := request.Params.Arguments["include_implementation"].(bool)
+		if includeImplementation {
+			tasks, err := formatProposalTasks(slug, proposalPath, false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sections = append(sections, tasks)
+		}
+
 		// Include affected files if configured
 		config := loadConfigOrDefault(specPath)
 		affectedFileCount := 0
@@ -254,8 +330,8 @@ func registerContextTool(s *server.MCPServer) {
 		summary.WriteString(fmt.Sprintf("- Active Proposal: %s\n", slug))
 
 		// Check if spec and design files exist
-		specExists := fileExists(filepath.Join(proposalPath, "specification.md"))
-		designExists := fileExists(filepath.Join(proposalPath, "design.md"))
+		specExists := workspace.FileExists(filepath.Join(proposalPath, "specification.md"))
+		designExists := workspace.FileExists(filepath.Join(proposalPath, "design.md"))
 
 		if specExists {
 			summary.WriteString("- Specification: included\n")
@@ -269,12 +345,20 @@ func registerContextTool(s *server.MCPServer) {
 			summary.WriteString("- Design: not found\n")
 		}
 
+		if includeImplementation {
+			if workspace.FileExists(filepath.Join(proposalPath, "implementation.md")) {
+				summary.WriteString("- Implementation: included\n")
+			} else {
+				summary.WriteString("- Implementation: not found\n")
+			}
+		}
+
 		if config.Context.IncludeAffectedFiles {
 			summary.WriteString(fmt.Sprintf("- Affected Files: %d file(s)\n", affectedFileCount))
 		}
 
 		return mcp.NewToolResultText(summary.String() + "\n\n---\n\n" + strings.Join(sections, "\n\n---\n\n")), nil
-	})
+	}))
 }
 
 func registerTasksTool(s *server.MCPServer) {
@@ -283,9 +367,13 @@ func registerTasksTool(s *server.MCPServer) {
 		mcp.WithString("maintenance_slug",
 			mcp.Description("Optional: maintenance item slug to get maintenance tasks instead of proposal tasks"),
 		),
+		mcp.WithBoolean("only_open",
+			mcp.Description("Optional: list only incomplete (- [ ]) tasks of the current phase"),
+		),
+		withMaxBytesArg(),
 	)
 
-	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	s.AddTool(tool, limitOutput(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		specPath, err := checkSpecWorkspace()
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
@@ -296,7 +384,7 @@ func registerTasksTool(s *server.MCPServer) {
 		if maintenanceSlug != "" {
 			// Maintenance tasks
 			filePath := filepath.Join(specPath, maintenanceDir, maintenanceSlug+".md")
-			if !fileExists(filePath) {
+			if !workspace.FileExists(filePath) {
 				return mcp.NewToolResultError(fmt.Sprintf("Maintenance item '%s' does not exist", maintenanceSlug)), nil
 			}
 
@@ -305,12 +393,12 @@ func registerTasksTool(s *server.MCPServer) {
 				return mcp.NewToolResultError(fmt.Sprintf("Failed to load state: %v", err)), nil
 			}
 
-			reqs, err := parseMaintenanceFile(filePath, state, maintenanceSlug)
+			reqs, err := workspace.ParseMaintenanceFile(filePath, state, maintenanceSlug)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Failed to parse maintenance file: %v", err)), nil
 			}
 
-			var dueReqs []MaintenanceRequirement
+			var dueReqs []workspace.MaintenanceRequirement
 			for _, req := range reqs {
 				if req.Due {
 					dueReqs = append(dueReqs, req)
@@ -349,46 +437,121 @@ func registerTasksTool(s *server.MCPServer) {
 			return mcp.NewToolResultError("No active proposal"), nil
 		}
 
-		implPath := filepath.Join(proposalPath, "implementation.md")
-		implContent, err := os.ReadFile(implPath)
+		onlyOpen, _ := request.Params.Arguments["only_open"].(bool)
+		tasks, err := formatProposalTasks(slug, proposalPath, onlyOpen)
 		if err != nil {
-			if os.IsNotExist(err) {
-				return mcp.NewToolResultText("No implementation.md found"), nil
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return mcp.NewToolResultText(tasks), nil
+	}))
+}
+
+// formatProposalTasks renders the current phase of a proposal's
+// implementation.md. With onlyOpen set, completed tasks are left out.
+func formatProposalTasks(slug, proposalPath string, onlyOpen bool) (string, error) {
+	implContent, err := os.ReadFile(filepath.Join(proposalPath, "implementation.md"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "No implementation.md found", nil
+		}
+		return "", fmt.Errorf("failed to read implementation.md: %w", err)
+	}
+
+	total, completed := workspace.ProposalProgress(proposalPath)
+	phases := extractPhases(string(implContent))
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("# Tasks for: %s\n\n", slug))
+	result.WriteString(fmt.Sprintf("Progress: %d/%d tasks complete\n\n", completed, total))
+
+	if len(phases) == 0 {
+		result.WriteString("No phases found in implementation.md")
+		return result.String(), nil
+	}
+
+	// Find current phase number
+	currentPhaseNum := 0
+	for i, p := range phases {
+		if !p.Complete {
+			currentPhaseNum = i + 1
+			break
+		}
+	}
+
+	currentPhase := getCurrentPhase(phases)
+	if currentPhase == nil {
+		result.WriteString("All phases complete!")
+		return result.String(), nil
+	}
+
+	phase := *currentPhase
+	if onlyOpen {
+		phase.Tasks = nil
+		for _, task := range currentPhase.Tasks {
+			if !task.Complete {
+				phase.Tasks = append(phase.Tasks, task)
 			}
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to read implementation.md: %v", err)), nil
 		}
+	}
+	result.WriteString(formatPhaseForContext(&phase, currentPhaseNum, len(phases)))
+	result.WriteString("\n\n> Once all tasks in this phase are complete, the next phase will appear.")
 
-		total, completed := getProposalProgress(proposalPath)
-		phases := extractPhases(string(implContent))
+	return result.String(), nil
+}
 
-		var result strings.Builder
-		result.WriteString(fmt.Sprintf("# Tasks for: %s\n\n", slug))
-		result.WriteString(fmt.Sprintf("Progress: %d/%d tasks complete\n\n", completed, total))
+// withMaxBytesArg declares the optional max_bytes argument shared by tools
+// whose output can grow with the size of the workspace.
+func withMaxBytesArg() mcp.ToolOption {
+	return mcp.WithNumber("max_bytes",
+		mcp.Description("Optional: truncate the output to this many bytes (defaults to context.max_output_bytes; 0 means no limit)"),
+	)
+}
 
-		if len(phases) == 0 {
-			result.WriteString("No phases found in implementation.md")
-			return mcp.NewToolResultText(result.String()), nil
+// limitOutput wraps a tool handler so that its text result is truncated to
+// the max_bytes argument, falling back to context.max_output_bytes.
+func limitOutput(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := handler(ctx, request)
+		if err != nil || result == nil || result.IsError {
+			return result, err
+		}
+
+		maxBytes := 0
+		if value, ok := request.Params.Arguments["max_bytes"].(float64); ok {
+			maxBytes = int(value)
+		} else if specPath, err := checkSpecWorkspace(); err == nil {
+			maxBytes = loadConfigOrDefault(specPath).Context.MaxOutputBytes
 		}
 
-		// Find current phase number
-		currentPhaseNum := 0
-		for i, p := range phases {
-			if !p.Complete {
-				currentPhaseNum = i + 1
-				break
+		for i, content := range result.Content {
+			if text, ok := content.(mcp.TextContent); ok {
+				text.Text = truncateOutput(text.Text, maxBytes)
+				result.Content[i] = text
 			}
 		}
+		return result, nil
+	}
+}
 
-		currentPhase := getCurrentPhase(phases)
-		if currentPhase == nil {
-			result.WriteString("All phases complete!")
-		} else {
-			result.WriteString(formatPhaseForContext(currentPhase, currentPhaseNum, len(phases)))
-			result.WriteString("\n\n> Once all tasks in this phase are complete, the next phase will appear.")
+// truncateOutput cuts text to at most maxBytes, backing up to the last line
+// break, and appends a note saying how much was left out. A maxBytes of zero
+// or less leaves text unchanged.
+func truncateOutput(text string, maxBytes int) string {
+	if maxBytes <= 0 || len(text) <= maxBytes {
+		return text
+	}
+
+	cut := text[:maxBytes]
+	if idx := strings.LastIndex(cut, "\n"); idx > 0 {
+		cut = cut[:idx+1]
+	} else {
+		// No line break to stop at; avoid splitting a multi-byte rune
+		for len(cut) > 0 && !utf8.ValidString(cut) {
+			cut = cut[:len(cut)-1]
 		}
+	}
 
-		return mcp.NewToolResultText(result.String()), nil
-	})
+	return fmt.Sprintf("%s\n[Output truncated: showing %d of %d bytes. Call again with a larger max_bytes (or max_bytes=0 for everything) to see the rest.]", cut, len(cut), len(text))
 }
 
 func extractOpenTasks(content string) []string {
@@ -409,6 +572,7 @@ type Phase struct {
 	Tasks     []Task
 	Milestone string
 	Complete  bool
+	Line      int // Line number of the phase header (1-indexed)
 }
 
 // Task represents a single task checkbox.
@@ -445,7 +609,7 @@ func extractPhases(content string) []Phase {
 				name = strings.TrimSpace(trimmed[colonIdx+1:])
 			}
 
-			currentPhase = &Phase{Name: name}
+			currentPhase = &Phase{Name: name, Line: i + 1}
 			continue
 		}
 
@@ -634,7 +798,7 @@ func registerDocsListTool(s *server.MCPServer) {
 	)
 
 	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		components, err := loadDocs()
+		components, _, err := loadDocs()
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to load docs: %v", err)), nil
 		}
@@ -649,43 +813,109 @@ func registerDocsListTool(s *server.MCPServer) {
 
 func registerDocsSearchTool(s *server.MCPServer) {
 	tool := mcp.NewTool("docs_search",
-		mcp.WithDescription("Search library and API documentation by name. Returns full content of matching documentation."),
+		mcp.WithDescription("Search library and API documentation by name. Returns full content of matching documentation, best matches first."),
 		mcp.WithString("query",
 			mcp.Required(),
 			mcp.Description("Search query to match against component names"),
 		),
+		mcp.WithBoolean("fuzzy",
+			mcp.Description("Optional: also match names containing the query's characters in order"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Optional: return at most this many results (0 means no limit)"),
+		),
 	)
 
-	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		query, ok := request.Params.Arguments["query"].(string)
-		if !ok {
-			return mcp.NewToolResultError("query parameter must be a string"), nil
-		}
+	s.AddTool(tool, handleDocsSearch)
+}
 
-		components, err := loadDocs()
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Failed to load docs: %v", err)), nil
-		}
+func handleDocsSearch(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	query, err := requireStringArg(request, "query")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
-		if len(components) == 0 {
-			return mcp.NewToolResultText("No documentation found"), nil
-		}
+	components, _, err := loadDocNames()
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to load docs: %v", err)), nil
+	}
 
-		matches := searchDocs(components, query)
-		if len(matches) == 0 {
-			return mcp.NewToolResultText(fmt.Sprintf("No components found matching '%s'. Use docs_list to see all available components.", query)), nil
-		}
+	if len(components) == 0 {
+		return mcp.NewToolResultText("No documentation found"), nil
+	}
 
-		return mcp.NewToolResultText(formatDocsSearchOutput(matches)), nil
-	})
+	fuzzy, _ := request.Params.Arguments["fuzzy"].(bool)
+	limit, _ := request.Params.Arguments["limit"].(float64)
+
+	matches := searchDocs(components, query, fuzzy, int(limit))
+	if len(matches) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No components found matching '%s'. Use docs_list to see all available components.", query)), nil
+	}
+	if err := loadDocContent(matches); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to load docs: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(formatDocsSearchOutput(matches)), nil
+}
+
+func registerDocsAddTool(s *server.MCPServer) {
+	tool := mcp.NewTool("docs_add",
+		mcp.WithDescription("Save condensed third-party documentation to spec/third. The content is appended to <name>.md as a new section, headed '# <name>' unless it starts with its own '# ' heading. Separate further sections within the content with '---' lines, each followed by a '# ' heading."),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Library or component name; also names the file (e.g. 'Bubble Tea' is saved to bubble-tea.md)"),
+		),
+		mcp.WithString("content",
+			mcp.Required(),
+			mcp.Description("Markdown documentation to add"),
+		),
+	)
+
+	s.AddTool(tool, handleDocsAdd)
+}
+
+func handleDocsAdd(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, err := requireStringArg(request, "name")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	content, ok := request.Params.Arguments["content"].(string)
+	if !ok {
+		return mcp.NewToolResultError("content parameter is required"), nil
+	}
+
+	path, count, err := appendDocs(docsPath, name, content)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to add docs: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Saved documentation to %s (%d component(s) in file)", path, count)), nil
+}
+
+// requireStringArg returns a required string argument with surrounding and
+// repeated whitespace collapsed. Missing, non-string, or blank values are errors.
+func requireStringArg(request mcp.CallToolRequest, key string) (string, error) {
+	raw := mcp.ParseArgument(request, key, nil)
+	if raw == nil {
+		return "", fmt.Errorf("%s parameter is required", key)
+	}
+	if _, ok := raw.(string); !ok {
+		return "", fmt.Errorf("%s parameter must be a string", key)
+	}
+
+	value := strings.Join(strings.Fields(mcp.ParseString(request, key, "")), " ")
+	if value == "" {
+		return "", fmt.Errorf("%s parameter must not be empty", key)
+	}
+	return value, nil
 }
 
 func registerTaskCompleteTool(s *server.MCPServer) {
 	tool := mcp.NewTool("task_complete",
-		mcp.WithDescription("Mark a task as complete in the active proposal's implementation.md or mark a maintenance requirement as actioned. For proposals, use task ID (e.g., '1.1', '2.3'). For maintenance, provide maintenance_slug and requirement ID. If git.auto_commit is enabled, automatically commits all changes."),
+		mcp.WithDescription("Mark a task as complete in the active proposal's implementation.md or mark a maintenance requirement as actioned. For proposals, use the task ID (e.g., '1.1', '2.3') or the task's {#id} marker. For maintenance, provide maintenance_slug and requirement ID. If git.auto_commit is enabled, automatically commits all changes."),
 		mcp.WithString("id",
 			mcp.Required(),
-			mcp.Description("The task ID to mark as complete (e.g., '1.1' for phase 1, task 1) or maintenance requirement ID"),
+			mcp.Description("The task ID to mark as complete (e.g., '1.1' for phase 1, task 1, or a {#id} marker such as 'add-login') or maintenance requirement ID"),
 		),
 		mcp.WithString("maintenance_slug",
 			mcp.Description("Optional: maintenance item slug if marking a maintenance requirement as actioned"),
@@ -709,7 +939,7 @@ func registerTaskCompleteTool(s *server.MCPServer) {
 		if maintenanceSlug != "" {
 			// Maintenance actioned
 			filePath := filepath.Join(specPath, maintenanceDir, maintenanceSlug+".md")
-			if !fileExists(filePath) {
+			if !workspace.FileExists(filePath) {
 				return mcp.NewToolResultError(fmt.Sprintf("Maintenance item '%s' does not exist", maintenanceSlug)), nil
 			}
 
@@ -719,7 +949,7 @@ func registerTaskCompleteTool(s *server.MCPServer) {
 			}
 
 			// Parse file to validate ID exists
-			reqs, err := parseMaintenanceFile(filePath, state, maintenanceSlug)
+			reqs, err := workspace.ParseMaintenanceFile(filePath, state, maintenanceSlug)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Failed to parse maintenance file: %v", err)), nil
 			}
@@ -740,14 +970,14 @@ func registerTaskCompleteTool(s *server.MCPServer) {
 
 			// Update state
 			if state.Maintenance == nil {
-				state.Maintenance = make(map[string]map[string]MaintenanceState)
+				state.Maintenance = make(map[string]map[string]workspace.MaintenanceState)
 			}
 			if state.Maintenance[maintenanceSlug] == nil {
-				state.Maintenance[maintenanceSlug] = make(map[string]MaintenanceState)
+				state.Maintenance[maintenanceSlug] = make(map[string]workspace.MaintenanceState)
 			}
 
 			timestamp := time.Now().Format(time.RFC3339)
-			state.Maintenance[maintenanceSlug][taskID] = MaintenanceState{
+			state.Maintenance[maintenanceSlug][taskID] = workspace.MaintenanceState{
 				LastActioned: timestamp,
 			}
 
@@ -774,20 +1004,7 @@ func registerTaskCompleteTool(s *server.MCPServer) {
 		}
 
 		// Parse phases to find the task by ID
-		phases := extractPhases(string(content))
-		var targetTask *Task
-		for _, phase := range phases {
-			for i := range phase.Tasks {
-				if phase.Tasks[i].ID == taskID {
-					targetTask = &phase.Tasks[i]
-					break
-				}
-			}
-			if targetTask != nil {
-				break
-			}
-		}
-
+		targetTask := findTask(extractPhases(string(content)), taskID)
 		if targetTask == nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Task ID not found: %s", taskID)), nil
 		}
@@ -811,25 +1028,23 @@ func registerTaskCompleteTool(s *server.MCPServer) {
 
 		// Replace the task at the specific line
 		lines := strings.Split(string(content), "\n")
-		lineIdx := targetTask.Line - 1 // Convert to 0-indexed
-
-		if lineIdx < 0 || lineIdx >= len(lines) {
+		if !setTaskChecked(lines, *targetTask, true) {
 			return mcp.NewToolResultError("Internal error: invalid line number"), nil
 		}
 
-		line := lines[lineIdx]
-		// Preserve indentation and replace checkbox
-		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
-		lines[lineIdx] = indent + "- [x] " + targetTask.Text
-
 		// Write back
 		newContent := strings.Join(lines, "\n")
 		if err := os.WriteFile(implPath, []byte(newContent), 0644); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to write implementation.md: %v", err)), nil
 		}
 
+		// Feeds 'spec stats --velocity'; not worth failing the task over
+		if err := recordTaskCompletion(specPath, slug, taskID); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to record task completion: %v\n", err)
+		}
+
 		// Get updated progress
-		total, completed := getProposalProgress(proposalPath)
+		total, completed := workspace.ProposalProgress(proposalPath)
 		updatedPhases := extractPhases(newContent)
 		currentPhase := getCurrentPhase(updatedPhases)
 
@@ -871,7 +1086,7 @@ func registerAddThirdPartyDocsPrompt(s *server.MCPServer) {
 	s.AddPrompt(prompt, func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
 		urls, _ := request.Params.Arguments["urls"]
 
-		promptText := fmt.Sprintf(`You'll write a condensed version of the documentation to spec/third. If there are any key references missing, fetch them from the web as well. The goal is to develop a solid understanding of the library. These docs are intended to provide an AI agent with a clear overview of the library or technology, including its usage and where to find additional information. Be as concise as possible to avoid overwhelming the AI's context.
+		promptText := fmt.Sprintf(`You'll write a condensed version of the documentation and save it to spec/third with the docs_add tool, one call per library. If there are any key references missing, fetch them from the web as well. The goal is to develop a solid understanding of the library. These docs are intended to provide an AI agent with a clear overview of the library or technology, including its usage and where to find additional information. Be as concise as possible to avoid overwhelming the AI's context.
 
 Separate each logical section with \n---\n, and immediately after the separator, include a header marked with #. Whenever possible, include direct links to the relevant documentation alongside any components or classes.
 
@@ -1513,7 +1728,7 @@ func registerMaintenanceListTool(s *server.MCPServer) {
 
 		for _, slug := range slugs {
 			filePath := filepath.Join(specPath, maintenanceDir, slug+".md")
-			reqs, err := parseMaintenanceFile(filePath, state, slug)
+			reqs, err := workspace.ParseMaintenanceFile(filePath, state, slug)
 			if err != nil {
 				result.WriteString(fmt.Sprintf("- %s: error parsing (%v)\n", slug, err))
 				continue
@@ -1533,6 +1748,215 @@ func registerMaintenanceListTool(s *server.MCPServer) {
 	})
 }
 
+func registerMaintenanceDueTool(s *server.MCPServer) {
+	tool := mcp.NewTool("maintenance_due",
+		mcp.WithDescription("List currently due maintenance requirements with their id, text, frequency, and last actioned time."),
+		mcp.WithString("slug",
+			mcp.Description("Optional: only report the given maintenance item. Defaults to all items"),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		specPath, err := checkSpecWorkspace()
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		slug, _ := request.Params.Arguments["slug"].(string)
+		output, err := formatMaintenanceDue(specPath, strings.TrimSpace(slug))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(output), nil
+	})
+}
+
+// formatMaintenanceDue renders the due requirements of one maintenance item,
+// or of every item when slug is empty, as markdown for MCP clients.
+func formatMaintenanceDue(specPath, slug string) (string, error) {
+	slugs := []string{slug}
+	if slug == "" {
+		var err error
+		slugs, err = listMaintenanceFiles(specPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to list maintenance items: %w", err)
+		}
+	} else if !workspace.FileExists(filepath.Join(specPath, maintenanceDir, slug+".md")) {
+		return "", fmt.Errorf("maintenance item '%s' does not exist", slug)
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to load state: %w", err)
+	}
+
+	var result strings.Builder
+	totalDue := 0
+	for _, itemSlug := range slugs {
+		filePath := filepath.Join(specPath, maintenanceDir, itemSlug+".md")
+		reqs, err := workspace.ParseMaintenanceFile(filePath, state, itemSlug)
+		if err != nil {
+			result.WriteString(fmt.Sprintf("## %s\n\nError parsing: %v\n\n", itemSlug, err))
+			continue
+		}
+
+		var due []workspace.MaintenanceRequirement
+		for _, req := range reqs {
+			if req.Due {
+				due = append(due, req)
+			}
+		}
+		if len(due) == 0 {
+			continue
+		}
+		totalDue += len(due)
+
+		result.WriteString(fmt.Sprintf("## %s (%d due)\n\n", itemSlug, len(due)))
+		for _, req := range due {
+			freq := req.Freq
+			if freq == "" {
+				freq = "always"
+			}
+			last := req.LastActioned
+			if last == "" {
+				last = "never"
+			}
+			result.WriteString(fmt.Sprintf("- [%s] %s (freq: %s, last actioned: %s)\n", req.ID, req.Text, freq, last))
+		}
+		result.WriteString("\n")
+	}
+
+	if totalDue == 0 && result.Len() == 0 {
+		if slug != "" {
+			return fmt.Sprintf("Nothing due in maintenance item '%s'", slug), nil
+		}
+		return "Nothing due: all maintenance requirements are up to date", nil
+	}
+
+	return fmt.Sprintf("# Due Maintenance (%d)\n\n", totalDue) + result.String(), nil
+}
+
+func registerStatsTool(s *server.MCPServer) {
+	tool := mcp.NewTool("stats",
+		mcp.WithDescription("Get project statistics: completed specifications, requirement counts by type, proposal counts, and current proposal progress."),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		specPath, err := checkSpecWorkspace()
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		stats, err := gatherStats(specPath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to gather stats: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(formatStatsOutput(stats)), nil
+	})
+}
+
+// formatStatsOutput renders stats as markdown for MCP clients.
+func formatStatsOutput(stats *Stats) string {
+	var result strings.Builder
+	result.WriteString("# Project Statistics\n\n")
+
+	result.WriteString("## Specifications\n\n")
+	result.WriteString(fmt.Sprintf("- Completed: %d\n", stats.CompletedSpecs))
+	result.WriteString(fmt.Sprintf("- Requirements: %d (MUST: %d, SHOULD: %d, MAY: %d)\n\n",
+		stats.TotalRequirements, stats.MustCount, stats.ShouldCount, stats.MayCount))
+
+	result.WriteString("## Proposals\n\n")
+	result.WriteString(fmt.Sprintf("- Active: %d\n", stats.ActiveProposals))
+	result.WriteString(fmt.Sprintf("- Pending: %d\n", stats.PendingProposals))
+	result.WriteString(fmt.Sprintf("- Archived: %d (%d completed, %d abandoned)\n",
+		stats.ArchivedTotal, stats.ArchivedCompleted, stats.ArchivedAbandoned))
+	if stats.ActiveEstimatedEffort > 0 || stats.ActiveActualEffort > 0 {
+		result.WriteString(fmt.Sprintf("- Effort (active): %s estimated, %s actual\n",
+			formatEffort(stats.ActiveEstimatedEffort), formatEffort(stats.ActiveActualEffort)))
+	}
+	result.WriteString("\n")
+
+	result.WriteString("## Progress\n\n")
+	if stats.CurrentProposal == "" {
+		result.WriteString("- Current: no active proposal\n")
+		return result.String()
+	}
+	result.WriteString(fmt.Sprintf("- Current: %s\n", stats.CurrentProposal))
+	if stats.CurrentTotal > 0 {
+		percentage := (stats.CurrentCompleted * 100) / stats.CurrentTotal
+		result.WriteString(fmt.Sprintf("- Tasks: %d/%d (%d%%)\n", stats.CurrentCompleted, stats.CurrentTotal, percentage))
+	} else {
+		result.WriteString("- Tasks: no tasks defined\n")
+	}
+
+	return result.String()
+}
+
+func registerGraphTool(s *server.MCPServer) {
+	tool := mcp.NewTool("graph",
+		mcp.WithDescription("Get the proposal dependency graph, including completed specifications and any circular dependency warnings."),
+		mcp.WithString("slug",
+			mcp.Description("Optional: only show the given proposal and the proposals related to it"),
+		),
+		mcp.WithString("format",
+			mcp.Description("Optional: output format (ascii, tree, dot, or mermaid). Defaults to ascii"),
+			mcp.Enum("ascii", "tree", "dot", "mermaid"),
+		),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		specPath, err := checkSpecWorkspace()
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		filterSlug, _ := request.Params.Arguments["slug"].(string)
+		filterSlug = strings.TrimSpace(filterSlug)
+		format, _ := request.Params.Arguments["format"].(string)
+		format = strings.TrimSpace(format)
+		if format == "" {
+			format = "ascii"
+		}
+
+		nodes, err := buildDependencyGraph(specPath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to build graph: %v", err)), nil
+		}
+
+		if len(nodes) == 0 {
+			return mcp.NewToolResultText("No proposals found"), nil
+		}
+
+		if filterSlug != "" {
+			if node, exists := nodes[filterSlug]; !exists || node.IsMissing {
+				return mcp.NewToolResultError(fmt.Sprintf("Proposal '%s' not found", filterSlug)), nil
+			}
+		}
+
+		output, err := renderGraph(nodes, filterSlug, format, nil)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var result strings.Builder
+		if cycles := detectCycles(nodes); len(cycles) > 0 {
+			result.WriteString("WARNING: Circular dependencies detected:\n")
+			for _, cycle := range cycles {
+				result.WriteString(fmt.Sprintf("- %s\n", strings.Join(cycle, " -> ")))
+			}
+			result.WriteString("\n")
+		}
+		if missing := missingDependencies(nodes); len(missing) > 0 {
+			result.WriteString(fmt.Sprintf("WARNING: Unresolved dependencies: %s\n\n", strings.Join(missing, ", ")))
+		}
+		result.WriteString(output)
+
+		return mcp.NewToolResultText(result.String()), nil
+	})
+}
+
 func registerStartMaintenancePrompt(s *server.MCPServer) {
 	prompt := mcp.NewPrompt("start-maintenance",
 		mcp.WithPromptDescription("Execute maintenance requirements for a maintenance item"),
@@ -1923,3 +2347,107 @@ Begin by understanding the project. Ask the user for the project description and
 		}, nil
 	})
 }
+
+// readGuideline returns the workspace copy of a guideline file, falling back
+// to the embedded template when the workspace has none.
+func readGuideline(filename string) (string, error) {
+	if specPath, err := checkSpecWorkspace(); err == nil {
+		if content, err := os.ReadFile(filepath.Join(specPath, filename)); err == nil {
+			return string(content), nil
+		}
+	}
+	return readTemplate("templates/" + filename)
+}
+
+func registerDraftProposalPrompt(s *server.MCPServer) {
+	prompt := mcp.NewPrompt("draft-proposal",
+		mcp.WithPromptDescription("Draft a new proposal from an idea, following the workspace's specification and design guidelines"),
+		mcp.WithArgument("topic",
+			mcp.ArgumentDescription("Short description of the idea to turn into a proposal"),
+			mcp.RequiredArgument(),
+		),
+	)
+
+	s.AddPrompt(prompt, func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		topic := strings.TrimSpace(request.Params.Arguments["topic"])
+		if topic == "" {
+			return nil, fmt.Errorf("topic argument is required")
+		}
+
+		specGuidelines, err := readGuideline("specification guidelines.md")
+		if err != nil {
+			return nil, err
+		}
+		designGuidelines, err := readGuideline("design guidelines.md")
+		if err != nil {
+			return nil, err
+		}
+
+		promptText := fmt.Sprintf(`You will draft a new proposal for the following idea:
+
+%s
+
+## Step 1: Understand the Context
+
+1. Call the MCP tool "context" to read the project rules and project design.
+2. Call the MCP tool "graph" to see existing proposals and completed specifications.
+3. If the idea overlaps with an existing proposal, STOP and ask the user whether to extend that proposal instead.
+4. If the idea is ambiguous, ask the user clarifying questions before writing anything.
+
+## Step 2: Create the Proposal
+
+Run: nocturnal spec proposal add "<proposal name>"
+
+Choose a short, descriptive name. This creates spec/proposal/<slug>/ with specification.md, design.md, and implementation.md from the workspace templates.
+
+## Step 3: Write specification.md
+
+Describe WHAT the change must do and WHY. Do not describe how it will be built. Follow these specification guidelines:
+
+<specification-guidelines>
+%s
+</specification-guidelines>
+
+List any proposals this one depends on in the **Depends on** field, and any existing files it will change in the **Affected files** field.
+
+## Step 4: Write design.md
+
+Describe HOW the specification will be met. Follow these design guidelines:
+
+<design-guidelines>
+%s
+</design-guidelines>
+
+## Step 5: Write implementation.md
+
+Break the design into phases. Each phase has:
+- A "### Phase N: Name" header
+- A **Goal**: line
+- Small, actionable "- [ ]" tasks that can each be completed in one sitting
+- A **Milestone**: line describing what is delivered
+
+## Step 6: Validate
+
+Run: nocturnal spec proposal validate <slug>
+
+Fix every error. Review every warning and either fix it or explain to the user why it is acceptable.
+
+## Step 7: Summary
+
+Present the proposal slug, a 1-2 sentence overview, its dependencies, and the number of phases and tasks. Ask the user to review before activating it.
+`, topic, strings.TrimSpace(specGuidelines), strings.TrimSpace(designGuidelines))
+
+		return &mcp.GetPromptResult{
+			Description: "Draft a new proposal from an idea",
+			Messages: []mcp.PromptMessage{
+				{
+					Role: mcp.RoleUser,
+					Content: mcp.TextContent{
+						Type: "text",
+						Text: promptText,
+					},
+				},
+			},
+		}, nil
+	})
+}
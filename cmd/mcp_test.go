@@ -0,0 +1,323 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+func TestHandleDocsSearchArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		wantText  string
+	}{
+		{
+			name:      "missing query",
+			arguments: map[string]any{},
+			wantText:  "query parameter is required",
+		},
+		{
+			name:      "nil arguments",
+			arguments: nil,
+			wantText:  "query parameter is required",
+		},
+		{
+			name:      "non-string query",
+			arguments: map[string]any{"query": 42},
+			wantText:  "query parameter must be a string",
+		},
+		{
+			name:      "blank query",
+			arguments: map[string]any{"query": "   "},
+			wantText:  "query parameter must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var request mcp.CallToolRequest
+			request.Params.Arguments = tt.arguments
+
+			result, err := handleDocsSearch(context.Background(), request)
+			if err != nil {
+				t.Fatalf("handleDocsSearch returned protocol error: %v", err)
+			}
+			if !result.IsError {
+				t.Fatalf("expected error result, got %+v", result)
+			}
+			text, ok := result.Content[0].(mcp.TextContent)
+			if !ok || !strings.Contains(text.Text, tt.wantText) {
+				t.Fatalf("result text = %+v, want %q", result.Content[0], tt.wantText)
+			}
+		})
+	}
+}
+
+func TestHandleDocsAdd(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), docsDir)
+	oldDocsPath := docsPath
+	docsPath = dir
+	t.Cleanup(func() { docsPath = oldDocsPath })
+
+	call := func(arguments map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		var request mcp.CallToolRequest
+		request.Params.Arguments = arguments
+		result, err := handleDocsAdd(context.Background(), request)
+		if err != nil {
+			t.Fatalf("handleDocsAdd returned protocol error: %v", err)
+		}
+		return result
+	}
+
+	if result := call(map[string]any{"name": "Bubble Tea", "content": "The Elm architecture for Go."}); result.IsError {
+		t.Fatalf("unexpected error: %+v", result.Content)
+	}
+	result := call(map[string]any{"name": "Bubble Tea", "content": "# Bubble Tea Commands\n\nCmds run I/O.\n"})
+	if result.IsError {
+		t.Fatalf("unexpected error: %+v", result.Content)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	path := filepath.Join(dir, "bubble-tea.md")
+	if !strings.Contains(text, path) || !strings.Contains(text, "2 component(s)") {
+		t.Errorf("result text = %q, want path and 2 components", text)
+	}
+
+	components, err := parseDocFile(path)
+	if err != nil {
+		t.Fatalf("parseDocFile: %v", err)
+	}
+	if len(components) != 2 || components[0].Name != "Bubble Tea" || components[1].Name != "Bubble Tea Commands" {
+		t.Fatalf("components = %+v", components)
+	}
+	if components[0].Content != "The Elm architecture for Go." {
+		t.Errorf("first component content = %q", components[0].Content)
+	}
+
+	for _, arguments := range []map[string]any{
+		{"content": "text"},
+		{"name": "Bubble Tea"},
+		{"name": "Bubble Tea", "content": "  "},
+	} {
+		if result := call(arguments); !result.IsError {
+			t.Errorf("expected error for arguments %v", arguments)
+		}
+	}
+}
+
+func TestRequireStringArgNormalizes(t *testing.T) {
+	var request mcp.CallToolRequest
+	request.Params.Arguments = map[string]any{"query": "  http   client \n"}
+
+	got, err := requireStringArg(request, "query")
+	if err != nil {
+		t.Fatalf("requireStringArg error: %v", err)
+	}
+	if got != "http client" {
+		t.Fatalf("requireStringArg = %q, want %q", got, "http client")
+	}
+}
+
+func TestFormatMaintenanceDue(t *testing.T) {
+	specPath := t.TempDir()
+	maintPath := filepath.Join(specPath, maintenanceDir)
+	if err := os.MkdirAll(maintPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	content := "# Maintenance: Deps\n\n## Requirements\n- Bump modules [id=bump] [freq=weekly]\n- Audit licenses [id=audit] [freq=yearly]\n"
+	if err := os.WriteFile(filepath.Join(maintPath, "deps.md"), []byte(content), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	output, err := formatMaintenanceDue(specPath, "")
+	if err != nil {
+		t.Fatalf("formatMaintenanceDue error: %v", err)
+	}
+	for _, want := range []string{"# Due Maintenance (2)", "## deps (2 due)", "[bump] Bump modules (freq: weekly, last actioned: never)"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState error: %v", err)
+	}
+	reqs, err := workspace.ParseMaintenanceFile(filepath.Join(maintPath, "deps.md"), state, "deps")
+	if err != nil {
+		t.Fatalf("ParseMaintenanceFile error: %v", err)
+	}
+	markAllDueActioned(state, "deps", reqs, time.Now(), "")
+	if err := saveState(specPath, state); err != nil {
+		t.Fatalf("saveState error: %v", err)
+	}
+
+	output, err = formatMaintenanceDue(specPath, "deps")
+	if err != nil {
+		t.Fatalf("formatMaintenanceDue error: %v", err)
+	}
+	if !strings.HasPrefix(output, "Nothing due") {
+		t.Errorf("expected nothing-due message, got:\n%s", output)
+	}
+
+	if _, err := formatMaintenanceDue(specPath, "missing"); err == nil {
+		t.Errorf("expected error for unknown maintenance item")
+	}
+}
+
+// listMCPNames returns the tool or prompt names a server exposes.
+func listMCPNames(t *testing.T, s *server.MCPServer, method string) map[string]bool {
+	t.Helper()
+
+	message := json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"` + method + `"}`)
+	response, ok := s.HandleMessage(context.Background(), message).(mcp.JSONRPCResponse)
+	if !ok {
+		t.Fatalf("%s: unexpected response type", method)
+	}
+
+	names := make(map[string]bool)
+	switch result := response.Result.(type) {
+	case mcp.ListToolsResult:
+		for _, tool := range result.Tools {
+			names[tool.Name] = true
+		}
+	case mcp.ListPromptsResult:
+		for _, prompt := range result.Prompts {
+			names[prompt.Name] = true
+		}
+	default:
+		t.Fatalf("%s: unexpected result type %T", method, response.Result)
+	}
+	return names
+}
+
+func TestNewMCPServerReadOnly(t *testing.T) {
+	full := newMCPServer(false, nil)
+	readOnly := newMCPServer(true, nil)
+
+	for _, method := range []string{"tools/list", "prompts/list"} {
+		fullNames := listMCPNames(t, full, method)
+		readOnlyNames := listMCPNames(t, readOnly, method)
+
+		entries := mcpTools
+		if method == "prompts/list" {
+			entries = mcpPrompts
+		}
+		for _, entry := range entries {
+			if !fullNames[entry.name] {
+				t.Errorf("%s missing from full server", entry.name)
+			}
+			if readOnlyNames[entry.name] == entry.mutating {
+				t.Errorf("%s registered = %v in read-only mode, mutating = %v", entry.name, readOnlyNames[entry.name], entry.mutating)
+			}
+		}
+	}
+
+	if listMCPNames(t, readOnly, "tools/list")["task_complete"] {
+		t.Errorf("task_complete must not be exposed in read-only mode")
+	}
+}
+
+func TestTruncateOutput(t *testing.T) {
+	text := "line one\nline two\nline three\n"
+
+	tests := []struct {
+		name      string
+		maxBytes  int
+		wantKept  string
+		truncated bool
+	}{
+		{name: "no limit", maxBytes: 0, wantKept: text},
+		{name: "fits", maxBytes: len(text), wantKept: text},
+		{name: "cuts at line break", maxBytes: 14, wantKept: "line one\n", truncated: true},
+		{name: "no line break", maxBytes: 4, wantKept: "line", truncated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncateOutput(text, tt.maxBytes)
+			if !strings.HasPrefix(got, tt.wantKept) {
+				t.Fatalf("truncateOutput = %q, want prefix %q", got, tt.wantKept)
+			}
+			hasNote := strings.Contains(got, "[Output truncated")
+			if hasNote != tt.truncated {
+				t.Fatalf("truncation note present = %v, want %v (got %q)", hasNote, tt.truncated, got)
+			}
+			if tt.truncated && !strings.Contains(got, "max_bytes") {
+				t.Errorf("truncation note does not say how to fetch more: %q", got)
+			}
+		})
+	}
+}
+
+func TestLimitOutputUsesMaxBytesArgument(t *testing.T) {
+	handler := limitOutput(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(strings.Repeat("0123456789\n", 100)), nil
+	})
+
+	var request mcp.CallToolRequest
+	request.Params.Arguments = map[string]any{"max_bytes": float64(50)}
+
+	result, err := handler(context.Background(), request)
+	if err != nil {
+		t.Fatalf("handler error: %v", err)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.HasPrefix(text, strings.Repeat("0123456789\n", 4)+"\n[Output truncated: showing 44 of 1100 bytes") {
+		t.Fatalf("unexpected truncated output: %q", text)
+	}
+}
+
+func TestFormatProposalTasksOnlyOpen(t *testing.T) {
+	proposalPath := t.TempDir()
+	impl := `# Implementation
+
+### Phase 1: Setup
+
+**Goal**: Get started
+
+- [x] Create package
+- [ ] Add config loader
+- [ ] Wire up command
+
+### Phase 2: Polish
+
+- [ ] Write docs
+`
+	if err := os.WriteFile(filepath.Join(proposalPath, "implementation.md"), []byte(impl), 0o644); err != nil {
+		t.Fatalf("write implementation.md: %v", err)
+	}
+
+	all, err := formatProposalTasks("demo", proposalPath, false)
+	if err != nil {
+		t.Fatalf("formatProposalTasks error: %v", err)
+	}
+	if !strings.Contains(all, "- [x] `1.1` Create package") {
+		t.Fatalf("expected completed task in full output:\n%s", all)
+	}
+
+	open, err := formatProposalTasks("demo", proposalPath, true)
+	if err != nil {
+		t.Fatalf("formatProposalTasks error: %v", err)
+	}
+	if strings.Contains(open, "Create package") {
+		t.Fatalf("completed task listed with only_open:\n%s", open)
+	}
+	for _, want := range []string{"- [ ] `1.2` Add config loader", "- [ ] `1.3` Wire up command", "Progress: 1/4"} {
+		if !strings.Contains(open, want) {
+			t.Errorf("only_open output missing %q:\n%s", want, open)
+		}
+	}
+	if strings.Contains(open, "Write docs") {
+		t.Errorf("only_open output includes tasks from a later phase:\n%s", open)
+	}
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// openMCPLog opens the --log-file for appending and returns a JSON logger
+// writing to it. Logs never go to stdout, which carries the MCP protocol.
+func openMCPLog(path string) (*slog.Logger, *os.File, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+	return slog.New(slog.NewJSONHandler(f, nil)), f, nil
+}
+
+// logToolCalls returns middleware that logs each tool call with its
+// arguments, duration, and error. Handler errors and error results are
+// logged at error level.
+func logToolCalls(logger *slog.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, request)
+
+			attrs := []any{
+				slog.String("tool", request.Params.Name),
+				slog.Any("arguments", request.Params.Arguments),
+				slog.Duration("duration", time.Since(start)),
+			}
+			switch {
+			case err != nil:
+				logger.ErrorContext(ctx, "tool call failed", append(attrs, slog.String("error", err.Error()))...)
+			case result != nil && result.IsError:
+				logger.ErrorContext(ctx, "tool call failed", append(attrs, slog.String("error", toolResultText(result)))...)
+			default:
+				logger.InfoContext(ctx, "tool call", attrs...)
+			}
+			return result, err
+		}
+	}
+}
+
+// toolResultText joins the text content of a tool result.
+func toolResultText(result *mcp.CallToolResult) string {
+	var parts []string
+	for _, content := range result.Content {
+		if text, ok := content.(mcp.TextContent); ok {
+			parts = append(parts, text.Text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestMCPLogsToolCalls(t *testing.T) {
+	t.Chdir(t.TempDir())
+	logPath := filepath.Join(t.TempDir(), "mcp.log")
+	logger, f, err := openMCPLog(logPath)
+	if err != nil {
+		t.Fatalf("openMCPLog: %v", err)
+	}
+	defer f.Close()
+
+	s := newMCPServer(true, logger)
+	for _, message := range []string{
+		`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"docs_search","arguments":{"query":"cobra"}}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"stats","arguments":{}}}`,
+	} {
+		if _, ok := s.HandleMessage(context.Background(), json.RawMessage(message)).(mcp.JSONRPCResponse); !ok {
+			t.Fatalf("unexpected response to %s", message)
+		}
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("read log: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d log lines, want 2:\n%s", len(lines), data)
+	}
+
+	var entry struct {
+		Level     string         `json:"level"`
+		Msg       string         `json:"msg"`
+		Tool      string         `json:"tool"`
+		Arguments map[string]any `json:"arguments"`
+		Duration  *int64         `json:"duration"`
+		Error     string         `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("invalid log line %q: %v", lines[0], err)
+	}
+	if entry.Tool != "docs_search" || entry.Arguments["query"] != "cobra" || entry.Duration == nil {
+		t.Errorf("unexpected log entry: %s", lines[0])
+	}
+
+	// There is no workspace, so stats returns an error result
+	entry.Error = ""
+	if err := json.Unmarshal([]byte(lines[1]), &entry); err != nil {
+		t.Fatalf("invalid log line %q: %v", lines[1], err)
+	}
+	if entry.Tool != "stats" || entry.Level != "ERROR" || entry.Error == "" {
+		t.Errorf("expected an error entry for stats, got: %s", lines[1])
+	}
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+var specProposalMoveDepCmd = &cobra.Command{
+	Use:   "move-dep <old-dep> <new-dep>",
+	Short: "Repoint every proposal's dependency on one slug to another",
+	Args:  cobra.ExactArgs(2),
+	Run:   runSpecProposalMoveDep,
+}
+
+func init() {
+	specProposalMoveDepCmd.Long = helpText("spec-proposal-move-dep")
+	specProposalCmd.AddCommand(specProposalMoveDepCmd)
+}
+
+func runSpecProposalMoveDep(cmd *cobra.Command, args []string) {
+	oldDep, newDep := args[0], args[1]
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	if oldDep == newDep {
+		printError("The old and new dependencies are the same")
+		return
+	}
+
+	changed, err := moveDependency(specPath, oldDep, newDep)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	if len(changed) == 0 {
+		printDim(fmt.Sprintf("No proposals depend on '%s'", oldDep))
+		return
+	}
+
+	printSuccess(fmt.Sprintf("Repointed %d proposal(s) from '%s' to '%s'", len(changed), oldDep, newDep))
+	for _, slug := range changed {
+		fmt.Printf("  %s\n", infoStyle.Render(slug))
+	}
+
+	if !workspace.FileExists(filepath.Join(specPath, proposalDir, newDep)) && !workspace.FileExists(filepath.Join(specPath, sectionDir, newDep+".md")) {
+		printWarning(fmt.Sprintf("Unresolved dependency: %s", newDep))
+		printDim("No proposal or completed spec matches; check the slug for typos")
+	}
+}
+
+// moveDependency replaces oldDep with newDep in the Depends on field of
+// every proposal's specification.md and returns the proposals changed.
+// Nothing is written if a changed proposal would be part of a dependency
+// cycle.
+func moveDependency(specPath, oldDep, newDep string) ([]string, error) {
+	nodes, err := buildDependencyGraph(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build dependency graph: %w", err)
+	}
+
+	updates := make(map[string]string)
+	var changed []string
+	for _, slug := range listProposalSlugs(specPath) {
+		specFile := filepath.Join(specPath, proposalDir, slug, "specification.md")
+		content, err := os.ReadFile(specFile)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read %s: %w", slug, err)
+		}
+
+		deps := workspace.ParseDependsOn(string(content))
+		if !contains(deps, oldDep) {
+			continue
+		}
+
+		var moved []string
+		for _, dep := range deps {
+			if dep == oldDep {
+				dep = newDep
+			}
+			if !contains(moved, dep) {
+				moved = append(moved, dep)
+			}
+		}
+
+		nodes[slug].Dependencies = moved
+		updates[slug] = setSpecField(string(content), "Depends on", strings.Join(moved, ", "))
+		changed = append(changed, slug)
+	}
+
+	if len(changed) == 0 {
+		return nil, nil
+	}
+
+	// Cycles that already existed are left to 'graph' to report
+	addMissingNodes(nodes)
+	for _, cycle := range detectCycles(nodes) {
+		for _, slug := range cycle {
+			if contains(changed, slug) {
+				return nil, fmt.Errorf("moving the dependency would create a cycle: %s", strings.Join(cycle, " -> "))
+			}
+		}
+	}
+
+	for _, slug := range changed {
+		specFile := filepath.Join(specPath, proposalDir, slug, "specification.md")
+		if err := os.WriteFile(specFile, []byte(updates[slug]), 0644); err != nil {
+			return nil, fmt.Errorf("failed to update %s: %w", slug, err)
+		}
+	}
+	return changed, nil
+}
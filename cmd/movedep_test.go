@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+func TestMoveDependency(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+
+	specs := map[string]string{
+		"billing":   "old-auth, payments",
+		"reporting": "old-auth",
+		"payments":  "reporting",
+	}
+	for slug, deps := range specs {
+		dir := filepath.Join(specPath, proposalDir, slug)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		content := "# " + slug + "\n\n**Depends on**: " + deps + "\n\n## Abstract\n"
+		if err := os.WriteFile(filepath.Join(dir, "specification.md"), []byte(content), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	deps := func(slug string) string {
+		got, err := workspace.ProposalDependencies(filepath.Join(specPath, proposalDir, slug))
+		if err != nil {
+			t.Fatalf("getProposalDependencies: %v", err)
+		}
+		return strings.Join(got, ",")
+	}
+
+	// reporting -> payments -> reporting
+	if _, err := moveDependency(specPath, "old-auth", "payments"); err == nil || !strings.Contains(err.Error(), "cycle") {
+		t.Fatalf("expected a cycle error, got %v", err)
+	}
+	if got := deps("billing"); got != "old-auth,payments" {
+		t.Fatalf("billing changed despite the cycle: %s", got)
+	}
+
+	changed, err := moveDependency(specPath, "old-auth", "auth")
+	if err != nil {
+		t.Fatalf("moveDependency: %v", err)
+	}
+	if strings.Join(changed, ",") != "billing,reporting" {
+		t.Errorf("changed = %v", changed)
+	}
+	if got := deps("billing"); got != "auth,payments" {
+		t.Errorf("billing depends on %s", got)
+	}
+	if got := deps("reporting"); got != "auth" {
+		t.Errorf("reporting depends on %s", got)
+	}
+	if got := deps("payments"); got != "reporting" {
+		t.Errorf("payments depends on %s", got)
+	}
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+var specProposalNewFromSectionCmd = &cobra.Command{
+	Use:               "new-from-section <section> [name]",
+	Short:             "Start a new proposal from a completed specification",
+	Args:              cobra.RangeArgs(1, 2),
+	Run:               runSpecProposalNewFromSection,
+	ValidArgsFunction: completeSectionNames,
+}
+
+func init() {
+	specProposalNewFromSectionCmd.Long = helpText("spec-proposal-new-from-section")
+	specProposalCmd.AddCommand(specProposalNewFromSectionCmd)
+}
+
+func runSpecProposalNewFromSection(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	section := strings.TrimSuffix(args[0], ".md")
+	name := section + "-extension"
+	if len(args) == 2 {
+		name = args[1]
+	}
+
+	slug, err := newProposalFromSection(specPath, section, name)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	printSuccess(fmt.Sprintf("Created proposal '%s' from '%s'", slug, section))
+	printDim(fmt.Sprintf("Location: %s/", filepath.Join(specPath, proposalDir, slug)))
+	printDim(fmt.Sprintf("The completed specification section/%s.md is unchanged", section))
+}
+
+// newProposalFromSection creates a proposal whose specification.md starts from
+// the completed specification section/<section>.md, retitled to name and
+// marked as extending (and depending on) that section. The other documents
+// are scaffolded from their templates. Returns the new proposal's slug.
+func newProposalFromSection(specPath, section, name string) (string, error) {
+	sectionFile, err := checkSection(specPath, section)
+	if err != nil {
+		return "", err
+	}
+
+	slug := nameToSlug(name)
+	if slug == "" {
+		return "", fmt.Errorf("invalid proposal name: must contain at least one alphanumeric character")
+	}
+	proposalPath := filepath.Join(specPath, proposalDir, slug)
+	if workspace.FileExists(proposalPath) {
+		return "", fmt.Errorf("proposal '%s' already exists", slug)
+	}
+
+	content, err := os.ReadFile(sectionFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read section: %w", err)
+	}
+
+	if err := os.MkdirAll(proposalPath, 0755); err != nil {
+		return "", fmt.Errorf("failed to create proposal directory: %w", err)
+	}
+
+	data := proposalTemplateData{Name: name, Slug: slug}
+
+	if err := scaffoldProposalDocuments(specPath, proposalPath, data); err != nil {
+		return "", err
+	}
+
+	spec := retitleDocument(string(content), name)
+	spec = setSpecField(spec, "Depends on", section)
+	spec = setSpecField(spec, "Extends", section)
+	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte(spec), 0644); err != nil {
+		return "", fmt.Errorf("failed to write specification.md: %w", err)
+	}
+
+	if err := recordProposalCreation(specPath, slug, newProposalMeta(specPath)); err != nil {
+		printWarning(fmt.Sprintf("Failed to record creation in history: %v", err))
+	}
+	return slug, nil
+}
+
+// retitleDocument replaces the first top-level heading of content with
+// title, or adds one if there is none.
+func retitleDocument(content, title string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "# ") {
+			lines[i] = "# " + title
+			return strings.Join(lines, "\n")
+		}
+	}
+	return "# " + title + "\n\n" + content
+}
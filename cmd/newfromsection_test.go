@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+func TestNewProposalFromSection(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	for _, dir := range []string{proposalDir, sectionDir} {
+		if err := os.MkdirAll(filepath.Join(specPath, dir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	section := "# OAuth Login\n\n**Depends on**: none\n\n## Abstract\n\nUsers MUST be able to sign in with OAuth.\n"
+	sectionFile := filepath.Join(specPath, sectionDir, "oauth-login.md")
+	if err := os.WriteFile(sectionFile, []byte(section), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	slug, err := newProposalFromSection(specPath, "oauth-login", "OAuth Refresh Tokens")
+	if err != nil {
+		t.Fatalf("newProposalFromSection: %v", err)
+	}
+	if slug != "oauth-refresh-tokens" {
+		t.Fatalf("slug = %q", slug)
+	}
+
+	proposalPath := filepath.Join(specPath, proposalDir, slug)
+	spec, err := os.ReadFile(filepath.Join(proposalPath, "specification.md"))
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	for _, want := range []string{
+		"# OAuth Refresh Tokens",
+		"**Depends on**: oauth-login",
+		"**Extends**: oauth-login",
+		"Users MUST be able to sign in with OAuth.",
+	} {
+		if !strings.Contains(string(spec), want) {
+			t.Errorf("specification.md missing %q:\n%s", want, spec)
+		}
+	}
+	for _, doc := range []string{"design.md", "implementation.md"} {
+		if !workspace.FileExists(filepath.Join(proposalPath, doc)) {
+			t.Errorf("expected %s to be scaffolded", doc)
+		}
+	}
+
+	// The completed specification stays in place
+	if got, err := os.ReadFile(sectionFile); err != nil || string(got) != section {
+		t.Errorf("section changed: %q, %v", got, err)
+	}
+
+	if _, err := newProposalFromSection(specPath, "oauth-login", "OAuth Refresh Tokens"); err == nil {
+		t.Error("expected an error when the proposal already exists")
+	}
+	if _, err := newProposalFromSection(specPath, "missing", "Other"); err == nil {
+		t.Error("expected an error for a missing section")
+	}
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+var (
+	openEditor     string
+	openSequential bool
+)
+
+var specProposalOpenCmd = &cobra.Command{
+	Use:               "open [change-slug]",
+	Short:             "Open a proposal's documents in the editor",
+	Args:              cobra.MaximumNArgs(1),
+	Run:               runSpecProposalOpen,
+	ValidArgsFunction: completeProposalNames,
+}
+
+func init() {
+	specProposalOpenCmd.Long = helpText("spec-proposal-open")
+	specProposalOpenCmd.Flags().StringVar(&openEditor, "editor", "", "Editor command to open the files with")
+	specProposalOpenCmd.Flags().BoolVar(&openSequential, "sequential", false, "Open the documents one after another")
+	specProposalCmd.AddCommand(specProposalOpenCmd)
+}
+
+func runSpecProposalOpen(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	var slug string
+	if len(args) == 1 {
+		slug = args[0]
+	} else if slug = getPrimaryProposalSlug(specPath); slug == "" {
+		printError("No active proposal; specify a proposal to open")
+		return
+	}
+
+	proposalPath, err := checkProposal(specPath, slug)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	paths := proposalDocumentPaths(specPath, proposalPath)
+	if len(paths) == 0 {
+		printError(fmt.Sprintf("Proposal '%s' has no documents", slug))
+		return
+	}
+
+	editor := resolveEditor(specPath, openEditor)
+	if err := runEditor(editor, paths, openSequential); err != nil {
+		printError(fmt.Sprintf("Failed to run editor '%s': %v", editor, err))
+	}
+}
+
+// proposalDocumentPaths returns the paths of the configured documents that
+// exist in a proposal, in configuration order.
+func proposalDocumentPaths(specPath, proposalPath string) []string {
+	var paths []string
+	for _, doc := range proposalDocuments(specPath) {
+		path := filepath.Join(proposalPath, doc.File)
+		if workspace.FileExists(path) {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
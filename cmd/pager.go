@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// noPager disables paging for long output; set by the --no-pager flag.
+var noPager bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&noPager, "no-pager", false, "Print long output directly instead of through a pager")
+}
+
+// printPaged prints content through a pager when stdout is a terminal, and
+// directly otherwise (e.g. when piped to a file or another process).
+func printPaged(content string) {
+	pager := resolvePager(noPager, stdoutIsTerminal())
+	if pager == "" {
+		fmt.Print(content)
+		return
+	}
+
+	debugf("paging output through %s", pager)
+	parts := strings.Fields(pager)
+	cmd := exec.Command(parts[0], parts[1:]...)
+	cmd.Stdin = strings.NewReader(content)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if _, ok := os.LookupEnv("LESS"); !ok {
+		// Quit if it fits on one screen, keep colors, don't clear on exit
+		cmd.Env = append(os.Environ(), "LESS=FRX")
+	}
+	if err := cmd.Run(); err != nil {
+		if _, exited := err.(*exec.ExitError); !exited {
+			// The pager never ran; don't lose the output
+			fmt.Print(content)
+		}
+	}
+}
+
+// resolvePager returns the pager command to use, or "" to print directly.
+// NOCTURNAL_PAGER takes precedence over $PAGER (an empty NOCTURNAL_PAGER or
+// "cat" disables paging), and less is the default.
+func resolvePager(disabled, interactive bool) string {
+	if disabled || !interactive {
+		return ""
+	}
+
+	pager, ok := os.LookupEnv("NOCTURNAL_PAGER")
+	if !ok {
+		pager = os.Getenv("PAGER")
+		if pager == "" {
+			pager = "less"
+		}
+	}
+	pager = strings.TrimSpace(pager)
+	if pager == "" || pager == "cat" {
+		return ""
+	}
+	if _, err := exec.LookPath(strings.Fields(pager)[0]); err != nil {
+		return ""
+	}
+	return pager
+}
+
+// stdoutIsTerminal reports whether stdout is an interactive terminal rather
+// than a file or pipe.
+func stdoutIsTerminal() bool {
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
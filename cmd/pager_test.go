@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+)
+
+func TestResolvePager(t *testing.T) {
+	tests := []struct {
+		name        string
+		noPager     bool
+		interactive bool
+		env         map[string]string
+		want        string
+	}{
+		{name: "not a terminal", interactive: false, env: map[string]string{"NOCTURNAL_PAGER": "sh"}, want: ""},
+		{name: "disabled by flag", noPager: true, interactive: true, env: map[string]string{"NOCTURNAL_PAGER": "sh"}, want: ""},
+		{name: "override", interactive: true, env: map[string]string{"NOCTURNAL_PAGER": "sh -s", "PAGER": "more"}, want: "sh -s"},
+		{name: "override disables", interactive: true, env: map[string]string{"NOCTURNAL_PAGER": "", "PAGER": "sh"}, want: ""},
+		{name: "cat disables", interactive: true, env: map[string]string{"NOCTURNAL_PAGER": "cat"}, want: ""},
+		{name: "PAGER fallback", interactive: true, env: map[string]string{"PAGER": "sh"}, want: "sh"},
+		{name: "missing pager", interactive: true, env: map[string]string{"NOCTURNAL_PAGER": "no-such-pager-binary"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PAGER", "")
+			t.Setenv("NOCTURNAL_PAGER", "")
+			if _, ok := tt.env["NOCTURNAL_PAGER"]; !ok {
+				// t.Setenv above restores it afterwards
+				os.Unsetenv("NOCTURNAL_PAGER")
+			}
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			if got := resolvePager(tt.noPager, tt.interactive); got != tt.want {
+				t.Errorf("resolvePager() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"text/template"
 
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 	"gopkg.in/yaml.v3"
 )
 
@@ -150,7 +151,7 @@ func (b *PrecursorBundle) readFileFromZip(relPath string) ([]byte, error) {
 // listThirdPartyDocsFromDir lists all .md files under third/ in a directory precursor
 func (b *PrecursorBundle) listThirdPartyDocsFromDir() ([]string, error) {
 	thirdPath := filepath.Join(b.path, "third")
-	if !fileExists(thirdPath) {
+	if !workspace.FileExists(thirdPath) {
 		return nil, nil
 	}
 
@@ -291,10 +292,12 @@ func validatePrecursorStructure(bundle *PrecursorBundle) error {
 			Name   string
 			Slug   string
 			Inputs map[string]any
+			Vars   map[string]string
 		}{
 			Name:   "test",
 			Slug:   "test",
 			Inputs: make(map[string]any),
+			Vars:   make(map[string]string),
 		}
 
 		if _, err := renderTemplateFromString(tmplName, string(content), testData); err != nil {
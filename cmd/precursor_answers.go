@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 	"gopkg.in/yaml.v3"
 )
 
@@ -30,7 +32,7 @@ func loadPrecursorAnswers(proposalPath string) (*PrecursorAnswers, error) {
 	answersPath := filepath.Join(proposalPath, precursorAnswersFile)
 
 	// If file doesn't exist, return empty answers
-	if !fileExists(answersPath) {
+	if !workspace.FileExists(answersPath) {
 		return &PrecursorAnswers{
 			Version: 1,
 			Inputs:  make(map[string]PrecursorAnswerInput),
@@ -106,12 +108,24 @@ func getMissingRequiredInputs(answers *PrecursorAnswers) []string {
 	return missing
 }
 
-// answersToTemplateData converts answers to a map suitable for template rendering
-func answersToTemplateData(answers *PrecursorAnswers) map[string]any {
+// answersToTemplateData converts answers to a map suitable for template
+// rendering. ${VAR} references in values are expanded from the environment;
+// the returned warnings name any that are unset.
+func answersToTemplateData(answers *PrecursorAnswers) (map[string]any, []string) {
 	data := make(map[string]any)
+	var warnings []string
 
-	for key, input := range answers.Inputs {
-		value := input.Value
+	keys := make([]string, 0, len(answers.Inputs))
+	for key := range answers.Inputs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, unset := expandAnswerEnv(answers.Inputs[key].Value)
+		for _, name := range unset {
+			warnings = append(warnings, fmt.Sprintf("Input '%s' references unset environment variable %s", key, name))
+		}
 
 		// Special handling for comma-separated lists (common pattern)
 		if strings.Contains(value, ",") {
@@ -129,5 +143,43 @@ func answersToTemplateData(answers *PrecursorAnswers) map[string]any {
 		}
 	}
 
-	return data
+	return data, warnings
+}
+
+// expandAnswerEnv replaces each ${VAR} in value with the environment
+// variable's value, or "" if it is unset, and each $$ with a literal $.
+// Other $ characters are kept as written. Returns the names of unset
+// variables.
+func expandAnswerEnv(value string) (string, []string) {
+	var b strings.Builder
+	var unset []string
+
+	for i := 0; i < len(value); i++ {
+		if value[i] != '$' || i+1 == len(value) {
+			b.WriteByte(value[i])
+			continue
+		}
+		switch value[i+1] {
+		case '$':
+			b.WriteByte('$')
+			i++
+		case '{':
+			end := strings.IndexByte(value[i+2:], '}')
+			if end <= 0 {
+				b.WriteByte('$')
+				continue
+			}
+			name := value[i+2 : i+2+end]
+			env, ok := os.LookupEnv(name)
+			if !ok {
+				unset = append(unset, name)
+			}
+			b.WriteString(env)
+			i += end + 2
+		default:
+			b.WriteByte('$')
+		}
+	}
+
+	return b.String(), unset
 }
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandAnswerEnv(t *testing.T) {
+	t.Setenv("NOCTURNAL_TEST_SERVICE", "billing")
+
+	tests := []struct {
+		name      string
+		value     string
+		want      string
+		wantUnset []string
+	}{
+		{name: "set", value: "${NOCTURNAL_TEST_SERVICE}-api", want: "billing-api"},
+		{name: "unset", value: "svc-${NOCTURNAL_TEST_MISSING}", want: "svc-", wantUnset: []string{"NOCTURNAL_TEST_MISSING"}},
+		{name: "escaped", value: "cost $$5 and $${NOCTURNAL_TEST_SERVICE}", want: "cost $5 and ${NOCTURNAL_TEST_SERVICE}"},
+		{name: "bare dollar", value: "$HOME and ${unterminated", want: "$HOME and ${unterminated"},
+		{name: "trailing dollar", value: "price$", want: "price$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, unset := expandAnswerEnv(tt.value)
+			if got != tt.want {
+				t.Errorf("expandAnswerEnv(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+			if !reflect.DeepEqual(unset, tt.wantUnset) {
+				t.Errorf("unset = %v, want %v", unset, tt.wantUnset)
+			}
+		})
+	}
+}
+
+func TestAnswersToTemplateDataExpandsEnv(t *testing.T) {
+	t.Setenv("NOCTURNAL_TEST_REGIONS", "eu, us")
+
+	answers := &PrecursorAnswers{Inputs: map[string]PrecursorAnswerInput{
+		"regions": {Value: "${NOCTURNAL_TEST_REGIONS}"},
+		"owner":   {Value: "${NOCTURNAL_TEST_OWNER}"},
+	}}
+
+	data, warnings := answersToTemplateData(answers)
+	if want := []any{"eu", "us"}; !reflect.DeepEqual(data["regions"], want) {
+		t.Errorf("regions = %#v, want %#v", data["regions"], want)
+	}
+	if data["owner"] != "" {
+		t.Errorf("owner = %#v, want empty", data["owner"])
+	}
+	want := []string{"Input 'owner' references unset environment variable NOCTURNAL_TEST_OWNER"}
+	if !reflect.DeepEqual(warnings, want) {
+		t.Errorf("warnings = %q, want %q", warnings, want)
+	}
+}
@@ -4,11 +4,16 @@ import (
 	"archive/zip"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
 var precursorCmd = &cobra.Command{
@@ -46,6 +51,8 @@ var (
 	precursorPath     string
 	precursorInPath   string
 	overwriteProposal bool
+
+	precursorPackExclude []string
 )
 
 func init() {
@@ -63,6 +70,7 @@ func init() {
 
 	precursorPackCmd.Flags().StringVar(&precursorInPath, "in", "", "Input directory")
 	precursorPackCmd.Flags().StringVar(&precursorOutPath, "out", "", "Output zip file")
+	precursorPackCmd.Flags().StringArrayVar(&precursorPackExclude, "exclude", nil, "Glob of files or directories to leave out (repeatable)")
 	precursorPackCmd.MarkFlagRequired("in")
 	precursorPackCmd.MarkFlagRequired("out")
 
@@ -313,7 +321,7 @@ nocturnal spec proposal add <proposal-name> \
 
 	if isZipOutput {
 		// Pack the temp directory into the output zip
-		if err := packPrecursorZip(workDir, outPath); err != nil {
+		if err := packPrecursorZip(workDir, outPath, nil); err != nil {
 			printError(fmt.Sprintf("Failed to pack zip: %v", err))
 			return
 		}
@@ -376,7 +384,7 @@ func runPrecursorValidate(cmd *cobra.Command, args []string) {
 }
 
 func runPrecursorPack(cmd *cobra.Command, args []string) {
-	if !fileExists(precursorInPath) {
+	if !workspace.FileExists(precursorInPath) {
 		printError(fmt.Sprintf("Input directory does not exist: %s", precursorInPath))
 		return
 	}
@@ -401,7 +409,14 @@ func runPrecursorPack(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	if err := packPrecursorZip(precursorInPath, precursorOutPath); err != nil {
+	for _, pattern := range precursorPackExclude {
+		if _, err := path.Match(pattern, ""); err != nil {
+			printError(fmt.Sprintf("Invalid --exclude pattern %q: %v", pattern, err))
+			return
+		}
+	}
+
+	if err := packPrecursorZip(precursorInPath, precursorOutPath, precursorPackExclude); err != nil {
 		printError(fmt.Sprintf("Failed to pack zip: %v", err))
 		return
 	}
@@ -410,12 +425,12 @@ func runPrecursorPack(cmd *cobra.Command, args []string) {
 }
 
 func runPrecursorUnpack(cmd *cobra.Command, args []string) {
-	if !fileExists(precursorInPath) {
+	if !workspace.FileExists(precursorInPath) {
 		printError(fmt.Sprintf("Input zip does not exist: %s", precursorInPath))
 		return
 	}
 
-	if fileExists(precursorOutPath) {
+	if workspace.FileExists(precursorOutPath) {
 		printError(fmt.Sprintf("Output directory already exists: %s", precursorOutPath))
 		return
 	}
@@ -444,20 +459,73 @@ func runPrecursorUnpack(cmd *cobra.Command, args []string) {
 	printSuccess(fmt.Sprintf("Unpacked precursor to: %s", precursorOutPath))
 }
 
-// packPrecursorZip creates a zip file from a directory, placing all files at zip root
-func packPrecursorZip(srcDir, dstZip string) error {
-	// Create zip file
-	zipFile, err := os.Create(dstZip)
-	if err != nil {
-		return fmt.Errorf("failed to create zip file: %w", err)
+// precursorRequiredFiles are never excluded from a packed precursor.
+var precursorRequiredFiles = []string{
+	"precursor.yaml",
+	"templates/specification.md.tmpl",
+	"templates/design.md.tmpl",
+	"templates/implementation.md.tmpl",
+}
+
+// excludeFromPrecursor reports whether zipPath (slash-separated, relative to
+// the precursor root) is left out of a packed precursor, either itself or
+// because a parent directory is. Dotfiles such as .DS_Store and .git are
+// always skipped. As in .gitignore, a pattern without a slash matches a name
+// at any depth and a pattern with one matches a path from the root. The
+// manifest and required templates are always kept.
+func excludeFromPrecursor(zipPath string, excludes []string) bool {
+	if contains(precursorRequiredFiles, zipPath) {
+		return false
 	}
-	defer zipFile.Close()
 
-	zipWriter := zip.NewWriter(zipFile)
-	defer zipWriter.Close()
+	parts := strings.Split(zipPath, "/")
+	for i, name := range parts {
+		if strings.HasPrefix(name, ".") {
+			return true
+		}
+		prefix := strings.Join(parts[:i+1], "/")
+		for _, pattern := range excludes {
+			pattern = strings.Trim(pattern, "/")
+			target := name
+			if strings.Contains(pattern, "/") {
+				target = prefix
+			}
+			if matched, _ := path.Match(pattern, target); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// holdsRequiredPrecursorFile reports whether the directory dir contains a
+// file that is never excluded.
+func holdsRequiredPrecursorFile(dir string) bool {
+	for _, file := range precursorRequiredFiles {
+		if strings.HasPrefix(file, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// precursorZipTime is the modification time recorded for every entry of a
+// packed precursor, so that the same content always packs to the same bytes.
+var precursorZipTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// packPrecursorZip creates a zip file from a directory, placing all files at
+// zip root. Paths matched by excludeFromPrecursor are left out. The archive
+// is reproducible: entries are sorted by path and have a fixed modification
+// time and normalized permissions.
+func packPrecursorZip(srcDir, dstZip string, excludes []string) error {
+	type zipEntry struct {
+		zipPath string
+		path    string
+		isDir   bool
+	}
+	var entries []zipEntry
 
-	// Walk the source directory
-	return filepath.Walk(srcDir, func(path string, info os.FileInfo, err error) error {
+	err := filepath.Walk(srcDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -476,28 +544,66 @@ func packPrecursorZip(srcDir, dstZip string) error {
 		// Convert to forward slashes for zip
 		zipPath := filepath.ToSlash(relPath)
 
+		if excludeFromPrecursor(zipPath, excludes) {
+			if info.IsDir() && !holdsRequiredPrecursorFile(zipPath) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
 		if info.IsDir() {
-			// Create directory entry
-			_, err := zipWriter.Create(zipPath + "/")
-			return err
+			zipPath += "/"
 		}
+		entries = append(entries, zipEntry{zipPath: zipPath, path: path, isDir: info.IsDir()})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].zipPath < entries[j].zipPath })
+
+	// Create zip file
+	zipFile, err := os.Create(dstZip)
+	if err != nil {
+		return fmt.Errorf("failed to create zip file: %w", err)
+	}
+	defer zipFile.Close()
 
-		// Create file entry
-		writer, err := zipWriter.Create(zipPath)
+	zipWriter := zip.NewWriter(zipFile)
+	for _, entry := range entries {
+		header := &zip.FileHeader{
+			Name:     entry.zipPath,
+			Method:   zip.Deflate,
+			Modified: precursorZipTime,
+		}
+		if entry.isDir {
+			header.Method = zip.Store
+			header.SetMode(fs.ModeDir | 0755)
+		} else {
+			header.SetMode(0644)
+		}
+
+		writer, err := zipWriter.CreateHeader(header)
 		if err != nil {
 			return err
 		}
+		if entry.isDir {
+			continue
+		}
 
-		// Copy file content
-		file, err := os.Open(path)
+		content, err := os.ReadFile(entry.path)
 		if err != nil {
 			return err
 		}
-		defer file.Close()
+		if _, err := writer.Write(content); err != nil {
+			return err
+		}
+	}
 
-		_, err = io.Copy(writer, file)
-		return err
-	})
+	if err := zipWriter.Close(); err != nil {
+		return fmt.Errorf("failed to write zip file: %w", err)
+	}
+	return zipFile.Close()
 }
 
 // extractZipFile extracts a single file from a zip archive
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPackPrecursorZipExcludesJunk(t *testing.T) {
+	src := t.TempDir()
+	files := []string{
+		"precursor.yaml",
+		"README.md",
+		"templates/specification.md.tmpl",
+		"templates/design.md.tmpl",
+		"templates/notes.md.tmpl",
+		"third/api.md",
+		"third/api.md~",
+		".DS_Store",
+		".git/HEAD",
+		"templates/.specification.md.tmpl.swp",
+		"build/output.bin",
+	}
+	for _, name := range files {
+		path := filepath.Join(src, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(name), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+
+	dst := filepath.Join(t.TempDir(), "out.zip")
+	// Patterns matching required files must not drop them
+	excludes := []string{"*~", "build/", "templates", "*.yaml"}
+	if err := packPrecursorZip(src, dst, excludes); err != nil {
+		t.Fatalf("packPrecursorZip: %v", err)
+	}
+
+	reader, err := zip.OpenReader(dst)
+	if err != nil {
+		t.Fatalf("open zip: %v", err)
+	}
+	defer reader.Close()
+
+	var got []string
+	for _, file := range reader.File {
+		if !strings.HasSuffix(file.Name, "/") {
+			got = append(got, file.Name)
+		}
+	}
+	sort.Strings(got)
+
+	want := []string{
+		"README.md",
+		"precursor.yaml",
+		"templates/design.md.tmpl",
+		"templates/specification.md.tmpl",
+		"third/api.md",
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("zip contains %v, want %v", got, want)
+	}
+}
+
+func TestPackPrecursorZipIsReproducible(t *testing.T) {
+	src := t.TempDir()
+	files := []string{"precursor.yaml", "templates/specification.md.tmpl", "third/api.md", "third/z.md"}
+	for _, name := range files {
+		path := filepath.Join(src, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(name), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+
+	first := filepath.Join(t.TempDir(), "first.zip")
+	if err := packPrecursorZip(src, first, nil); err != nil {
+		t.Fatalf("packPrecursorZip: %v", err)
+	}
+
+	// Timestamps and permissions differ between machines and checkouts
+	later := time.Now().Add(48 * time.Hour)
+	for _, name := range files {
+		path := filepath.Join(src, filepath.FromSlash(name))
+		if err := os.Chtimes(path, later, later); err != nil {
+			t.Fatalf("chtimes: %v", err)
+		}
+		if err := os.Chmod(path, 0o600); err != nil {
+			t.Fatalf("chmod: %v", err)
+		}
+	}
+
+	second := filepath.Join(t.TempDir(), "second.zip")
+	if err := packPrecursorZip(src, second, nil); err != nil {
+		t.Fatalf("packPrecursorZip: %v", err)
+	}
+
+	a, err := os.ReadFile(first)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	b, err := os.ReadFile(second)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if !bytes.Equal(a, b) {
+		t.Fatal("packing the same content twice produced different archives")
+	}
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+var (
+	agentProjectMaintenance bool
+	agentProjectDocs        bool
+)
+
+func init() {
+	agentProjectCmd.Flags().BoolVar(&agentProjectMaintenance, "include-maintenance", false, "Also summarise maintenance items and their due requirements")
+	agentProjectCmd.Flags().BoolVar(&agentProjectDocs, "include-docs", false, "Also list the names of the documentation components in third/")
+}
+
+// buildProjectContext returns the rules and project design, followed by a
+// maintenance summary and a documentation table of contents when requested.
+// It is empty when there is nothing to show.
+func buildProjectContext(specPath string, maintenance, docs bool) (string, error) {
+	var sections []string
+
+	content, err := readRulesAndProject(specPath)
+	if err != nil {
+		return "", err
+	}
+	if content != "" {
+		sections = append(sections, content)
+	}
+
+	if maintenance {
+		summary, err := maintenanceSummary(specPath)
+		if err != nil {
+			return "", err
+		}
+		if summary != "" {
+			sections = append(sections, summary)
+		}
+	}
+
+	if docs {
+		toc, err := docsTableOfContents()
+		if err != nil {
+			return "", err
+		}
+		if toc != "" {
+			sections = append(sections, toc)
+		}
+	}
+
+	return strings.Join(sections, "---\n\n"), nil
+}
+
+// maintenanceSummary lists each maintenance item with its due count and
+// the text of its due requirements. It is empty when there are no items.
+func maintenanceSummary(specPath string) (string, error) {
+	slugs, err := listMaintenanceFiles(specPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to list maintenance items: %w", err)
+	}
+	if len(slugs) == 0 {
+		return "", nil
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to load state: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString("# Maintenance\n\n")
+	for _, slug := range slugs {
+		reqs, err := workspace.ParseMaintenanceFile(filepath.Join(specPath, maintenanceDir, slug+".md"), state, slug)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse maintenance file '%s': %w", slug, err)
+		}
+		fmt.Fprintf(&b, "- %s: %d of %s due\n", slug, workspace.CountDue(reqs), countNoun(len(reqs), "requirement"))
+		for _, req := range reqs {
+			if req.Due {
+				fmt.Fprintf(&b, "  - [%s] %s\n", req.ID, req.Text)
+			}
+		}
+	}
+	b.WriteString("\n")
+	return b.String(), nil
+}
+
+// docsTableOfContents lists the documentation component names without
+// their bodies. It is empty when there is no documentation.
+func docsTableOfContents() (string, error) {
+	components, _, err := loadDocNames()
+	if err != nil {
+		return "", err
+	}
+	if len(components) == 0 {
+		return "", nil
+	}
+
+	var b strings.Builder
+	b.WriteString("# Documentation\n\n")
+	b.WriteString("Read a component with 'nocturnal docs search <name>'.\n\n")
+	for _, component := range components {
+		fmt.Fprintf(&b, "- %s\n", component.Name)
+	}
+	b.WriteString("\n")
+	return b.String(), nil
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildProjectContextIncludes(t *testing.T) {
+	specPath := t.TempDir()
+	if err := os.WriteFile(filepath.Join(specPath, projectFile), []byte("Use Go.\n"), 0o644); err != nil {
+		t.Fatalf("write project: %v", err)
+	}
+	requirements, err := seedMaintenanceRequirements("Update go modules\n", "")
+	if err != nil {
+		t.Fatalf("seedMaintenanceRequirements error: %v", err)
+	}
+	if _, err := createMaintenanceItem(specPath, "Dependency Updates", "", requirements); err != nil {
+		t.Fatalf("createMaintenanceItem error: %v", err)
+	}
+
+	dir := t.TempDir()
+	oldDocsPath := docsPath
+	docsPath = dir
+	t.Cleanup(func() { docsPath = oldDocsPath })
+	if err := os.WriteFile(filepath.Join(dir, "cobra.md"), []byte("# cobra-flags\nFull flag docs\n"), 0o644); err != nil {
+		t.Fatalf("write docs: %v", err)
+	}
+
+	tests := []struct {
+		name              string
+		maintenance, docs bool
+	}{
+		{name: "default"},
+		{name: "maintenance", maintenance: true},
+		{name: "docs", docs: true},
+		{name: "both", maintenance: true, docs: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := buildProjectContext(specPath, tt.maintenance, tt.docs)
+			if err != nil {
+				t.Fatalf("buildProjectContext error: %v", err)
+			}
+			if !strings.Contains(content, "# Project Design\n\nUse Go.") {
+				t.Errorf("project design missing:\n%s", content)
+			}
+			if got := strings.Contains(content, "# Maintenance\n\n- dependency-updates: 1 of 1 requirement due\n  - [") &&
+				strings.Contains(content, "Update go modules"); got != tt.maintenance {
+				t.Errorf("maintenance section present = %v, want %v:\n%s", got, tt.maintenance, content)
+			}
+			if got := strings.Contains(content, "# Documentation\n") && strings.Contains(content, "- cobra-flags\n"); got != tt.docs {
+				t.Errorf("docs section present = %v, want %v:\n%s", got, tt.docs, content)
+			}
+			if strings.Contains(content, "Full flag docs") {
+				t.Errorf("docs table of contents includes component bodies:\n%s", content)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+var specProposalRenameCmd = &cobra.Command{
+	Use:               "rename <change-slug> <new-slug>",
+	Short:             "Rename a proposal and repoint dependencies on it",
+	Args:              cobra.ExactArgs(2),
+	Run:               runSpecProposalRename,
+	ValidArgsFunction: completeProposalNames,
+}
+
+func init() {
+	specProposalRenameCmd.Long = helpText("spec-proposal-rename")
+	specProposalCmd.AddCommand(specProposalRenameCmd)
+}
+
+func runSpecProposalRename(cmd *cobra.Command, args []string) {
+	oldSlug, newSlug := args[0], args[1]
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	repointed, err := renameProposal(specPath, oldSlug, newSlug)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	printSuccess(fmt.Sprintf("Renamed proposal '%s' to '%s'", oldSlug, newSlug))
+	if len(repointed) > 0 {
+		printDim(fmt.Sprintf("Repointed dependencies in %d proposal(s):", len(repointed)))
+		for _, slug := range repointed {
+			fmt.Printf("  %s\n", infoStyle.Render(slug))
+		}
+	}
+}
+
+// renameProposal moves proposal/<oldSlug> to proposal/<newSlug>, carries its
+// state over, and repoints other proposals' Depends on fields. It returns the
+// proposals whose dependencies changed. The new slug must not clash with a
+// proposal, completed specification, or archive entry.
+func renameProposal(specPath, oldSlug, newSlug string) ([]string, error) {
+	oldPath, err := checkProposal(specPath, oldSlug)
+	if err != nil {
+		return nil, err
+	}
+	if newSlug == oldSlug {
+		return nil, fmt.Errorf("proposal is already named '%s'", newSlug)
+	}
+	if nameToSlug(newSlug) != newSlug {
+		return nil, fmt.Errorf("invalid slug '%s': use lowercase letters, digits, and hyphens", newSlug)
+	}
+
+	conflicts := []struct{ path, message string }{
+		{filepath.Join(specPath, proposalDir, newSlug), "proposal '%s' already exists"},
+		{filepath.Join(specPath, sectionDir, newSlug+".md"), "completed specification '%s' already exists"},
+		{filepath.Join(specPath, archiveDir, newSlug), "archive entry '%s' already exists"},
+	}
+	for _, conflict := range conflicts {
+		if workspace.FileExists(conflict.path) {
+			return nil, fmt.Errorf(conflict.message, newSlug)
+		}
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load state: %w", err)
+	}
+
+	if err := os.Rename(oldPath, filepath.Join(specPath, proposalDir, newSlug)); err != nil {
+		return nil, fmt.Errorf("failed to rename proposal: %w", err)
+	}
+
+	state.RenameProposal(oldSlug, newSlug)
+	if err := saveState(specPath, state); err != nil {
+		return nil, fmt.Errorf("renamed proposal but failed to save state: %w", err)
+	}
+
+	repointed, err := moveDependency(specPath, oldSlug, newSlug)
+	if err != nil {
+		return nil, fmt.Errorf("renamed proposal but failed to repoint dependencies: %w", err)
+	}
+	return repointed, nil
+}
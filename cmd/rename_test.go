@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+func TestRenameProposal(t *testing.T) {
+	specPath := t.TempDir()
+	proposals := map[string]string{
+		"login":   "# Login\n\n**Depends on**: none\n",
+		"profile": "# Profile\n\n**Depends on**: login\n",
+		"search":  "# Search\n\n**Depends on**: none\n",
+	}
+	for slug, content := range proposals {
+		dir := filepath.Join(specPath, proposalDir, slug)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "specification.md"), []byte(content), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(specPath, sectionDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(specPath, sectionDir, "done.md"), []byte("# Done\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	state, _ := loadState(specPath)
+	state.ActivateProposal("login", map[string]string{})
+	if err := saveState(specPath, state); err != nil {
+		t.Fatalf("saveState: %v", err)
+	}
+
+	for _, tt := range []struct{ slug, want string }{
+		{"search", "proposal 'search' already exists"},
+		{"done", "completed specification 'done' already exists"},
+		{"Sign In", "invalid slug"},
+		{"login", "already named"},
+	} {
+		if _, err := renameProposal(specPath, "login", tt.slug); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("renameProposal(login, %q) error = %v, want %q", tt.slug, err, tt.want)
+		}
+	}
+
+	repointed, err := renameProposal(specPath, "login", "sign-in")
+	if err != nil {
+		t.Fatalf("renameProposal: %v", err)
+	}
+	if !reflect.DeepEqual(repointed, []string{"profile"}) {
+		t.Errorf("repointed = %v, want [profile]", repointed)
+	}
+	if workspace.FileExists(filepath.Join(specPath, proposalDir, "login")) || !workspace.FileExists(filepath.Join(specPath, proposalDir, "sign-in", "specification.md")) {
+		t.Error("proposal directory was not moved to sign-in")
+	}
+
+	deps, err := workspace.ProposalDependencies(filepath.Join(specPath, proposalDir, "profile"))
+	if err != nil {
+		t.Fatalf("ProposalDependencies: %v", err)
+	}
+	if !reflect.DeepEqual(deps, []string{"sign-in"}) {
+		t.Errorf("profile depends on %v, want [sign-in]", deps)
+	}
+
+	loaded, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	if loaded.Primary != "sign-in" || !loaded.IsProposalActive("sign-in") {
+		t.Errorf("state primary = %q, active = %v, want sign-in", loaded.Primary, loaded.Active)
+	}
+}
@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"gitlab.com/caffeinatedjack/nocturnal/cmd/tui"
@@ -38,6 +40,11 @@ var completionCmd = &cobra.Command{
 	},
 }
 
+var (
+	tuiEditor string
+	dirFlag   string
+)
+
 var tuiCmd = &cobra.Command{
 	Use:   "tui",
 	Short: "Launch terminal user interface",
@@ -47,7 +54,15 @@ var tuiCmd = &cobra.Command{
 
 func init() {
 	rootCmd.Version = fmt.Sprintf("%s (built %s)", Version, BuildTime)
+	rootCmd.PersistentFlags().StringVar(&dirFlag, "dir", "", "Workspace directory name (default \"spec\", or $NOCTURNAL_DIR)")
+	cobra.OnInitialize(func() {
+		if err := applySpecDir(dirFlag); err != nil {
+			printError(err.Error())
+			os.Exit(1)
+		}
+	})
 	rootCmd.AddCommand(completionCmd)
+	tuiCmd.Flags().StringVar(&tuiEditor, "editor", "", "Editor command to open files with (overrides ui.editor and $EDITOR)")
 	rootCmd.AddCommand(tuiCmd)
 }
 
@@ -75,7 +90,28 @@ func runTUI(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	if err := tui.Run(specPath, Version); err != nil {
+	hooks := tui.Hooks{
+		Validate: validateProposalForTUI,
+		NewRule:  createRule,
+		NewMaintenance: func(specPath, name string) (string, error) {
+			return createMaintenanceItem(specPath, name, "", nil)
+		},
+		Documents: func(specPath string) []string {
+			return proposalDocFilenames(proposalDocuments(specPath))
+		},
+		Rename: renameProposal,
+		Complete: func(specPath, slug string) error {
+			_, err := completeProposal(specPath, slug, true, false, sectionConflictError)
+			if errors.Is(err, errCompletedSpecExists) {
+				return fmt.Errorf("%w; use 'spec proposal complete --force' or '--new-version'", err)
+			}
+			return err
+		},
+		WatchDebounce: func(specPath string) time.Duration {
+			return time.Duration(loadConfigOrDefault(specPath).UI.WatchDebounceMs) * time.Millisecond
+		},
+	}
+	if err := tui.Run(specPath, Version, resolveEditor(specPath, tuiEditor), hooks); err != nil {
 		printError(fmt.Sprintf("TUI error: %v", err))
 	}
 }
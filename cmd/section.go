@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/cmd/tui"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+var sectionEditor string
+
+var specSectionCmd = &cobra.Command{
+	Use:   "section",
+	Short: "Manage completed specifications",
+}
+
+var specSectionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List completed specifications",
+	Args:  cobra.NoArgs,
+	Run:   runSpecSectionList,
+}
+
+var specSectionShowCmd = &cobra.Command{
+	Use:               "show <name>",
+	Short:             "Show a completed specification",
+	Args:              cobra.ExactArgs(1),
+	Run:               runSpecSectionShow,
+	ValidArgsFunction: completeSectionNames,
+}
+
+var specSectionEditCmd = &cobra.Command{
+	Use:               "edit <name>",
+	Short:             "Open a completed specification in the editor",
+	Args:              cobra.ExactArgs(1),
+	Run:               runSpecSectionEdit,
+	ValidArgsFunction: completeSectionNames,
+}
+
+func init() {
+	specSectionCmd.Long = helpText("spec-section")
+	specSectionListCmd.Long = helpText("spec-section-list")
+	specSectionShowCmd.Long = helpText("spec-section-show")
+	specSectionEditCmd.Long = helpText("spec-section-edit")
+
+	specSectionEditCmd.Flags().StringVar(&sectionEditor, "editor", "", "Editor command to open the file with")
+
+	specSectionCmd.AddCommand(specSectionListCmd)
+	specSectionCmd.AddCommand(specSectionShowCmd)
+	specSectionCmd.AddCommand(specSectionEditCmd)
+	specCmd.AddCommand(specSectionCmd)
+}
+
+func completeSectionNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeWorkspaceSlugs(sectionDir, false), cobra.ShellCompDirectiveNoFileComp
+}
+
+// checkSection returns the path of a completed specification, or an error if
+// it does not exist.
+func checkSection(specPath, name string) (string, error) {
+	sectionPath := filepath.Join(specPath, sectionDir, strings.TrimSuffix(name, ".md")+".md")
+	if !workspace.FileExists(sectionPath) {
+		return "", fmt.Errorf("completed specification '%s' does not exist", name)
+	}
+	return sectionPath, nil
+}
+
+func runSpecSectionList(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	sectionPath := filepath.Join(specPath, sectionDir)
+	files, err := listMarkdownFiles(sectionPath)
+	if err != nil && !os.IsNotExist(err) {
+		printError(fmt.Sprintf("Failed to read section directory: %v", err))
+		return
+	}
+
+	if len(files) == 0 {
+		printDim("No completed specifications found")
+		printDim("Use 'nocturnal spec proposal complete <change-slug>' to promote a proposal")
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(boldStyle.Render(fmt.Sprintf("Completed Specifications (%d)", len(files))))
+	fmt.Println()
+
+	for _, filename := range files {
+		name := strings.TrimSuffix(filename, ".md")
+		requirements := 0
+		if content, err := os.ReadFile(filepath.Join(sectionPath, filename)); err == nil {
+			must, should, may := countRequirementsByType(string(content))
+			requirements = must + should + may
+		}
+		fmt.Printf("  %s  %s\n", infoStyle.Render(name), dimStyle.Render(fmt.Sprintf("%d requirements", requirements)))
+	}
+	fmt.Println()
+}
+
+func runSpecSectionShow(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	sectionPath, err := checkSection(specPath, args[0])
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	content, err := os.ReadFile(sectionPath)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to read specification: %v", err))
+		return
+	}
+
+	fmt.Print(string(content))
+}
+
+func runSpecSectionEdit(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	sectionPath, err := checkSection(specPath, args[0])
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	editor := resolveEditor(specPath, sectionEditor)
+	if err := tui.EditorRun(editor, sectionPath); err != nil {
+		printError(fmt.Sprintf("Failed to run editor '%s': %v", editor, err))
+		return
+	}
+}
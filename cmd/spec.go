@@ -3,13 +3,20 @@ package cmd
 import (
 	"bytes"
 	"embed"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
+	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/cmd/tui"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
 //go:embed templates
@@ -64,17 +71,37 @@ var specViewCmd = &cobra.Command{
 	Run:   runSpecView,
 }
 
+var (
+	initBare bool
+	initGit  bool
+)
+
 var specInitCmd = &cobra.Command{
 	Use:   "init",
 	Short: "Initialize a specification workspace",
 	Run:   runSpecInit,
 }
 
+var specReinitCmd = &cobra.Command{
+	Use:   "reinit",
+	Short: "Add missing directories and files to an existing workspace",
+	Args:  cobra.NoArgs,
+	Run:   runSpecReinit,
+}
+
 var specProposalCmd = &cobra.Command{
 	Use:   "proposal",
 	Short: "Manage proposals",
 }
 
+var (
+	addDependsOn string
+	addActivate  bool
+	addVars      []string
+	addAuthor    string
+	addDate      string
+)
+
 var specProposalAddCmd = &cobra.Command{
 	Use:   "add <change-slug>",
 	Short: "Create a new proposal",
@@ -92,6 +119,8 @@ var specProposalRemoveCmd = &cobra.Command{
 	ValidArgsFunction: completeProposalNames,
 }
 
+var forceActivate bool
+
 var specProposalActivateCmd = &cobra.Command{
 	Use:               "activate <change-slug>",
 	Short:             "Activate a proposal",
@@ -100,6 +129,14 @@ var specProposalActivateCmd = &cobra.Command{
 	ValidArgsFunction: completeProposalNames,
 }
 
+var specProposalTouchCmd = &cobra.Command{
+	Use:               "touch <change-slug>",
+	Short:             "Accept edits to an active proposal by resetting its integrity hashes",
+	Args:              cobra.ExactArgs(1),
+	Run:               runSpecProposalTouch,
+	ValidArgsFunction: completeProposalNames,
+}
+
 var specProposalDeactivateCmd = &cobra.Command{
 	Use:   "deactivate",
 	Short: "Deactivate the current proposal",
@@ -107,6 +144,13 @@ var specProposalDeactivateCmd = &cobra.Command{
 	Run:   runSpecProposalDeactivate,
 }
 
+var (
+	completeNoArchive  bool
+	completeKeep       bool
+	completeForce      bool
+	completeNewVersion bool
+)
+
 var specProposalCompleteCmd = &cobra.Command{
 	Use:               "complete <change-slug>",
 	Short:             "Complete and promote a proposal",
@@ -115,10 +159,38 @@ var specProposalCompleteCmd = &cobra.Command{
 	ValidArgsFunction: completeProposalNames,
 }
 
+var specProposalUncompleteCmd = &cobra.Command{
+	Use:               "uncomplete <change-slug>",
+	Short:             "Demote a completed specification back to a proposal",
+	Args:              cobra.ExactArgs(1),
+	Run:               runSpecProposalUncomplete,
+	ValidArgsFunction: completeSectionNames,
+}
+
+var (
+	validateFix        bool
+	validateAll        bool
+	validateJUnit      string
+	validateArchived   bool
+	validateFailFast   bool
+	validateCheckFiles bool
+	validateComponents bool
+	validateSummary    bool
+	validateOutput     string
+	validateFormat     string
+
+	proposalListFormat string
+	proposalListFilter string
+	proposalListTree   bool
+)
+
+// proposalListFilters are the values accepted by spec proposal list --filter.
+var proposalListFilters = []string{"all", "active", "pending", "blocked"}
+
 var specProposalValidateCmd = &cobra.Command{
-	Use:               "validate <change-slug>",
+	Use:               "validate [change-slug]",
 	Short:             "Validate proposal documents against guidelines",
-	Args:              cobra.ExactArgs(1),
+	Args:              cobra.MaximumNArgs(1),
 	Run:               runSpecProposalValidate,
 	ValidArgsFunction: completeProposalNames,
 }
@@ -156,9 +228,11 @@ var specRuleAddCmd = &cobra.Command{
 }
 
 var specRuleShowCmd = &cobra.Command{
-	Use:   "show",
-	Short: "Show all rules",
-	Run:   runSpecRuleShow,
+	Use:               "show [rule-name]",
+	Short:             "Show all rules, or a single rule",
+	Args:              cobra.MaximumNArgs(1),
+	Run:               runSpecRuleShow,
+	ValidArgsFunction: completeRuleNames,
 }
 
 var specConfigCmd = &cobra.Command{
@@ -208,12 +282,15 @@ func init() {
 	specCmd.Long = helpText("spec")
 	specViewCmd.Long = helpText("spec-view")
 	specInitCmd.Long = helpText("spec-init")
+	specReinitCmd.Long = helpText("spec-reinit")
 	specProposalCmd.Long = helpText("spec-proposal")
 	specProposalAddCmd.Long = helpText("spec-proposal-add")
 	specProposalRemoveCmd.Long = helpText("spec-proposal-remove")
 	specProposalActivateCmd.Long = helpText("spec-proposal-activate")
 	specProposalDeactivateCmd.Long = helpText("spec-proposal-deactivate")
+	specProposalTouchCmd.Long = helpText("spec-proposal-touch")
 	specProposalCompleteCmd.Long = helpText("spec-proposal-complete")
+	specProposalUncompleteCmd.Long = helpText("spec-proposal-uncomplete")
 	specProposalValidateCmd.Long = helpText("spec-proposal-validate")
 	specProposalListCmd.Long = helpText("spec-proposal-list")
 	specProposalAbandonCmd.Long = helpText("spec-proposal-abandon")
@@ -233,6 +310,9 @@ func init() {
 
 	specCmd.AddCommand(specViewCmd)
 	specCmd.AddCommand(specInitCmd)
+	specCmd.AddCommand(specReinitCmd)
+	specInitCmd.Flags().BoolVar(&initBare, "bare", false, "Create only the directory skeleton and an empty project.md")
+	specInitCmd.Flags().BoolVar(&initGit, "init-git", false, "Create a git repository and commit the new workspace")
 	specCmd.AddCommand(specProposalCmd)
 	specCmd.AddCommand(specRuleCmd)
 	specCmd.AddCommand(specConfigCmd)
@@ -245,7 +325,9 @@ func init() {
 	specProposalCmd.AddCommand(specProposalRemoveCmd)
 	specProposalCmd.AddCommand(specProposalActivateCmd)
 	specProposalCmd.AddCommand(specProposalDeactivateCmd)
+	specProposalCmd.AddCommand(specProposalTouchCmd)
 	specProposalCmd.AddCommand(specProposalCompleteCmd)
+	specProposalCmd.AddCommand(specProposalUncompleteCmd)
 	specProposalCmd.AddCommand(specProposalValidateCmd)
 	specProposalCmd.AddCommand(specProposalListCmd)
 	specProposalCmd.AddCommand(specProposalAbandonCmd)
@@ -253,7 +335,31 @@ func init() {
 
 	specProposalAddCmd.Flags().StringVar(&precursorPath, "precursor-path", "", "Path to precursor bundle (directory or .zip)")
 	specProposalAddCmd.Flags().BoolVar(&overwriteProposal, "overwrite", false, "Allow regeneration into existing proposal and overwrite third-party docs")
+	specProposalAddCmd.Flags().StringVar(&addDependsOn, "depends-on", "", "Comma-separated proposal or spec slugs this proposal depends on")
+	specProposalAddCmd.Flags().BoolVar(&addActivate, "activate", false, "Activate the proposal after creating it")
+	specProposalAddCmd.Flags().StringArrayVar(&addVars, "var", nil, "Template variable as key=value, available as .Vars.key (repeatable)")
+	specProposalAddCmd.Flags().StringVar(&addAuthor, "author", "", "Record this author instead of git user.name")
+	specProposalAddCmd.Flags().StringVar(&addDate, "date", "", "Record this creation date (YYYY-MM-DD or RFC3339) instead of now")
 	specProposalRemoveCmd.Flags().BoolVarP(&forceRemove, "force", "f", false, "Force removal even if proposal is active")
+	specProposalCompleteCmd.Flags().BoolVar(&completeNoArchive, "no-archive", false, "Promote the specification without archiving design and implementation")
+	specProposalCompleteCmd.Flags().BoolVar(&completeKeep, "keep", false, "Keep the proposal directory after completion")
+	specProposalCompleteCmd.Flags().BoolVarP(&completeForce, "force", "f", false, "Overwrite an existing completed specification with the same slug")
+	specProposalCompleteCmd.Flags().BoolVar(&completeNewVersion, "new-version", false, "Promote under <slug>-vN if a completed specification with the same slug exists")
+	specProposalCompleteCmd.MarkFlagsMutuallyExclusive("force", "new-version")
+	specProposalValidateCmd.Flags().BoolVar(&validateFix, "fix", false, "Insert headers for missing guideline sections before validating")
+	specProposalValidateCmd.Flags().BoolVar(&validateAll, "all", false, "Validate every proposal")
+	specProposalValidateCmd.Flags().StringVar(&validateJUnit, "junit", "", "Write the results as a JUnit XML report to this path")
+	specProposalListCmd.Flags().StringVar(&proposalListFormat, "format", "text", "Output format: text or csv")
+	specProposalListCmd.Flags().StringVar(&proposalListFilter, "filter", "all", "Only list proposals with this status: active, pending, blocked, or all")
+	specProposalListCmd.Flags().BoolVar(&proposalListTree, "tree", false, "Group proposals and specifications by dependency layer")
+	specProposalValidateCmd.Flags().BoolVar(&validateArchived, "archived", false, "Validate archived proposals instead of open ones")
+	specProposalValidateCmd.Flags().BoolVar(&validateCheckFiles, "check-files", false, "Warn about affected files in specification.md that do not exist")
+	specProposalValidateCmd.Flags().BoolVar(&validateComponents, "check-components", false, "Warn when implementation tasks and design.md's Detailed Design components do not match")
+	specProposalValidateCmd.Flags().BoolVar(&validateSummary, "summary-only", false, "Print only the error and warning counts for each document")
+	specProposalValidateCmd.Flags().BoolVar(&validateFailFast, "fail-fast", false, "Stop at the first proposal with errors and exit non-zero")
+	specProposalValidateCmd.Flags().StringVar(&validateOutput, "output", "", "Write one report file per proposal, named by slug, into this directory")
+	specProposalValidateCmd.Flags().StringVar(&validateFormat, "format", "markdown", "Report format for --output: markdown, json, junit, or sarif")
+	specProposalActivateCmd.Flags().BoolVarP(&forceActivate, "force", "f", false, "Activate even if dependencies are not completed")
 
 	specRuleCmd.AddCommand(specRuleAddCmd)
 	specRuleCmd.AddCommand(specRuleShowCmd)
@@ -263,16 +369,7 @@ func init() {
 	agentCmd.AddCommand(agentSpecificationsCmd)
 }
 
-var proposalDocs = []struct {
-	Name string
-	File string
-}{
-	{"Specification", "specification.md"},
-	{"Design", "design.md"},
-	{"Implementation", "implementation.md"},
-}
-
-// readProposalDocs reads all proposal documents (spec, design, implementation)
+// readProposalDocs reads all configured proposal documents
 func readProposalDocs(proposalPath string) (string, error) {
 	return readProposalDocsFiltered(proposalPath, nil)
 }
@@ -283,7 +380,7 @@ func readProposalDocsFiltered(proposalPath string, files []string) (string, erro
 	var buf bytes.Buffer
 	first := true
 
-	for _, doc := range proposalDocs {
+	for _, doc := range proposalDocumentsAt(proposalPath) {
 		// Skip if filtering and file not in list
 		if len(files) > 0 && !contains(files, doc.File) {
 			continue
@@ -399,23 +496,41 @@ func completeProposalNames(cmd *cobra.Command, args []string, toComplete string)
 	if len(args) != 0 {
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	}
+	return completeWorkspaceSlugs(proposalDir, true), cobra.ShellCompDirectiveNoFileComp
+}
 
-	specPath := getSpecPath()
-	proposalsPath := filepath.Join(specPath, proposalDir)
+func completeRuleNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeWorkspaceSlugs(ruleDir, false), cobra.ShellCompDirectiveNoFileComp
+}
 
-	entries, err := os.ReadDir(proposalsPath)
-	if err != nil {
+func completeMaintenanceNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	}
+	return completeWorkspaceSlugs(maintenanceDir, false), cobra.ShellCompDirectiveNoFileComp
+}
 
-	var proposals []string
+// completeWorkspaceSlugs lists entries of a workspace subdirectory for shell
+// completion: directory names when dirs is set, otherwise markdown file
+// names without their extension.
+func completeWorkspaceSlugs(subdir string, dirs bool) []string {
+	entries, err := os.ReadDir(filepath.Join(getSpecPath(), subdir))
+	if err != nil {
+		return nil
+	}
+
+	var slugs []string
 	for _, entry := range entries {
-		if entry.IsDir() {
-			proposals = append(proposals, entry.Name())
+		if dirs && entry.IsDir() {
+			slugs = append(slugs, entry.Name())
+		} else if !dirs && !entry.IsDir() && strings.HasSuffix(entry.Name(), ".md") {
+			slugs = append(slugs, strings.TrimSuffix(entry.Name(), ".md"))
 		}
 	}
-
-	return proposals, cobra.ShellCompDirectiveNoFileComp
+	return slugs
 }
 
 // countRequirements counts lines containing MUST or SHALL keywords.
@@ -431,27 +546,6 @@ func countRequirements(content string) int {
 	return count
 }
 
-// getProposalProgress counts task checkboxes in implementation.md.
-func getProposalProgress(proposalPath string) (total int, completed int) {
-	implPath := filepath.Join(proposalPath, "implementation.md")
-	content, err := os.ReadFile(implPath)
-	if err != nil {
-		return 0, 0
-	}
-
-	lines := strings.Split(string(content), "\n")
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-		if strings.HasPrefix(trimmed, "- [ ]") {
-			total++
-		} else if strings.HasPrefix(trimmed, "- [x]") || strings.HasPrefix(trimmed, "- [X]") {
-			total++
-			completed++
-		}
-	}
-	return total, completed
-}
-
 func runSpecView(cmd *cobra.Command, args []string) {
 	specPath, err := checkSpecWorkspace()
 	if err != nil {
@@ -501,7 +595,7 @@ func runSpecView(cmd *cobra.Command, args []string) {
 	} else if slug == "" {
 		printDim("  No active proposal")
 	} else {
-		total, completed := getProposalProgress(proposalPath)
+		total, completed := workspace.ProposalProgress(proposalPath)
 		if total > 0 {
 			percentage := (completed * 100) / total
 			progressBar := renderProgressBar(completed, total, 20)
@@ -510,9 +604,14 @@ func runSpecView(cmd *cobra.Command, args []string) {
 			fmt.Printf("  %s  %s\n", infoStyle.Render(slug), dimStyle.Render("(no tasks)"))
 		}
 		// Show dependencies for active proposal
-		if deps, _ := getProposalDependencies(proposalPath); len(deps) > 0 {
+		if deps, _ := workspace.ProposalDependencies(proposalPath); len(deps) > 0 {
 			fmt.Printf("  %s %s\n", dimStyle.Render("depends on:"), strings.Join(deps, ", "))
 		}
+		if state, err := loadState(specPath); err == nil {
+			if created := formatProposalMeta(state.Proposals[slug]); created != "" {
+				fmt.Printf("  %s %s\n", dimStyle.Render("created:"), created)
+			}
+		}
 	}
 
 	fmt.Println()
@@ -539,8 +638,8 @@ func runSpecView(cmd *cobra.Command, args []string) {
 	} else {
 		for _, name := range otherProposals {
 			propPath := filepath.Join(proposalsPath, name)
-			total, completed := getProposalProgress(propPath)
-			deps, _ := getProposalDependencies(propPath)
+			total, completed := workspace.ProposalProgress(propPath)
+			deps, _ := workspace.ProposalDependencies(propPath)
 
 			var parts []string
 			if total > 0 {
@@ -563,6 +662,7 @@ func runSpecView(cmd *cobra.Command, args []string) {
 }
 
 // renderProgressBar creates a visual progress bar using block characters.
+// The filled portion is colored by progressStyle.
 func renderProgressBar(completed, total, width int) string {
 	if total == 0 {
 		return dimStyle.Render("[" + strings.Repeat("-", width) + "]")
@@ -571,10 +671,40 @@ func renderProgressBar(completed, total, width int) string {
 	filled := (completed * width) / total
 	empty := width - filled
 
-	bar := successStyle.Render(strings.Repeat("█", filled)) + dimStyle.Render(strings.Repeat("░", empty))
+	bar := progressStyle(completed, total).Render(strings.Repeat("█", filled)) + dimStyle.Render(strings.Repeat("░", empty))
 	return "[" + bar + "]"
 }
 
+// progressStyle returns the fill style for a progress bar: red, yellow, or
+// green by workspace.ClassifyProgress.
+func progressStyle(completed, total int) lipgloss.Style {
+	switch workspace.ClassifyProgress(completed, total) {
+	case workspace.ProgressLow:
+		return errorStyle
+	case workspace.ProgressMedium:
+		return warningStyle
+	default:
+		return successStyle
+	}
+}
+
+// workspaceTemplate is a document written into a new spec workspace.
+type workspaceTemplate struct {
+	template  string
+	filename  string
+	guideline bool // Opinionated guidance, skipped by 'spec init --bare'
+}
+
+var workspaceTemplates = []workspaceTemplate{
+	{"templates/project.md", "project.md", false},
+	{"templates/AGENTS.md", "AGENTS.md", true},
+	{"templates/specification guidelines.md", "specification guidelines.md", true},
+	{"templates/design guidelines.md", "design guidelines.md", true},
+	{"templates/coding guidelines.md", "coding guidelines.md", true},
+}
+
+var workspaceDirs = []string{ruleDir, proposalDir, archiveDir, sectionDir, maintenanceDir}
+
 func runSpecInit(cmd *cobra.Command, args []string) {
 	specPath := getSpecPath()
 
@@ -584,54 +714,137 @@ func runSpecInit(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	dirs := []string{
-		specPath,
-		filepath.Join(specPath, ruleDir),
-		filepath.Join(specPath, proposalDir),
-		filepath.Join(specPath, archiveDir),
-		filepath.Join(specPath, sectionDir),
-		filepath.Join(specPath, maintenanceDir),
+	if err := initSpecWorkspace(specPath, initBare); err != nil {
+		printError(err.Error())
+		return
+	}
+
+	// Create default configuration file
+	config := DefaultConfig()
+	if err := saveConfig(specPath, config); err != nil {
+		printWarning(fmt.Sprintf("Failed to create config file: %v", err))
+	}
+
+	printSuccess("Initialized specification workspace")
+	printDim(fmt.Sprintf("Created %s/", specDir))
+
+	if initGit {
+		created, err := initWorkspaceGit(specPath)
+		switch {
+		case errors.Is(err, errGitNotInstalled):
+			printWarning("git is not installed; skipping repository setup")
+		case err != nil:
+			printWarning(fmt.Sprintf("Failed to set up git repository: %v", err))
+		case created:
+			printSuccess("Initialized git repository with the workspace committed")
+		default:
+			printDim("Already inside a git repository; skipping git init")
+		}
+	}
+}
+
+// initSpecWorkspace creates the workspace directories and template documents.
+// When bare is set, project.md is created empty and guideline files are skipped.
+func initSpecWorkspace(specPath string, bare bool) error {
+	dirs := []string{specPath}
+	for _, dir := range workspaceDirs {
+		dirs = append(dirs, filepath.Join(specPath, dir))
 	}
 
 	for _, dir := range dirs {
 		if err := os.MkdirAll(dir, 0755); err != nil {
-			printError(fmt.Sprintf("Failed to create directory %s: %v", dir, err))
-			return
+			return fmt.Errorf("failed to create directory %s: %w", dir, err)
 		}
 	}
 
-	templateFiles := []struct {
-		template string
-		filename string
-	}{
-		{"templates/project.md", "project.md"},
-		{"templates/AGENTS.md", "AGENTS.md"},
-		{"templates/specification guidelines.md", "specification guidelines.md"},
-		{"templates/design guidelines.md", "design guidelines.md"},
-		{"templates/coding guidelines.md", "coding guidelines.md"},
+	for _, tf := range workspaceTemplates {
+		if bare && tf.guideline {
+			continue
+		}
+
+		content := ""
+		if !bare {
+			var err error
+			content, err = readTemplate(tf.template)
+			if err != nil {
+				return fmt.Errorf("failed to read %s template: %w", tf.filename, err)
+			}
+		}
+
+		filePath := filepath.Join(specPath, tf.filename)
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to create %s: %w", tf.filename, err)
+		}
+	}
+
+	return nil
+}
+
+func runSpecReinit(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	added, err := upgradeSpecWorkspace(specPath)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	if len(added) == 0 {
+		printSuccess("Workspace is up to date")
+		return
+	}
+
+	printSuccess(fmt.Sprintf("Added %d missing item(s) to the workspace", len(added)))
+	for _, item := range added {
+		printDim(fmt.Sprintf("  %s/%s", specDir, item))
+	}
+}
+
+// upgradeSpecWorkspace creates any missing standard directories, template
+// documents, and config file without touching existing ones. Returns the
+// added paths relative to the workspace.
+func upgradeSpecWorkspace(specPath string) ([]string, error) {
+	var added []string
+
+	dirs := append(append([]string{}, workspaceDirs...), docsDir)
+	for _, dir := range dirs {
+		dirPath := filepath.Join(specPath, dir)
+		if workspace.FileExists(dirPath) {
+			continue
+		}
+		if err := os.MkdirAll(dirPath, 0755); err != nil {
+			return added, fmt.Errorf("failed to create directory %s: %w", dir, err)
+		}
+		added = append(added, dir+"/")
 	}
 
-	for _, tf := range templateFiles {
+	for _, tf := range workspaceTemplates {
+		filePath := filepath.Join(specPath, tf.filename)
+		if workspace.FileExists(filePath) {
+			continue
+		}
 		content, err := readTemplate(tf.template)
 		if err != nil {
-			printError(fmt.Sprintf("Failed to read %s template: %v", tf.filename, err))
-			return
+			return added, fmt.Errorf("failed to read %s template: %w", tf.filename, err)
 		}
-		filePath := filepath.Join(specPath, tf.filename)
 		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
-			printError(fmt.Sprintf("Failed to create %s: %v", tf.filename, err))
-			return
+			return added, fmt.Errorf("failed to create %s: %w", tf.filename, err)
 		}
+		added = append(added, tf.filename)
 	}
 
-	// Create default configuration file
-	config := DefaultConfig()
-	if err := saveConfig(specPath, config); err != nil {
-		printWarning(fmt.Sprintf("Failed to create config file: %v", err))
+	if !workspace.FileExists(getConfigPath(specPath)) {
+		if err := saveConfig(specPath, DefaultConfig()); err != nil {
+			return added, err
+		}
+		added = append(added, configFileName)
 	}
 
-	printSuccess("Initialized specification workspace")
-	printDim(fmt.Sprintf("Created %s/", specDir))
+	return added, nil
 }
 
 func runSpecProposalAdd(cmd *cobra.Command, args []string) {
@@ -663,9 +876,32 @@ func runSpecProposalAdd(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	meta, err := proposalAddMeta(specPath)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	vars, err := parseTemplateVars(addVars)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	// Check dependencies before anything is written
+	deps, missing, err := resolveNewDependencies(specPath, slug, addDependsOn)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+	if len(missing) > 0 {
+		printWarning(fmt.Sprintf("Unresolved dependencies: %s", strings.Join(missing, ", ")))
+		printDim("No proposal or completed spec matches; check the slugs for typos")
+	}
+
 	// Branch: Use precursor if --precursor-path is specified
 	if precursorPath != "" {
-		runSpecProposalAddWithPrecursor(name, slug, specPath, proposalPath, proposalExists)
+		runSpecProposalAddWithPrecursor(name, slug, specPath, proposalPath, proposalExists, deps, meta, vars)
 		return
 	}
 
@@ -675,36 +911,74 @@ func runSpecProposalAdd(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	data := struct {
-		Name string
-		Slug string
-	}{Name: name, Slug: slug}
+	data := proposalTemplateData{Name: name, Slug: slug, Vars: vars}
 
-	templates := map[string]string{
-		"specification.md":  "templates/proposal/specification.md",
-		"design.md":         "templates/proposal/design.md",
-		"implementation.md": "templates/proposal/implementation.md",
+	if err := scaffoldProposalDocuments(specPath, proposalPath, data); err != nil {
+		printError(err.Error())
+		return
 	}
 
-	for filename, templatePath := range templates {
-		content, err := renderTemplate(templatePath, data)
-		if err != nil {
-			printError(fmt.Sprintf("Failed to render %s: %v", filename, err))
-			return
-		}
-		filePath := filepath.Join(proposalPath, filename)
-		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
-			printError(fmt.Sprintf("Failed to create %s: %v", filename, err))
-			return
-		}
+	if err := setProposalDependencies(proposalPath, deps); err != nil {
+		printError(err.Error())
+		return
+	}
+
+	if err := recordProposalCreation(specPath, slug, meta); err != nil {
+		printWarning(fmt.Sprintf("Failed to record creation in history: %v", err))
 	}
 
 	printSuccess(fmt.Sprintf("Created proposal '%s'", slug))
 	printDim(fmt.Sprintf("Location: %s/", proposalPath))
+
+	if addActivate {
+		activateAndReport(specPath, slug, false)
+	}
+}
+
+// proposalAddMeta returns the creation metadata for 'spec proposal add',
+// applying --author and --date over the defaults.
+func proposalAddMeta(specPath string) (workspace.ProposalMeta, error) {
+	meta := newProposalMeta(specPath)
+	if addAuthor != "" {
+		meta.Author = addAuthor
+	}
+	if addDate != "" {
+		created, err := parseProposalDate(addDate)
+		if err != nil {
+			return meta, err
+		}
+		meta.Created = created.UTC().Format(time.RFC3339)
+	}
+	return meta, nil
+}
+
+// parseProposalDate parses a --date value as a date or an RFC3339 timestamp.
+func parseProposalDate(value string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date '%s' (use YYYY-MM-DD or RFC3339)", value)
+	}
+	return t, nil
+}
+
+// formatProposalMeta renders creation metadata as "2026-03-04 by name",
+// or "" if none was recorded.
+func formatProposalMeta(meta workspace.ProposalMeta) string {
+	var parts []string
+	if created, err := time.Parse(time.RFC3339, meta.Created); err == nil {
+		parts = append(parts, created.Format("2006-01-02"))
+	}
+	if meta.Author != "" {
+		parts = append(parts, "by "+meta.Author)
+	}
+	return strings.Join(parts, " ")
 }
 
 // runSpecProposalAddWithPrecursor creates/updates a proposal using a precursor bundle
-func runSpecProposalAddWithPrecursor(name, slug, specPath, proposalPath string, proposalExists bool) {
+func runSpecProposalAddWithPrecursor(name, slug, specPath, proposalPath string, proposalExists bool, deps []string, meta workspace.ProposalMeta, vars map[string]string) {
 	// Load precursor bundle
 	bundle, err := LoadPrecursorBundle(precursorPath)
 	if err != nil {
@@ -763,56 +1037,58 @@ func runSpecProposalAddWithPrecursor(name, slug, specPath, proposalPath string,
 	}
 
 	// All inputs satisfied - generate proposal docs
+	inputs, envWarnings := answersToTemplateData(answers)
+	for _, warning := range envWarnings {
+		printWarning(warning)
+	}
 	templateData := struct {
 		Name   string
 		Slug   string
 		Inputs map[string]any
+		Vars   map[string]string
 	}{
 		Name:   name,
 		Slug:   slug,
-		Inputs: answersToTemplateData(answers),
+		Inputs: inputs,
+		Vars:   vars,
 	}
 
 	// Render each proposal document
-	proposalTemplates := []struct {
-		filename     string
-		precursorTpl string
-		embeddedTpl  string
-	}{
-		{"specification.md", "templates/specification.md.tmpl", "templates/proposal/specification.md"},
-		{"design.md", "templates/design.md.tmpl", "templates/proposal/design.md"},
-		{"implementation.md", "templates/implementation.md.tmpl", "templates/proposal/implementation.md"},
-	}
-
-	for _, tpl := range proposalTemplates {
+	for _, doc := range proposalDocuments(specPath) {
 		var content string
 		var err error
 
 		// Try to use precursor template first
-		if bundle.HasTemplate(tpl.precursorTpl[10:]) { // strip "templates/" prefix
-			tmplContent, readErr := bundle.ReadFile(tpl.precursorTpl)
+		precursorTpl := doc.File + ".tmpl"
+		if bundle.HasTemplate(precursorTpl) {
+			tmplContent, readErr := bundle.ReadFile("templates/" + precursorTpl)
 			if readErr == nil {
-				content, err = renderTemplateFromString(tpl.filename, string(tmplContent), templateData)
+				content, err = renderTemplateFromString(doc.File, string(tmplContent), templateData)
 			} else {
 				err = readErr
 			}
 		} else {
-			// Fall back to embedded template
-			content, err = renderTemplate(tpl.embeddedTpl, templateData)
+			// Fall back to the workspace or embedded template
+			content, err = renderProposalDocument(specPath, doc, templateData)
 		}
 
 		if err != nil {
-			printError(fmt.Sprintf("Failed to render %s: %v", tpl.filename, err))
+			printError(fmt.Sprintf("Failed to render %s: %v", doc.File, err))
 			return
 		}
 
-		filePath := filepath.Join(proposalPath, tpl.filename)
+		filePath := filepath.Join(proposalPath, doc.File)
 		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
-			printError(fmt.Sprintf("Failed to write %s: %v", tpl.filename, err))
+			printError(fmt.Sprintf("Failed to write %s: %v", doc.File, err))
 			return
 		}
 	}
 
+	if err := setProposalDependencies(proposalPath, deps); err != nil {
+		printError(err.Error())
+		return
+	}
+
 	// Install third-party docs
 	thirdDocs, err := bundle.ListThirdPartyDocs()
 	if err != nil {
@@ -833,6 +1109,12 @@ func runSpecProposalAddWithPrecursor(name, slug, specPath, proposalPath string,
 		printWarning(fmt.Sprintf("Failed to save answers file: %v", err))
 	}
 
+	if !proposalExists {
+		if err := recordProposalCreation(specPath, slug, meta); err != nil {
+			printWarning(fmt.Sprintf("Failed to record creation in history: %v", err))
+		}
+	}
+
 	if proposalExists {
 		printSuccess(fmt.Sprintf("Regenerated proposal '%s' from precursor", slug))
 	} else {
@@ -840,6 +1122,30 @@ func runSpecProposalAddWithPrecursor(name, slug, specPath, proposalPath string,
 	}
 	printDim(fmt.Sprintf("Location: %s/", proposalPath))
 	printDim(fmt.Sprintf("Precursor: %s", manifest.ID))
+
+	if addActivate {
+		activateAndReport(specPath, slug, false)
+	}
+}
+
+// setProposalDependencies fills the "Depends on" field of a proposal's
+// specification.md. It does nothing when deps is empty.
+func setProposalDependencies(proposalPath string, deps []string) error {
+	if len(deps) == 0 {
+		return nil
+	}
+
+	specFile := filepath.Join(proposalPath, "specification.md")
+	content, err := os.ReadFile(specFile)
+	if err != nil {
+		return fmt.Errorf("failed to read specification.md: %w", err)
+	}
+
+	updated := setSpecField(string(content), "Depends on", strings.Join(deps, ", "))
+	if err := os.WriteFile(specFile, []byte(updated), 0644); err != nil {
+		return fmt.Errorf("failed to write specification.md: %w", err)
+	}
+	return nil
 }
 
 // installThirdPartyDocs copies third-party docs from precursor to spec/third/
@@ -858,7 +1164,7 @@ func installThirdPartyDocs(bundle *PrecursorBundle, specPath string, docPaths []
 		destPath := filepath.Join(thirdDir, filename)
 
 		// Check for conflicts
-		if fileExists(destPath) && !overwrite {
+		if workspace.FileExists(destPath) && !overwrite {
 			conflicts = append(conflicts, docPath)
 			continue
 		}
@@ -895,7 +1201,7 @@ func runSpecProposalRemove(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	if !forceRemove && state.isProposalActive(slug) {
+	if !forceRemove && state.IsProposalActive(slug) {
 		printError(fmt.Sprintf("Proposal '%s' is currently active", slug))
 		printDim("Use --force to remove anyway, or deactivate first")
 		return
@@ -906,8 +1212,7 @@ func runSpecProposalRemove(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	if state.isProposalActive(slug) {
-		state.deactivateProposal(slug)
+	if state.ForgetProposal(slug) {
 		if err := saveState(specPath, state); err != nil {
 			printWarning(fmt.Sprintf("Failed to update state: %v", err))
 		}
@@ -924,47 +1229,60 @@ func runSpecProposalActivate(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	proposalPath, err := checkProposal(specPath, slug)
-	if err != nil {
+	if _, err := checkProposal(specPath, slug); err != nil {
 		printError(err.Error())
 		return
 	}
 
-	// Check that this proposal's dependencies are completed.
-	missing, err := getMissingCompletedDependencies(specPath, proposalPath)
+	activateAndReport(specPath, slug, forceActivate)
+}
+
+// activateAndReport activates a proposal under the dependency rules and
+// prints the outcome. It reports whether the proposal was activated.
+func activateAndReport(specPath, slug string, force bool) bool {
+	missing, err := activateProposalChecked(specPath, slug, force)
 	if err != nil {
-		printError(fmt.Sprintf("Failed to check dependencies: %v", err))
-		return
+		printError(err.Error())
+		if len(missing) > 0 {
+			printDim(fmt.Sprintf("Missing: %s", strings.Join(missing, ", ")))
+			printDim("Complete the dependencies first (they must exist in spec/section/), or activate with --force")
+		}
+		return false
 	}
 	if len(missing) > 0 {
-		printError(fmt.Sprintf("Cannot activate '%s': missing completed dependencies", slug))
-		printDim(fmt.Sprintf("Missing: %s", strings.Join(missing, ", ")))
-		printDim("Complete the dependencies first (they must exist in spec/section/)")
-		return
+		printWarning(fmt.Sprintf("Activating despite missing completed dependencies: %s", strings.Join(missing, ", ")))
 	}
 
-	// Compute hashes for proposal files
-	hashes, err := computeProposalHashes(proposalPath)
+	printSuccess(fmt.Sprintf("Activated proposal '%s'", slug))
+	return true
+}
+
+func runSpecProposalTouch(cmd *cobra.Command, args []string) {
+	slug := args[0]
+	specPath, err := checkSpecWorkspace()
 	if err != nil {
-		printError(fmt.Sprintf("Failed to compute file hashes: %v", err))
+		printWorkspaceError()
 		return
 	}
 
-	// Load state and activate proposal
-	state, err := loadState(specPath)
-	if err != nil {
-		printError(fmt.Sprintf("Failed to load state: %v", err))
+	if _, err := checkProposal(specPath, slug); err != nil {
+		printError(err.Error())
 		return
 	}
 
-	state.activateProposal(slug, hashes)
+	changed, err := rebaselineProposalHashes(specPath, slug)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to reset hashes: %v", err))
+		return
+	}
 
-	if err := saveState(specPath, state); err != nil {
-		printError(fmt.Sprintf("Failed to save state: %v", err))
+	if len(changed) == 0 {
+		printSuccess(fmt.Sprintf("Proposal '%s' is unchanged since activation", slug))
 		return
 	}
 
-	printSuccess(fmt.Sprintf("Activated proposal '%s'", slug))
+	printSuccess(fmt.Sprintf("Accepted changes to '%s'", slug))
+	printDim(fmt.Sprintf("Updated: %s", strings.Join(changed, ", ")))
 }
 
 func runSpecProposalDeactivate(cmd *cobra.Command, args []string) {
@@ -986,7 +1304,8 @@ func runSpecProposalDeactivate(cmd *cobra.Command, args []string) {
 	}
 
 	slug := state.Primary
-	state.deactivateProposal(slug)
+	state.DeactivateProposal(slug)
+	state.RecordEvent(slug, workspace.EventDeactivated)
 
 	if err := saveState(specPath, state); err != nil {
 		printError(fmt.Sprintf("Failed to save state: %v", err))
@@ -1020,7 +1339,7 @@ func runSpecProposalCurrent(cmd *cobra.Command, args []string) {
 
 	for _, slug := range state.Active {
 		proposalPath := filepath.Join(specPath, proposalDir, slug)
-		total, completed := getProposalProgress(proposalPath)
+		total, completed := workspace.ProposalProgress(proposalPath)
 
 		var status string
 		if slug == state.Primary {
@@ -1053,80 +1372,237 @@ func runSpecProposalCurrent(cmd *cobra.Command, args []string) {
 func runSpecProposalComplete(cmd *cobra.Command, args []string) {
 	slug := args[0]
 	specPath := getSpecPath()
-	proposalPath, err := checkProposal(specPath, slug)
+	if _, err := checkProposal(specPath, slug); err != nil {
+		printError(err.Error())
+		return
+	}
+
+	conflict := sectionConflictError
+	switch {
+	case completeForce:
+		conflict = sectionConflictOverwrite
+	case completeNewVersion:
+		conflict = sectionConflictVersion
+	}
+
+	promoted, err := completeProposal(specPath, slug, !completeNoArchive, completeKeep, conflict)
 	if err != nil {
 		printError(err.Error())
+		if errors.Is(err, errCompletedSpecExists) {
+			printDim("Use --force to overwrite it, or --new-version to promote under a versioned slug")
+		}
 		return
 	}
 
-	archivePath := filepath.Join(specPath, archiveDir, slug)
+	printSuccess(fmt.Sprintf("Completed proposal '%s'", slug))
+	printDim(fmt.Sprintf("Specification promoted to %s/%s.md", sectionDir, promoted))
+	if !completeNoArchive {
+		printDim(fmt.Sprintf("Design/implementation archived to %s/%s/", archiveDir, promoted))
+	}
+	if completeKeep {
+		printDim(fmt.Sprintf("Proposal workspace kept at %s/%s/", proposalDir, slug))
+	}
+}
+
+// errCompletedSpecExists is returned when completing a proposal would
+// replace a completed specification with the same slug.
+var errCompletedSpecExists = errors.New("completed specification already exists")
+
+// sectionConflict says how completeProposal handles an existing
+// section/<slug>.md, left behind by an earlier completion.
+type sectionConflict int
+
+const (
+	// sectionConflictError refuses to complete the proposal.
+	sectionConflictError sectionConflict = iota
+	// sectionConflictOverwrite replaces the existing specification.
+	sectionConflictOverwrite
+	// sectionConflictVersion promotes under the first free <slug>-vN slug.
+	sectionConflictVersion
+)
+
+// completeProposal promotes a proposal's specification to the section
+// directory. When archive is set, design and implementation documents are
+// copied to the archive; unless keep is set, the proposal directory is then
+// removed. The proposal is always cleared from the active state. If
+// section/<slug>.md already exists, conflict decides whether to fail,
+// overwrite it, or promote under a versioned slug. It returns the slug the
+// specification and archive were written under.
+func completeProposal(specPath, slug string, archive, keep bool, conflict sectionConflict) (string, error) {
+	proposalPath := filepath.Join(specPath, proposalDir, slug)
 	sectionPath := filepath.Join(specPath, sectionDir)
 
 	specFile := filepath.Join(proposalPath, "specification.md")
-	if !fileExists(specFile) {
-		printError(fmt.Sprintf("Proposal '%s' is missing specification.md", slug))
-		return
+	if !workspace.FileExists(specFile) {
+		return "", fmt.Errorf("proposal '%s' is missing specification.md", slug)
+	}
+
+	promoted := slug
+	if workspace.FileExists(filepath.Join(sectionPath, slug+".md")) {
+		switch conflict {
+		case sectionConflictOverwrite:
+			printWarning(fmt.Sprintf("Overwriting existing %s/%s.md", sectionDir, slug))
+		case sectionConflictVersion:
+			promoted = nextSectionVersion(specPath, slug)
+			printWarning(fmt.Sprintf("%s/%s.md already exists; promoting as '%s'", sectionDir, slug, promoted))
+		default:
+			return "", fmt.Errorf("%w: %s/%s.md", errCompletedSpecExists, sectionDir, slug)
+		}
 	}
+	archivePath := filepath.Join(specPath, archiveDir, promoted)
 
 	// Archive design and implementation documents
-	if err := archiveProposalDocs(proposalPath, archivePath, []string{"design.md", "implementation.md"}); err != nil {
-		printError(err.Error())
-		return
+	if archive {
+		debugf("archiving documents of %s to %s", slug, archivePath)
+		if err := workspace.ArchiveProposalDocs(proposalPath, archivePath, archivedDocFilenames(specPath)); err != nil {
+			return "", err
+		}
 	}
 
 	// Promote specification to section
-	specDst := filepath.Join(sectionPath, slug+".md")
-	if err := copyFile(specFile, specDst); err != nil {
-		printError(fmt.Sprintf("Failed to promote specification: %v", err))
-		return
+	specDst := filepath.Join(sectionPath, promoted+".md")
+	debugf("promoting %s to %s", specFile, specDst)
+	if err := workspace.CopyFile(specFile, specDst); err != nil {
+		return "", fmt.Errorf("failed to promote specification: %w", err)
 	}
 
-	if err := os.RemoveAll(proposalPath); err != nil {
-		printError(fmt.Sprintf("Failed to remove proposal workspace: %v", err))
-		return
+	if !keep {
+		debugf("removing %s", proposalPath)
+		if err := os.RemoveAll(proposalPath); err != nil {
+			return "", fmt.Errorf("failed to remove proposal workspace: %w", err)
+		}
 	}
 
 	clearActiveProposalIfMatches(specPath, slug)
-	printSuccess(fmt.Sprintf("Completed proposal '%s'", slug))
-	printDim(fmt.Sprintf("Specification promoted to %s/%s.md", sectionDir, slug))
-	printDim(fmt.Sprintf("Design/implementation archived to %s/%s/", archiveDir, slug))
+	if err := recordProposalEvent(specPath, slug, workspace.EventCompleted); err != nil {
+		printWarning(fmt.Sprintf("Failed to record completion in history: %v", err))
+	}
+	return promoted, nil
 }
 
-func runSpecRuleAdd(cmd *cobra.Command, args []string) {
-	ruleName := args[0]
-	slug := nameToSlug(ruleName)
-
-	if slug == "" {
-		printError("Invalid rule name: must contain at least one alphanumeric character")
-		return
+// nextSectionVersion returns the first <slug>-vN, counting from 2, that has
+// neither a completed specification nor an archive entry.
+func nextSectionVersion(specPath, slug string) string {
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s-v%d", slug, n)
+		if !workspace.FileExists(filepath.Join(specPath, sectionDir, candidate+".md")) &&
+			!workspace.FileExists(filepath.Join(specPath, archiveDir, candidate)) {
+			return candidate
+		}
 	}
+}
 
+func runSpecProposalUncomplete(cmd *cobra.Command, args []string) {
+	slug := args[0]
 	specPath, err := checkSpecWorkspace()
 	if err != nil {
 		printWorkspaceError()
 		return
 	}
-	rulePath := filepath.Join(specPath, ruleDir, slug+".md")
 
-	if _, err := os.Stat(rulePath); err == nil {
-		printError(fmt.Sprintf("Rule '%s' already exists", slug))
+	restored, err := uncompleteProposal(specPath, slug)
+	if err != nil {
+		printError(err.Error())
 		return
 	}
 
-	data := struct{ Name string }{Name: ruleName}
-	ruleContent, err := renderTemplate("templates/rule.md", data)
+	printSuccess(fmt.Sprintf("Reopened proposal '%s'", slug))
+	printDim(fmt.Sprintf("Specification moved to %s/%s/specification.md", proposalDir, slug))
+	if len(restored) > 0 {
+		printDim(fmt.Sprintf("Restored from archive: %s", strings.Join(restored, ", ")))
+	}
+}
+
+// uncompleteProposal is the inverse of completeProposal. It moves
+// section/<slug>.md back to proposal/<slug>/specification.md, restores design
+// and implementation documents from archive/<slug>/ when present, and removes
+// the section and archive entries. Returns the restored archive documents.
+func uncompleteProposal(specPath, slug string) ([]string, error) {
+	sectionFile := filepath.Join(specPath, sectionDir, slug+".md")
+	if !workspace.FileExists(sectionFile) {
+		return nil, fmt.Errorf("completed specification '%s' does not exist", slug)
+	}
+
+	proposalPath := filepath.Join(specPath, proposalDir, slug)
+	if workspace.FileExists(proposalPath) {
+		return nil, fmt.Errorf("proposal '%s' already exists", slug)
+	}
+
+	archivePath := filepath.Join(specPath, archiveDir, slug)
+	if workspace.FileExists(filepath.Join(archivePath, ".abandoned")) {
+		return nil, fmt.Errorf("archive entry '%s' belongs to an abandoned proposal", slug)
+	}
+
+	if err := os.MkdirAll(proposalPath, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create proposal directory: %w", err)
+	}
+
+	if err := workspace.CopyFile(sectionFile, filepath.Join(proposalPath, "specification.md")); err != nil {
+		return nil, fmt.Errorf("failed to restore specification: %w", err)
+	}
+
+	var restored []string
+	for _, filename := range archivedDocFilenames(specPath) {
+		src := filepath.Join(archivePath, filename)
+		if !workspace.FileExists(src) {
+			continue
+		}
+		if err := workspace.CopyFile(src, filepath.Join(proposalPath, filename)); err != nil {
+			return nil, fmt.Errorf("failed to restore %s: %w", filename, err)
+		}
+		restored = append(restored, filename)
+	}
+
+	if err := os.Remove(sectionFile); err != nil {
+		return nil, fmt.Errorf("failed to remove completed specification: %w", err)
+	}
+	if err := os.RemoveAll(archivePath); err != nil {
+		return nil, fmt.Errorf("failed to remove archive entry: %w", err)
+	}
+
+	return restored, nil
+}
+
+func runSpecRuleAdd(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
 	if err != nil {
-		printError(fmt.Sprintf("Failed to render rule template: %v", err))
+		printWorkspaceError()
 		return
 	}
 
-	if err := os.WriteFile(rulePath, []byte(ruleContent), 0644); err != nil {
-		printError(fmt.Sprintf("Failed to create rule: %v", err))
+	slug, err := createRule(specPath, args[0])
+	if err != nil {
+		printError(err.Error())
 		return
 	}
 
 	printSuccess(fmt.Sprintf("Created rule '%s'", slug))
-	printDim(fmt.Sprintf("Location: %s", rulePath))
+	printDim(fmt.Sprintf("Location: %s", filepath.Join(specPath, ruleDir, slug+".md")))
+}
+
+// createRule scaffolds rule/<slug>.md from the rule template and returns the
+// slug derived from name.
+func createRule(specPath, name string) (string, error) {
+	slug := nameToSlug(name)
+	if slug == "" {
+		return "", fmt.Errorf("invalid rule name: must contain at least one alphanumeric character")
+	}
+
+	rulePath := filepath.Join(specPath, ruleDir, slug+".md")
+	if workspace.FileExists(rulePath) {
+		return "", fmt.Errorf("rule '%s' already exists", slug)
+	}
+
+	data := struct{ Name string }{Name: name}
+	ruleContent, err := renderTemplate("templates/rule.md", data)
+	if err != nil {
+		return "", fmt.Errorf("failed to render rule template: %w", err)
+	}
+
+	if err := os.WriteFile(rulePath, []byte(ruleContent), 0644); err != nil {
+		return "", fmt.Errorf("failed to create rule: %w", err)
+	}
+	return slug, nil
 }
 
 func runSpecRuleShow(cmd *cobra.Command, args []string) {
@@ -1153,9 +1629,17 @@ func runSpecRuleShow(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	fmt.Println()
-	fmt.Println(boldStyle.Render(fmt.Sprintf("Rules (%d)", len(ruleFiles))))
-	fmt.Println()
+	if len(args) > 0 {
+		filename := nameToSlug(args[0]) + ".md"
+		if !contains(ruleFiles, filename) {
+			printError(fmt.Sprintf("Rule '%s' does not exist", args[0]))
+			return
+		}
+		ruleFiles = []string{filename}
+	}
+
+	var out strings.Builder
+	out.WriteString("\n" + boldStyle.Render(fmt.Sprintf("Rules (%d)", len(ruleFiles))) + "\n\n")
 
 	for i, filename := range ruleFiles {
 		filePath := filepath.Join(rulesDirPath, filename)
@@ -1166,12 +1650,13 @@ func runSpecRuleShow(cmd *cobra.Command, args []string) {
 		}
 
 		if i > 0 {
-			fmt.Println(dimStyle.Render("---"))
-			fmt.Println()
+			out.WriteString(dimStyle.Render("---") + "\n\n")
 		}
 
-		fmt.Println(string(content))
+		out.WriteString(string(content) + "\n")
 	}
+
+	printPaged(out.String())
 }
 
 func runAgentCurrent(cmd *cobra.Command, args []string) {
@@ -1191,11 +1676,11 @@ func runAgentCurrent(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	fmt.Println(boldStyle.Render("Active proposal:"), slug)
-	printDim(fmt.Sprintf("Location: %s", proposalPath))
-	fmt.Println()
+	var out strings.Builder
+	out.WriteString(boldStyle.Render("Active proposal:") + " " + slug + "\n")
+	out.WriteString(dimStyle.Render(fmt.Sprintf("Location: %s", proposalPath)) + "\n\n")
 
-	for i, doc := range proposalDocs {
+	for i, doc := range proposalDocuments(specPath) {
 		filePath := filepath.Join(proposalPath, doc.File)
 		content, err := os.ReadFile(filePath)
 		if err != nil {
@@ -1207,15 +1692,14 @@ func runAgentCurrent(cmd *cobra.Command, args []string) {
 		}
 
 		if i > 0 {
-			fmt.Println()
-			fmt.Println(dimStyle.Render("---"))
-			fmt.Println()
+			out.WriteString("\n" + dimStyle.Render("---") + "\n\n")
 		}
 
-		fmt.Println(boldStyle.Render(doc.Name))
-		fmt.Println()
-		fmt.Print(string(content))
+		out.WriteString(boldStyle.Render(doc.Name) + "\n\n")
+		out.WriteString(string(content))
 	}
+
+	printPaged(out.String())
 }
 
 func runAgentProject(cmd *cobra.Command, args []string) {
@@ -1225,7 +1709,7 @@ func runAgentProject(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	content, err := readRulesAndProject(specPath)
+	content, err := buildProjectContext(specPath, agentProjectMaintenance, agentProjectDocs)
 	if err != nil {
 		printError(err.Error())
 		return
@@ -1236,7 +1720,7 @@ func runAgentProject(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	fmt.Print(content)
+	printPaged(content)
 }
 
 func runAgentSpecifications(cmd *cobra.Command, args []string) {
@@ -1258,14 +1742,16 @@ func runAgentSpecifications(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	fmt.Print(content)
+	printPaged(content)
 }
 
 // ValidationResult holds errors and warnings from document validation.
+// Notes record waived checks and are informational only.
 type ValidationResult struct {
 	Document string
 	Errors   []string
 	Warnings []string
+	Notes    []string
 }
 
 // containsText checks if content contains text (case-insensitive)
@@ -1273,41 +1759,125 @@ func containsText(content, text string) bool {
 	return strings.Contains(strings.ToLower(content), strings.ToLower(text))
 }
 
-// validateSpecification checks for required sections and normative language.
-func validateSpecification(content string) ValidationResult {
-	result := ValidationResult{Document: "specification.md"}
+// scaffoldFieldPattern matches a "**Label**: value" metadata field.
+var scaffoldFieldPattern = regexp.MustCompile(`^\*\*([^*]+)\*\*:(.*)$`)
 
-	requiredSections := []struct {
-		name     string
-		required bool
-		hint     string
-	}{
-		{"Abstract", true, "Add a 2-4 sentence summary of the specification"},
-		{"Introduction", true, "Add context for why this specification exists"},
-		{"Requirements", true, "List requirements using MUST/SHOULD/MAY language"},
+// htmlCommentPattern matches a single-line HTML comment.
+var htmlCommentPattern = regexp.MustCompile(`<!--.*?-->`)
+
+// checkTemplatePlaceholders warns about each metadata field whose value is
+// still only its template comment, naming the field and its line, and once
+// about any other template comments left in the document. A comment after a
+// filled-in value is treated as an intentional note.
+func checkTemplatePlaceholders(content string, result *ValidationResult) {
+	otherComments := false
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if match := scaffoldFieldPattern.FindStringSubmatch(trimmed); match != nil {
+			value := strings.TrimSpace(match[2])
+			if strings.Contains(value, "<!--") {
+				if strings.TrimSpace(htmlCommentPattern.ReplaceAllString(value, "")) == "" {
+					result.Warnings = append(result.Warnings, fmt.Sprintf("Unfilled field: %s (line %d)", strings.TrimSpace(match[1]), i+1))
+				}
+				continue
+			}
+		}
+		if strings.Contains(trimmed, "<!--") {
+			otherComments = true
+		}
 	}
 
-	recommendedSections := []struct {
-		name string
-		hint string
-	}{
-		{"Examples", "Provide concrete, runnable examples"},
-		{"Security Considerations", "Address security implications"},
-		{"Error Handling", "Define error conditions and responses"},
+	if otherComments {
+		result.Warnings = append(result.Warnings, "Document contains unfilled template comments")
 	}
+}
 
-	for _, section := range requiredSections {
-		if section.required && !containsHeaderWithText(content, section.name) {
-			result.Errors = append(result.Errors, fmt.Sprintf("Missing required section: %s - %s", section.name, section.hint))
+// guidelineSection is a section the guidelines expect in a proposal
+// document. Missing required sections are errors; others are warnings.
+type guidelineSection struct {
+	Name     string
+	Hint     string
+	Required bool
+}
+
+// specificationSections lists the sections checked in specification.md, in
+// the order they appear in the template, when the specification guidelines
+// do not list them.
+var specificationSections = []guidelineSection{
+	{"Abstract", "Add a 2-4 sentence summary of the specification", true},
+	{"Introduction", "Add context for why this specification exists", true},
+	{"Requirements", "List requirements using MUST/SHOULD/MAY language", true},
+	{"Error Handling", "Define error conditions and responses", false},
+	{"Examples", "Provide concrete, runnable examples", false},
+	{"Security Considerations", "Address security implications", false},
+}
+
+// designSections lists the sections checked in design.md, in the order they
+// appear in the template, when the design guidelines do not list them.
+var designSections = []guidelineSection{
+	{"Context", "Establish the technical landscape and constraints", true},
+	{"Goals and Non-Goals", "Define goals and explicitly excluded items", true},
+	{"Options Considered", "Document at least 2 viable approaches", true},
+	{"Decision", "State the chosen approach and rationale", true},
+	{"Detailed Design", "Describe architecture, components, data, or API design", true},
+	{"Cross-Cutting Concerns", "Address security, performance, reliability, testing", true},
+	{"Implementation Plan", "Define phased approach and milestones", true},
+	{"Open Questions", "List unresolved items with owners and blocking status", false},
+}
+
+// checkGuidelineSections records an error for each missing required section,
+// a warning for each required section that appears more than once, and a
+// warning for each missing recommended one.
+func checkGuidelineSections(content string, sections []guidelineSection, result *ValidationResult) {
+	for _, section := range sections {
+		if section.Required && !containsHeaderWithText(content, section.Name) {
+			result.Errors = append(result.Errors, fmt.Sprintf("Missing required section: %s - %s", section.Name, section.Hint))
 		}
 	}
 
-	for _, section := range recommendedSections {
-		if !containsHeaderWithText(content, section.name) {
-			result.Warnings = append(result.Warnings, fmt.Sprintf("Missing recommended section: %s - %s", section.name, section.hint))
+	for _, section := range sections {
+		if !section.Required {
+			continue
+		}
+		matches := duplicateSectionLines(content, section.Name)
+		if len(matches) < 2 {
+			continue
 		}
+		lines := make([]string, len(matches))
+		for i, n := range matches {
+			lines[i] = strconv.Itoa(n)
+		}
+		result.Warnings = append(result.Warnings, fmt.Sprintf("Duplicate section: %s (lines %s)", section.Name, strings.Join(lines, ", ")))
 	}
 
+	for _, section := range sections {
+		if !section.Required && !containsHeaderWithText(content, section.Name) {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("Missing recommended section: %s - %s", section.Name, section.Hint))
+		}
+	}
+}
+
+// duplicateSectionLines returns the line numbers of headers whose title is
+// exactly the section name. Headers that merely contain the name, such as
+// "Requirements Notation", satisfy the presence check but are not duplicates.
+func duplicateSectionLines(content, name string) []int {
+	lines := strings.Split(content, "\n")
+	var matches []int
+	for _, n := range headerLinesWithText(content, name) {
+		if strings.EqualFold(headerTitle(lines[n-1]), name) {
+			matches = append(matches, n)
+		}
+	}
+	return matches
+}
+
+// validateSpecification checks for the guideline sections and normative
+// language.
+func validateSpecification(content string, sections []guidelineSection) ValidationResult {
+	result := ValidationResult{Document: "specification.md"}
+
+	checkGuidelineSections(content, sections, &result)
+
 	if containsHeaderWithText(content, "Requirements") {
 		hasNormative := containsText(content, "MUST") || containsText(content, "SHOULD") || containsText(content, "MAY")
 		if !hasNormative {
@@ -1315,79 +1885,74 @@ func validateSpecification(content string) ValidationResult {
 		}
 	}
 
-	if containsText(content, "<!-- ") && containsText(content, " -->") {
-		result.Warnings = append(result.Warnings, "Document contains unfilled template comments")
-	}
+	checkTemplatePlaceholders(content, &result)
 
 	return result
 }
 
-// validateDesign checks for required design doc sections and metadata.
-func validateDesign(content string) ValidationResult {
-	result := ValidationResult{Document: "design.md"}
+// designOptionPattern matches an "Option 1" or "Option A" heading.
+var designOptionPattern = regexp.MustCompile(`(?i)^#+\s*option\s+([0-9]+|[a-z])\b`)
 
-	requiredSections := []struct {
-		name string
-		hint string
-	}{
-		{"Context", "Establish the technical landscape and constraints"},
-		{"Goals and Non-Goals", "Define goals and explicitly excluded items"},
-		{"Options Considered", "Document at least 2 viable approaches"},
-		{"Decision", "State the chosen approach and rationale"},
-		{"Detailed Design", "Describe architecture, components, data, or API design"},
-		{"Cross-Cutting Concerns", "Address security, performance, reliability, testing"},
-		{"Implementation Plan", "Define phased approach and milestones"},
-	}
-
-	recommendedSections := []struct {
-		name string
-		hint string
-	}{
-		{"Open Questions", "List unresolved items with owners and blocking status"},
-	}
+// singleOptionJustificationPattern matches the explicit justification a
+// single-option design needs: a "Justification" heading or bold label, or
+// a sentence saying there is only one option.
+var singleOptionJustificationPattern = regexp.MustCompile(`(?im)^\s*(#+.*justification|\*\*justification\*\*)|only one (viable )?option`)
 
-	for _, section := range requiredSections {
-		if !containsHeaderWithText(content, section.name) {
-			result.Errors = append(result.Errors, fmt.Sprintf("Missing required section: %s - %s", section.name, section.hint))
+// countDesignOptions returns the number of distinct option headings.
+func countDesignOptions(content string) int {
+	seen := make(map[string]bool)
+	for _, line := range strings.Split(content, "\n") {
+		if m := designOptionPattern.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			seen[strings.ToLower(m[1])] = true
 		}
 	}
+	return len(seen)
+}
 
-	for _, section := range recommendedSections {
-		if !containsHeaderWithText(content, section.name) {
-			result.Warnings = append(result.Warnings, fmt.Sprintf("Missing recommended section: %s - %s", section.name, section.hint))
-		}
-	}
+// validateDesign checks for the guideline sections and metadata. Metadata
+// may be given inline or in a YAML front-matter block. In strict mode a
+// single documented option is an error unless justified.
+func validateDesign(content string, strict bool, sections []guidelineSection) ValidationResult {
+	result := ValidationResult{Document: "design.md"}
+
+	meta, content, _ := workspace.ParseFrontMatter(content)
+
+	checkGuidelineSections(content, sections, &result)
 
-	hasTitle := containsText(content, "# Design:") || containsText(content, "# design:")
+	hasTitle := meta.Title != "" || containsText(content, "# Design:") || containsText(content, "# design:")
 	if !hasTitle {
 		result.Errors = append(result.Errors, "Missing metadata: Title should be 'Design: [Feature Name]'")
 	}
 
-	hasSpecRef := containsText(content, "Specification Reference") || containsText(content, "specification reference")
+	hasSpecRef := meta.SpecificationReference != "" || containsText(content, "Specification Reference") || containsText(content, "specification reference")
 	if !hasSpecRef {
 		result.Warnings = append(result.Warnings, "Missing metadata: Specification Reference")
 	}
 
-	hasStatus := containsText(content, "Status:") || containsText(content, "status:")
+	hasStatus := meta.Status != "" || containsText(content, "Status:") || containsText(content, "**Status**:")
 	if !hasStatus {
 		result.Warnings = append(result.Warnings, "Missing metadata: Status (Draft | Review | Approved | Superseded)")
 	}
 
-	hasOption1 := containsHeaderWithText(content, "Option 1") || containsHeaderWithText(content, "Option A")
-	hasOption2 := containsHeaderWithText(content, "Option 2") || containsHeaderWithText(content, "Option B")
-	if hasOption1 && !hasOption2 {
-		result.Warnings = append(result.Warnings, "Only one option documented - guidelines require at least 2 alternatives or justification")
+	switch options := countDesignOptions(content); {
+	case options == 0:
+		result.Errors = append(result.Errors, "No options documented - add 'Option 1' and 'Option 2' headings for at least 2 alternatives")
+	case options == 1 && !singleOptionJustificationPattern.MatchString(content):
+		message := "Only one option documented - guidelines require at least 2 alternatives or justification"
+		if strict {
+			result.Errors = append(result.Errors, message+" (add a 'Justification' section explaining why there is only one option)")
+		} else {
+			result.Warnings = append(result.Warnings, message)
+		}
 	}
 
-	if containsText(content, "<!-- ") && containsText(content, " -->") {
-		result.Warnings = append(result.Warnings, "Document contains unfilled template comments")
-	}
+	checkTemplatePlaceholders(content, &result)
 
 	return result
 }
 
 // validateImplementation checks for phases and task checkboxes.
-func validateImplementation(content string) ValidationResult {
+func validateImplementation(content string, strict bool) ValidationResult {
 	result := ValidationResult{Document: "implementation.md"}
 
 	if !containsHeaderWithText(content, "Phase") {
@@ -1398,67 +1963,373 @@ func validateImplementation(content string) ValidationResult {
 		result.Warnings = append(result.Warnings, "No task checkboxes found - consider adding actionable tasks")
 	}
 
-	if containsText(content, "<!-- ") && containsText(content, " -->") {
-		result.Warnings = append(result.Warnings, "Document contains unfilled template comments")
-	}
+	checkTaskIDs(content, strict, &result)
+	checkTemplatePlaceholders(content, &result)
 
 	return result
 }
 
+// checkTaskIDs reports tasks without a {#id} marker, as a warning or, under
+// strict validation, an error. Ids give task_complete a reference that
+// survives tasks being reordered.
+func checkTaskIDs(content string, strict bool, result *ValidationResult) {
+	var lines []string
+	for _, phase := range extractPhases(content) {
+		for _, task := range phase.Tasks {
+			if id, _ := parseTaskMarker(task.Text); id == "" {
+				lines = append(lines, strconv.Itoa(task.Line))
+			}
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	message := fmt.Sprintf("%d task(s) without a {#id} marker (line %s) - run 'nocturnal spec proposal idify' to assign ids", len(lines), strings.Join(lines, ", "))
+	if strict {
+		result.Errors = append(result.Errors, message)
+	} else {
+		result.Warnings = append(result.Warnings, message)
+	}
+}
+
+// validateCustomDocument returns a validator for configured documents that
+// have no dedicated rules: they must have content and no template comments.
+func validateCustomDocument(filename string) func(string) ValidationResult {
+	return func(content string) ValidationResult {
+		result := ValidationResult{Document: filename}
+
+		if strings.TrimSpace(content) == "" {
+			result.Errors = append(result.Errors, "Document is empty")
+		}
+
+		checkTemplatePlaceholders(content, &result)
+
+		return result
+	}
+}
+
 func runSpecProposalValidate(cmd *cobra.Command, args []string) {
-	slug := args[0]
 	specPath, err := checkSpecWorkspace()
 	if err != nil {
 		printWorkspaceError()
 		return
 	}
 
-	proposalPath, err := checkProposal(specPath, slug)
+	if validateAll == (len(args) == 1) {
+		printError("Specify a proposal slug or --all")
+		return
+	}
+	if validateArchived && validateFix {
+		printError("--fix cannot be used with --archived")
+		return
+	}
+	if _, ok := validateReportFormats[validateFormat]; !ok {
+		printError(fmt.Sprintf("Unknown format '%s': use markdown, json, junit, or sarif", validateFormat))
+		return
+	}
+	if cmd.Flags().Changed("format") && validateOutput == "" {
+		printError("--format requires --output")
+		return
+	}
+
+	slugs := args
+	if validateAll {
+		if validateArchived {
+			slugs = listArchivedSlugs(specPath)
+		} else {
+			slugs = listProposalSlugs(specPath)
+		}
+		if len(slugs) == 0 {
+			printDim("No proposals found")
+			return
+		}
+	}
+
+	reports, totalErrors, totalWarnings, err := validateProposals(specPath, slugs)
 	if err != nil {
 		printError(err.Error())
 		return
 	}
 
-	fmt.Println()
-	fmt.Println(boldStyle.Render(fmt.Sprintf("Validating proposal: %s", slug)))
-	fmt.Println()
+	if len(slugs) > 1 {
+		fmt.Println()
+		summary := fmt.Sprintf("Validated %d proposal(s): %d error(s), %d warning(s)", len(reports), totalErrors, totalWarnings)
+		if len(reports) < len(slugs) {
+			summary = fmt.Sprintf("Stopped at '%s' after %d of %d proposal(s) (--fail-fast): %d error(s), %d warning(s)", reports[len(reports)-1].Slug, len(reports), len(slugs), totalErrors, totalWarnings)
+		}
+		switch {
+		case totalErrors > 0:
+			printError(summary)
+		case totalWarnings > 0:
+			printWarning(summary)
+		default:
+			printSuccess(summary)
+		}
+	}
 
-	var totalErrors, totalWarnings int
-	var results []ValidationResult
+	if validateJUnit != "" {
+		if err := writeJUnitReport(validateJUnit, reports); err != nil {
+			printError(err.Error())
+			return
+		}
+		printDim(fmt.Sprintf("JUnit report written to %s", validateJUnit))
+	}
 
-	documents := []struct {
-		filename string
-		validate func(string) ValidationResult
-	}{
-		{"specification.md", validateSpecification},
-		{"design.md", validateDesign},
-		{"implementation.md", validateImplementation},
+	if validateOutput != "" {
+		base := proposalDir
+		if validateArchived {
+			base = archiveDir
+		}
+		written, err := writeValidationReports(validateOutput, validateFormat, base, reports)
+		if err != nil {
+			printError(err.Error())
+			return
+		}
+		printDim(fmt.Sprintf("Wrote %s to %s", countNoun(written, validateFormat+" report"), validateOutput))
 	}
 
-	for _, doc := range documents {
-		filePath := filepath.Join(proposalPath, doc.filename)
-		content, err := os.ReadFile(filePath)
+	if validateFailFast && totalErrors > 0 {
+		os.Exit(1)
+	}
+}
+
+// validateProposals validates each proposal in turn, printing its results.
+// With --fail-fast it stops after the first proposal with errors, so the
+// reports cover only the proposals validated.
+func validateProposals(specPath string, slugs []string) (reports []ProposalValidation, totalErrors, totalWarnings int, err error) {
+	for _, slug := range slugs {
+		var results []ValidationResult
+		if validateArchived {
+			if !workspace.FileExists(filepath.Join(specPath, archiveDir, slug)) {
+				return reports, totalErrors, totalWarnings, fmt.Errorf("archived proposal '%s' does not exist", slug)
+			}
+
+			fmt.Println()
+			fmt.Println(boldStyle.Render(fmt.Sprintf("Validating archived proposal: %s", slug)))
+			fmt.Println()
+
+			results = validateArchivedDocuments(specPath, slug)
+		} else {
+			proposalPath, err := checkProposal(specPath, slug)
+			if err != nil {
+				return reports, totalErrors, totalWarnings, err
+			}
+
+			fmt.Println()
+			fmt.Println(boldStyle.Render(fmt.Sprintf("Validating proposal: %s", slug)))
+			fmt.Println()
+
+			if validateFix {
+				fixProposalSections(specPath, proposalPath)
+			}
+
+			results = validateProposalDocuments(specPath, proposalPath)
+			if validateCheckFiles {
+				results = checkAffectedFiles(specPath, proposalPath, results)
+			}
+			if validateComponents {
+				results = checkComponentAlignment(proposalPath, results)
+			}
+			results = append(results, guidelineChangeResults(specPath, slug)...)
+			results = waiveProposalResults(specPath, proposalPath, results)
+		}
+		errors, warnings := printValidationResults(results)
+		totalErrors += errors
+		totalWarnings += warnings
+		reports = append(reports, ProposalValidation{Slug: slug, Results: results})
+
+		if validateFailFast && errors > 0 {
+			break
+		}
+	}
+	return reports, totalErrors, totalWarnings, nil
+}
+
+// ProposalValidation is the validation outcome of a single proposal.
+type ProposalValidation struct {
+	Slug    string
+	Results []ValidationResult
+}
+
+// listProposalSlugs returns the names of all proposal directories.
+func listProposalSlugs(specPath string) []string {
+	return listSubdirNames(filepath.Join(specPath, proposalDir))
+}
+
+// listArchivedSlugs returns the names of all archived proposals.
+func listArchivedSlugs(specPath string) []string {
+	return listSubdirNames(filepath.Join(specPath, archiveDir))
+}
+
+// listSubdirNames returns the names of the directories in dir.
+func listSubdirNames(dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names
+}
+
+// validateProposalDocuments validates each configured document of a
+// proposal. Missing documents are reported as errors, and a proposal that is
+// still mostly template placeholders gets one "unstarted" warning in place
+// of its other warnings.
+func validateProposalDocuments(specPath, proposalPath string) []ValidationResult {
+	results := validateDocuments(specPath, func(file string) string {
+		return filepath.Join(proposalPath, file)
+	})
+	return checkUnstartedProposal(specPath, proposalPath, results)
+}
+
+// checkAffectedFiles adds a warning to the specification.md result for each
+// path in its Affected files field that does not exist relative to the
+// directory containing the workspace. Paths may be glob patterns.
+func checkAffectedFiles(specPath, proposalPath string, results []ValidationResult) []ValidationResult {
+	files, err := getAffectedFiles(proposalPath)
+	if err != nil || len(files) == 0 {
+		return results
+	}
+
+	var warnings []string
+	for _, file := range missingAffectedFiles(filepath.Dir(specPath), files) {
+		warnings = append(warnings, fmt.Sprintf("Affected file does not exist: %s", file))
+	}
+	if len(warnings) == 0 {
+		return results
+	}
+
+	for i := range results {
+		if results[i].Document == "specification.md" {
+			results[i].Warnings = append(results[i].Warnings, warnings...)
+			return results
+		}
+	}
+	return append(results, ValidationResult{Document: "specification.md", Warnings: warnings})
+}
+
+// missingAffectedFiles returns the files that match nothing under root.
+func missingAffectedFiles(root string, files []string) []string {
+	var missing []string
+	for _, file := range files {
+		matches, err := filepath.Glob(filepath.Join(root, filepath.FromSlash(file)))
+		if err != nil || len(matches) == 0 {
+			missing = append(missing, file)
+		}
+	}
+	return missing
+}
+
+// guidelineChangeResults warns about each validation guideline that changed
+// since the proposal was created, since a proposal that passed against the
+// old guidelines may now fail for reasons unrelated to its own edits.
+func guidelineChangeResults(specPath, slug string) []ValidationResult {
+	changed, err := changedGuidelines(specPath, slug)
+	if err != nil {
+		debugf("skipping guideline change check for %s: %v", slug, err)
+		return nil
+	}
+
+	var results []ValidationResult
+	for _, filename := range changed {
+		results = append(results, ValidationResult{
+			Document: filename,
+			Warnings: []string{"Guidelines changed since this proposal was created - review new requirements before treating failures as regressions"},
+		})
+	}
+	return results
+}
+
+// validateArchivedDocuments validates the documents of an archived proposal.
+// A completed proposal's specification was promoted to section/<slug>.md
+// rather than archived, so it is read from there; an abandoned proposal
+// keeps every document in the archive.
+func validateArchivedDocuments(specPath, slug string) []ValidationResult {
+	archivePath := filepath.Join(specPath, archiveDir, slug)
+	return validateDocuments(specPath, func(file string) string {
+		archived := filepath.Join(archivePath, file)
+		if file == "specification.md" && !workspace.FileExists(archived) {
+			return filepath.Join(specPath, sectionDir, slug+".md")
+		}
+		return archived
+	})
+}
+
+// validateDocuments validates each configured document, reading it from the
+// path returned by docPath. Missing documents are reported as errors.
+func validateDocuments(specPath string, docPath func(file string) string) []ValidationResult {
+	var results []ValidationResult
+
+	strict := loadConfigOrDefault(specPath).Validation.Strict
+	specGuidelines := loadGuidelineSections(specPath, "specification.md")
+	designGuidelines := loadGuidelineSections(specPath, "design.md")
+	validators := map[string]func(string) ValidationResult{
+		"specification.md": func(content string) ValidationResult {
+			return validateSpecification(content, specGuidelines)
+		},
+		"design.md": func(content string) ValidationResult {
+			return validateDesign(content, strict, designGuidelines)
+		},
+		"implementation.md": func(content string) ValidationResult {
+			return validateImplementation(content, strict)
+		},
+	}
+
+	for _, doc := range proposalDocuments(specPath) {
+		content, err := os.ReadFile(docPath(doc.File))
 		if err != nil {
 			if os.IsNotExist(err) {
 				results = append(results, ValidationResult{
-					Document: doc.filename,
+					Document: doc.File,
 					Errors:   []string{"File not found"},
 				})
-				totalErrors++
 				continue
 			}
-			printError(fmt.Sprintf("Failed to read %s: %v", doc.filename, err))
+			results = append(results, ValidationResult{
+				Document: doc.File,
+				Errors:   []string{fmt.Sprintf("Failed to read: %v", err)},
+			})
 			continue
 		}
 
-		result := doc.validate(string(content))
-		results = append(results, result)
-		totalErrors += len(result.Errors)
-		totalWarnings += len(result.Warnings)
+		validate, ok := validators[doc.File]
+		if !ok {
+			validate = validateCustomDocument(doc.File)
+		}
+		results = append(results, validate(string(content)))
 	}
 
+	return results
+}
+
+// validateProposalForTUI runs validateProposalDocuments for the TUI, so both
+// front ends apply the same rules and waivers.
+func validateProposalForTUI(specPath, proposalPath string) []tui.DocumentValidation {
+	var results []tui.DocumentValidation
+	for _, result := range waiveProposalResults(specPath, proposalPath, validateProposalDocuments(specPath, proposalPath)) {
+		results = append(results, tui.DocumentValidation(result))
+	}
+	return results
+}
+
+// printValidationResults prints each document's errors and warnings, then
+// a count line per document and the overall summary, and returns the
+// totals. With --summary-only only the counts are printed.
+func printValidationResults(results []ValidationResult) (totalErrors, totalWarnings int) {
 	for _, result := range results {
-		hasIssues := len(result.Errors) > 0 || len(result.Warnings) > 0
+		totalErrors += len(result.Errors)
+		totalWarnings += len(result.Warnings)
+		if validateSummary {
+			continue
+		}
+		hasIssues := len(result.Errors) > 0 || len(result.Warnings) > 0 || len(result.Notes) > 0
 
 		if len(result.Errors) > 0 {
 			fmt.Println(errorStyle.Render(fmt.Sprintf("✗ %s", result.Document)))
@@ -1476,12 +2347,30 @@ func runSpecProposalValidate(cmd *cobra.Command, args []string) {
 			fmt.Println(warningStyle.Render(fmt.Sprintf("    ⚠ %s", warn)))
 		}
 
+		for _, note := range result.Notes {
+			fmt.Println(infoStyle.Render(fmt.Sprintf("    ℹ %s", note)))
+		}
+
 		if hasIssues {
 			fmt.Println()
 		}
 	}
 
-	fmt.Println(dimStyle.Render("---"))
+	if !validateSummary {
+		fmt.Println(dimStyle.Render("---"))
+	}
+	for _, result := range results {
+		line := documentCountLine(result)
+		switch {
+		case len(result.Errors) > 0:
+			fmt.Println(errorStyle.Render(line))
+		case len(result.Warnings) > 0:
+			fmt.Println(warningStyle.Render(line))
+		default:
+			fmt.Println(dimStyle.Render(line))
+		}
+	}
+
 	if totalErrors == 0 && totalWarnings == 0 {
 		printSuccess("All documents pass validation")
 	} else {
@@ -1492,6 +2381,22 @@ func runSpecProposalValidate(cmd *cobra.Command, args []string) {
 			printWarning(summary)
 		}
 	}
+
+	return totalErrors, totalWarnings
+}
+
+// documentCountLine returns a document's counts, such as
+// "specification.md: 2 errors, 1 warning".
+func documentCountLine(result ValidationResult) string {
+	return fmt.Sprintf("%s: %s, %s", result.Document, countNoun(len(result.Errors), "error"), countNoun(len(result.Warnings), "warning"))
+}
+
+// countNoun formats n with noun, adding an "s" unless n is 1.
+func countNoun(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
 }
 
 func runSpecProposalList(cmd *cobra.Command, args []string) {
@@ -1501,52 +2406,77 @@ func runSpecProposalList(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	proposalsPath := filepath.Join(specPath, proposalDir)
-	entries, err := os.ReadDir(proposalsPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			printDim("No proposals found")
+	if !checkListFormat(proposalListFormat) {
+		return
+	}
+	if !contains(proposalListFilters, proposalListFilter) {
+		printError(fmt.Sprintf("Unknown filter '%s' (use %s)", proposalListFilter, strings.Join(proposalListFilters, ", ")))
+		return
+	}
+	if proposalListTree {
+		if proposalListFormat != "text" || proposalListFilter != "all" {
+			printError("--tree cannot be combined with --format or --filter")
 			return
 		}
+		runSpecProposalListTree(specPath)
+		return
+	}
+
+	proposalsPath := filepath.Join(specPath, proposalDir)
+	entries, err := os.ReadDir(proposalsPath)
+	if err != nil && !os.IsNotExist(err) {
 		printError(fmt.Sprintf("Failed to read proposals directory: %v", err))
 		return
 	}
 
 	activeSlug := getActiveProposalSlug(specPath)
+	state, err := loadState(specPath)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to load state: %v", err))
+		return
+	}
 
 	var proposals []string
 	for _, entry := range entries {
-		if entry.IsDir() {
-			proposals = append(proposals, entry.Name())
+		if !entry.IsDir() {
+			continue
+		}
+		if proposalListFilter != "all" && proposalListStatus(specPath, entry.Name(), state) != proposalListFilter {
+			continue
 		}
+		proposals = append(proposals, entry.Name())
 	}
 
-	if len(proposals) == 0 {
+	if len(proposals) == 0 && proposalListFormat == "text" {
+		if proposalListFilter != "all" {
+			printDim(fmt.Sprintf("No %s proposals found", proposalListFilter))
+			return
+		}
 		printDim("No proposals found")
 		printDim("Use 'nocturnal spec proposal add <name>' to create one")
 		return
 	}
 
-	fmt.Println()
-	fmt.Println(boldStyle.Render(fmt.Sprintf("Proposals (%d)", len(proposals))))
-	fmt.Println()
-
-	// Header
-	fmt.Printf("  %-20s %-10s %-15s %s\n",
-		dimStyle.Render("NAME"),
-		dimStyle.Render("STATUS"),
-		dimStyle.Render("PROGRESS"),
-		dimStyle.Render("DEPENDENCIES"))
-	fmt.Println()
-
+	var rows, csvRows [][]string
 	for _, name := range proposals {
 		propPath := filepath.Join(proposalsPath, name)
-		total, completed := getProposalProgress(propPath)
-		deps, _ := getProposalDependencies(propPath)
+		total, completed := workspace.ProposalProgress(propPath)
+		deps, _ := workspace.ProposalDependencies(propPath)
+		estimated, actual := getProposalEffort(propPath)
+
+		isActive := state.IsProposalActive(name)
+		csvStatus, csvProgress := "inactive", ""
+		if isActive {
+			csvStatus = "active"
+		}
+		if total > 0 {
+			csvProgress = strconv.Itoa((completed * 100) / total)
+		}
+		csvRows = append(csvRows, []string{name, csvStatus, csvProgress, strconv.Itoa(completed), strconv.Itoa(total), strings.Join(deps, ", ")})
 
 		// Status
 		status := dimStyle.Render("inactive")
-		if name == activeSlug {
+		if isActive {
 			status = successStyle.Render("active")
 		}
 
@@ -1559,6 +2489,19 @@ func runSpecProposalList(cmd *cobra.Command, args []string) {
 			progress = dimStyle.Render("no tasks")
 		}
 
+		// Effort (actual/estimated when both are known)
+		var effort string
+		switch {
+		case estimated > 0 && actual > 0:
+			effort = fmt.Sprintf("%s/%s", formatEffort(actual), formatEffort(estimated))
+		case estimated > 0:
+			effort = formatEffort(estimated)
+		case actual > 0:
+			effort = formatEffort(actual)
+		default:
+			effort = dimStyle.Render("-")
+		}
+
 		// Dependencies
 		var depsStr string
 		if len(deps) > 0 {
@@ -1573,11 +2516,102 @@ func runSpecProposalList(cmd *cobra.Command, args []string) {
 			displayName = infoStyle.Render(name)
 		}
 
-		fmt.Printf("  %-20s %-10s %-15s %s\n", displayName, status, progress, depsStr)
+		created := formatProposalMeta(state.Proposals[name])
+		if created == "" {
+			created = dimStyle.Render("-")
+		}
+
+		rows = append(rows, []string{displayName, status, progress, effort, depsStr, created})
+	}
+
+	if proposalListFormat == "csv" {
+		printCSV([]string{"name", "status", "progress", "completed", "total", "dependencies"}, csvRows)
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(boldStyle.Render(fmt.Sprintf("Proposals (%d)", len(proposals))))
+	fmt.Println()
+	fmt.Print(renderTable([]string{"NAME", "STATUS", "PROGRESS", "EFFORT", "DEPENDENCIES", "CREATED"}, rows))
+	fmt.Println()
+}
+
+// runSpecProposalListTree prints proposals and completed specifications
+// grouped by dependency layer, followed by any that are caught in a cycle.
+func runSpecProposalListTree(specPath string) {
+	nodes, err := buildDependencyGraph(specPath)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to build dependency graph: %v", err))
+		return
+	}
+	if len(nodes) == 0 {
+		printDim("No proposals found")
+		printDim("Use 'nocturnal spec proposal add <name>' to create one")
+		return
+	}
+
+	row := func(slug string) []string {
+		node := nodes[slug]
+		var status string
+		switch {
+		case node.IsMissing:
+			status = errorStyle.Render("missing")
+		case node.IsCompleted:
+			status = successStyle.Render("completed")
+		case node.IsActive:
+			status = infoStyle.Render("active")
+		default:
+			status = dimStyle.Render("inactive")
+		}
+		deps := dimStyle.Render("-")
+		if len(node.Dependencies) > 0 {
+			deps = strings.Join(node.Dependencies, ", ")
+		}
+		return []string{slug, status, deps}
+	}
+
+	layers, unlayered := dependencyLayers(nodes)
+	for i, layer := range layers {
+		var rows [][]string
+		for _, slug := range layer {
+			rows = append(rows, row(slug))
+		}
+		fmt.Println()
+		fmt.Println(boldStyle.Render(fmt.Sprintf("Layer %d (%d)", i, len(layer))))
+		fmt.Println()
+		fmt.Print(renderTable([]string{"NAME", "STATUS", "DEPENDENCIES"}, rows))
+	}
+
+	if len(unlayered) > 0 {
+		var rows [][]string
+		for _, slug := range unlayered {
+			rows = append(rows, row(slug))
+		}
+		fmt.Println()
+		printWarning(fmt.Sprintf("Not layered: %d proposal(s) in or behind a dependency cycle", len(unlayered)))
+		for _, cycle := range detectCycles(nodes) {
+			fmt.Printf("  %s\n", warningStyle.Render(strings.Join(cycle, " -> ")))
+		}
+		fmt.Println()
+		fmt.Print(renderTable([]string{"NAME", "STATUS", "DEPENDENCIES"}, rows))
 	}
 	fmt.Println()
 }
 
+// proposalListStatus classifies a proposal for --filter: "active" if it is
+// activated, otherwise "blocked" if any dependency is not yet completed,
+// otherwise "pending".
+func proposalListStatus(specPath, slug string, state *workspace.State) string {
+	if state.IsProposalActive(slug) {
+		return "active"
+	}
+	missing, err := getMissingCompletedDependencies(specPath, filepath.Join(specPath, proposalDir, slug))
+	if err == nil && len(missing) > 0 {
+		return "blocked"
+	}
+	return "pending"
+}
+
 func runSpecProposalAbandon(cmd *cobra.Command, args []string) {
 	slug := args[0]
 	specPath, err := checkSpecWorkspace()
@@ -1595,7 +2629,7 @@ func runSpecProposalAbandon(cmd *cobra.Command, args []string) {
 	archivePath := filepath.Join(specPath, archiveDir, slug)
 
 	// Archive all proposal documents
-	if err := archiveProposalDocs(proposalPath, archivePath, proposalDocFiles); err != nil {
+	if err := workspace.ArchiveProposalDocs(proposalPath, archivePath, proposalDocFilenames(proposalDocuments(specPath))); err != nil {
 		printError(err.Error())
 		return
 	}
@@ -1613,6 +2647,9 @@ func runSpecProposalAbandon(cmd *cobra.Command, args []string) {
 	}
 
 	clearActiveProposalIfMatches(specPath, slug)
+	if err := recordProposalEvent(specPath, slug, workspace.EventAbandoned); err != nil {
+		printWarning(fmt.Sprintf("Failed to record abandonment in history: %v", err))
+	}
 	printSuccess(fmt.Sprintf("Abandoned proposal '%s'", slug))
 	printDim(fmt.Sprintf("Archived to %s/%s/", archiveDir, slug))
 }
@@ -1635,7 +2672,7 @@ func runSpecConfigShow(cmd *cobra.Command, args []string) {
 	fmt.Println()
 
 	configPath := getConfigPath(specPath)
-	if fileExists(configPath) {
+	if workspace.FileExists(configPath) {
 		printDim(fmt.Sprintf("Source: %s", configPath))
 	} else {
 		printDim("Source: defaults (no config file)")
@@ -1654,6 +2691,31 @@ func runSpecConfigShow(cmd *cobra.Command, args []string) {
 	fmt.Println(boldStyle.Render("Context"))
 	fmt.Printf("  include_affected_files: %v\n", config.Context.IncludeAffectedFiles)
 	fmt.Printf("  max_file_lines: %d\n", config.Context.MaxFileLines)
+	if config.Context.MaxOutputBytes > 0 {
+		fmt.Printf("  max_output_bytes: %d\n", config.Context.MaxOutputBytes)
+	} else {
+		fmt.Printf("  max_output_bytes: %s\n", dimStyle.Render("0 (no limit)"))
+	}
+	fmt.Println()
+
+	fmt.Println(boldStyle.Render("UI"))
+	if config.UI.Editor != "" {
+		fmt.Printf("  editor: %s\n", config.UI.Editor)
+	} else {
+		fmt.Printf("  editor: %s\n", dimStyle.Render("(unset, using $EDITOR)"))
+	}
+	fmt.Printf("  watch_debounce_ms: %d\n", config.UI.WatchDebounceMs)
+	fmt.Println()
+
+	fmt.Println(boldStyle.Render("Proposal"))
+	fmt.Println("  documents:")
+	for _, doc := range proposalDocuments(specPath) {
+		template := doc.Template
+		if template == "" {
+			template = "(title only)"
+		}
+		fmt.Printf("    %s %s\n", doc.File, dimStyle.Render(template))
+	}
 	fmt.Println()
 }
 
@@ -1665,7 +2727,7 @@ func runSpecConfigInit(cmd *cobra.Command, args []string) {
 	}
 
 	configPath := getConfigPath(specPath)
-	if fileExists(configPath) {
+	if workspace.FileExists(configPath) {
 		printWarning("Configuration file already exists")
 		printDim(fmt.Sprintf("Location: %s", configPath))
 		return
@@ -1709,9 +2771,25 @@ func runSpecConfigSet(cmd *cobra.Command, args []string) {
 			return
 		}
 		config.Context.MaxFileLines = lines
+	case "context.max_output_bytes":
+		var bytes int
+		if _, err := fmt.Sscanf(value, "%d", &bytes); err != nil {
+			printError("Invalid value: must be a number")
+			return
+		}
+		config.Context.MaxOutputBytes = bytes
+	case "ui.editor":
+		config.UI.Editor = value
+	case "ui.watch_debounce_ms":
+		var ms int
+		if _, err := fmt.Sscanf(value, "%d", &ms); err != nil || ms < 0 {
+			printError("Invalid value: must be a non-negative number")
+			return
+		}
+		config.UI.WatchDebounceMs = ms
 	default:
 		printError(fmt.Sprintf("Unknown config key: %s", key))
-		printDim("Valid keys: validation.strict, context.include_affected_files, context.max_file_lines")
+		printDim("Valid keys: validation.strict, context.include_affected_files, context.max_file_lines, context.max_output_bytes, ui.editor, ui.watch_debounce_ms")
 		return
 	}
 
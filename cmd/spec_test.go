@@ -0,0 +1,909 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+func TestInitSpecWorkspace(t *testing.T) {
+	t.Parallel()
+
+	specPath := filepath.Join(t.TempDir(), specDir)
+	if err := initSpecWorkspace(specPath, false); err != nil {
+		t.Fatalf("initSpecWorkspace error: %v", err)
+	}
+
+	for _, dir := range workspaceDirs {
+		if !workspace.FileExists(filepath.Join(specPath, dir)) {
+			t.Errorf("expected directory %s to exist", dir)
+		}
+	}
+	for _, tf := range workspaceTemplates {
+		if !workspace.FileExists(filepath.Join(specPath, tf.filename)) {
+			t.Errorf("expected %s to exist", tf.filename)
+		}
+	}
+}
+
+func TestInitSpecWorkspaceBare(t *testing.T) {
+	t.Parallel()
+
+	specPath := filepath.Join(t.TempDir(), specDir)
+	if err := initSpecWorkspace(specPath, true); err != nil {
+		t.Fatalf("initSpecWorkspace error: %v", err)
+	}
+
+	for _, dir := range workspaceDirs {
+		if !workspace.FileExists(filepath.Join(specPath, dir)) {
+			t.Errorf("expected directory %s to exist", dir)
+		}
+	}
+
+	for _, name := range []string{"AGENTS.md", "specification guidelines.md", "design guidelines.md", "coding guidelines.md"} {
+		if workspace.FileExists(filepath.Join(specPath, name)) {
+			t.Errorf("expected %s to be absent under --bare", name)
+		}
+	}
+
+	content, err := os.ReadFile(filepath.Join(specPath, projectFile))
+	if err != nil {
+		t.Fatalf("expected project.md to exist: %v", err)
+	}
+	if len(content) != 0 {
+		t.Errorf("expected empty project.md, got %d bytes", len(content))
+	}
+}
+
+func TestInitWorkspaceGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("GIT_CEILING_DIRECTORIES", filepath.Dir(dir))
+	t.Setenv("GIT_CONFIG_GLOBAL", os.DevNull)
+	for _, key := range []string{"GIT_AUTHOR_NAME", "GIT_COMMITTER_NAME"} {
+		t.Setenv(key, "Test")
+	}
+	for _, key := range []string{"GIT_AUTHOR_EMAIL", "GIT_COMMITTER_EMAIL"} {
+		t.Setenv(key, "test@example.com")
+	}
+
+	initGit = true
+	t.Cleanup(func() { initGit = false })
+	runSpecInit(specInitCmd, nil)
+
+	if !workspace.FileExists(filepath.Join(dir, ".git")) {
+		t.Fatal("expected a git repository to be created")
+	}
+
+	ignore, err := os.ReadFile(filepath.Join(dir, ".gitignore"))
+	if err != nil {
+		t.Fatalf("expected .gitignore: %v", err)
+	}
+	for _, entry := range workspaceGitignore() {
+		if !strings.Contains(string(ignore), entry+"\n") {
+			t.Errorf(".gitignore missing %q:\n%s", entry, ignore)
+		}
+	}
+
+	out, err := exec.Command("git", "ls-tree", "-r", "--name-only", "HEAD").Output()
+	if err != nil {
+		t.Fatalf("git ls-tree: %v", err)
+	}
+	for _, want := range []string{".gitignore", "spec/project.md", "spec/nocturnal.yaml"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("expected %s to be committed, got:\n%s", want, out)
+		}
+	}
+
+	// A second run inside the repository leaves it alone
+	created, err := initWorkspaceGit(getSpecPath())
+	if err != nil || created {
+		t.Errorf("initWorkspaceGit() in existing repo = %v, %v; want false, nil", created, err)
+	}
+}
+
+func TestUpgradeSpecWorkspace(t *testing.T) {
+	t.Parallel()
+
+	specPath := t.TempDir()
+	for _, dir := range []string{ruleDir, proposalDir} {
+		if err := os.MkdirAll(filepath.Join(specPath, dir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	projectPath := filepath.Join(specPath, projectFile)
+	if err := os.WriteFile(projectPath, []byte("# My Project\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	added, err := upgradeSpecWorkspace(specPath)
+	if err != nil {
+		t.Fatalf("upgradeSpecWorkspace error: %v", err)
+	}
+
+	addedSet := make(map[string]bool)
+	for _, item := range added {
+		addedSet[item] = true
+	}
+	for _, want := range []string{"archive/", "section/", "maintenance/", "third/", "AGENTS.md", "design guidelines.md", configFileName} {
+		if !addedSet[want] {
+			t.Errorf("expected %s to be added, got %v", want, added)
+		}
+	}
+	for _, existing := range []string{"rule/", "proposal/", projectFile} {
+		if addedSet[existing] {
+			t.Errorf("did not expect existing %s to be reported as added", existing)
+		}
+	}
+
+	content, err := os.ReadFile(projectPath)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if string(content) != "# My Project\n" {
+		t.Errorf("project.md was overwritten: %q", content)
+	}
+
+	// Second run is a no-op
+	added, err = upgradeSpecWorkspace(specPath)
+	if err != nil {
+		t.Fatalf("second upgradeSpecWorkspace error: %v", err)
+	}
+	if len(added) != 0 {
+		t.Errorf("expected nothing added on second run, got %v", added)
+	}
+}
+
+func TestCompleteWorkspaceSlugs(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, ruleDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir, "add-auth"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	for _, name := range []string{"error-handling.md", "notes.txt"} {
+		if err := os.WriteFile(filepath.Join(specPath, ruleDir, name), []byte("# Rule\n"), 0o644); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+	}
+
+	rules, _ := completeRuleNames(nil, nil, "")
+	if len(rules) != 1 || rules[0] != "error-handling" {
+		t.Errorf("rule completions = %v, want [error-handling]", rules)
+	}
+
+	proposals, _ := completeProposalNames(nil, nil, "")
+	if len(proposals) != 1 || proposals[0] != "add-auth" {
+		t.Errorf("proposal completions = %v, want [add-auth]", proposals)
+	}
+
+	if got, _ := completeRuleNames(nil, []string{"error-handling"}, ""); got != nil {
+		t.Errorf("expected no completions after first arg, got %v", got)
+	}
+}
+
+func TestCompleteProposal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		archive      bool
+		keep         bool
+		wantArchive  bool
+		wantProposal bool
+	}{
+		{name: "default", archive: true, wantArchive: true},
+		{name: "no archive", archive: false},
+		{name: "keep", archive: true, keep: true, wantArchive: true, wantProposal: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			specPath := t.TempDir()
+			proposalPath := filepath.Join(specPath, proposalDir, "feature")
+			if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+				t.Fatalf("mkdir: %v", err)
+			}
+			if err := os.MkdirAll(filepath.Join(specPath, sectionDir), 0o755); err != nil {
+				t.Fatalf("mkdir: %v", err)
+			}
+			for _, name := range []string{"specification.md", "design.md", "implementation.md"} {
+				if err := os.WriteFile(filepath.Join(proposalPath, name), []byte("# "+name+"\n"), 0o644); err != nil {
+					t.Fatalf("write file: %v", err)
+				}
+			}
+
+			state, err := loadState(specPath)
+			if err != nil {
+				t.Fatalf("loadState error: %v", err)
+			}
+			state.ActivateProposal("feature", map[string]string{})
+			if err := saveState(specPath, state); err != nil {
+				t.Fatalf("saveState error: %v", err)
+			}
+
+			if _, err := completeProposal(specPath, "feature", tt.archive, tt.keep, sectionConflictError); err != nil {
+				t.Fatalf("completeProposal error: %v", err)
+			}
+
+			if !workspace.FileExists(filepath.Join(specPath, sectionDir, "feature.md")) {
+				t.Errorf("specification was not promoted")
+			}
+			if got := workspace.FileExists(filepath.Join(specPath, archiveDir, "feature", "design.md")); got != tt.wantArchive {
+				t.Errorf("archive exists = %v, want %v", got, tt.wantArchive)
+			}
+			if got := workspace.FileExists(proposalPath); got != tt.wantProposal {
+				t.Errorf("proposal dir exists = %v, want %v", got, tt.wantProposal)
+			}
+
+			state, err = loadState(specPath)
+			if err != nil {
+				t.Fatalf("loadState error: %v", err)
+			}
+			if state.IsProposalActive("feature") || state.Primary != "" {
+				t.Errorf("proposal still active after completion")
+			}
+		})
+	}
+}
+
+func TestCompleteProposalSectionConflict(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) string {
+		specPath := t.TempDir()
+		proposalPath := filepath.Join(specPath, proposalDir, "feature")
+		for _, dir := range []string{proposalPath, filepath.Join(specPath, sectionDir), filepath.Join(specPath, archiveDir, "feature")} {
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				t.Fatalf("mkdir: %v", err)
+			}
+		}
+		for _, name := range []string{"specification.md", "design.md"} {
+			if err := os.WriteFile(filepath.Join(proposalPath, name), []byte("# New "+name+"\n"), 0o644); err != nil {
+				t.Fatalf("write file: %v", err)
+			}
+		}
+		if err := os.WriteFile(filepath.Join(specPath, sectionDir, "feature.md"), []byte("# Old spec\n"), 0o644); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+		return specPath
+	}
+	readSection := func(t *testing.T, specPath, slug string) string {
+		content, err := os.ReadFile(filepath.Join(specPath, sectionDir, slug+".md"))
+		if err != nil {
+			t.Fatalf("read section: %v", err)
+		}
+		return string(content)
+	}
+
+	t.Run("blocked without force", func(t *testing.T) {
+		specPath := setup(t)
+		_, err := completeProposal(specPath, "feature", true, false, sectionConflictError)
+		if !errors.Is(err, errCompletedSpecExists) {
+			t.Fatalf("completeProposal error = %v, want errCompletedSpecExists", err)
+		}
+		if got := readSection(t, specPath, "feature"); got != "# Old spec\n" {
+			t.Errorf("existing section changed to %q", got)
+		}
+		if !workspace.FileExists(filepath.Join(specPath, proposalDir, "feature", "specification.md")) {
+			t.Error("proposal removed although completion was blocked")
+		}
+		if workspace.FileExists(filepath.Join(specPath, archiveDir, "feature", "design.md")) {
+			t.Error("documents archived although completion was blocked")
+		}
+	})
+
+	t.Run("force overwrites", func(t *testing.T) {
+		specPath := setup(t)
+		promoted, err := completeProposal(specPath, "feature", true, false, sectionConflictOverwrite)
+		if err != nil || promoted != "feature" {
+			t.Fatalf("completeProposal = %q, %v, want feature", promoted, err)
+		}
+		if got := readSection(t, specPath, "feature"); got != "# New specification.md\n" {
+			t.Errorf("section = %q, want the new specification", got)
+		}
+	})
+
+	t.Run("new version keeps both", func(t *testing.T) {
+		specPath := setup(t)
+		if err := os.WriteFile(filepath.Join(specPath, sectionDir, "feature-v2.md"), []byte("# V2\n"), 0o644); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+		promoted, err := completeProposal(specPath, "feature", true, false, sectionConflictVersion)
+		if err != nil || promoted != "feature-v3" {
+			t.Fatalf("completeProposal = %q, %v, want feature-v3", promoted, err)
+		}
+		if got := readSection(t, specPath, "feature"); got != "# Old spec\n" {
+			t.Errorf("existing section changed to %q", got)
+		}
+		if got := readSection(t, specPath, "feature-v3"); got != "# New specification.md\n" {
+			t.Errorf("versioned section = %q, want the new specification", got)
+		}
+		if !workspace.FileExists(filepath.Join(specPath, archiveDir, "feature-v3", "design.md")) {
+			t.Error("documents not archived under the versioned slug")
+		}
+	})
+}
+
+func TestCheckSection(t *testing.T) {
+	t.Parallel()
+
+	specPath := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(specPath, sectionDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	want := filepath.Join(specPath, sectionDir, "auth.md")
+	if err := os.WriteFile(want, []byte("# Auth\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	for _, name := range []string{"auth", "auth.md"} {
+		got, err := checkSection(specPath, name)
+		if err != nil {
+			t.Fatalf("checkSection(%q) error: %v", name, err)
+		}
+		if got != want {
+			t.Errorf("checkSection(%q) = %q, want %q", name, got, want)
+		}
+	}
+
+	if _, err := checkSection(specPath, "missing"); err == nil {
+		t.Errorf("expected error for missing section")
+	}
+}
+
+func TestUncompleteProposalRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	specPath := t.TempDir()
+	proposalPath := filepath.Join(specPath, proposalDir, "feature")
+	if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(specPath, sectionDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	docs := map[string]string{
+		"specification.md":  "# Spec\n",
+		"design.md":         "# Design\n",
+		"implementation.md": "# Implementation\n- [x] done\n",
+	}
+	for name, content := range docs {
+		if err := os.WriteFile(filepath.Join(proposalPath, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+	}
+
+	if _, err := completeProposal(specPath, "feature", true, false, sectionConflictError); err != nil {
+		t.Fatalf("completeProposal error: %v", err)
+	}
+
+	restored, err := uncompleteProposal(specPath, "feature")
+	if err != nil {
+		t.Fatalf("uncompleteProposal error: %v", err)
+	}
+	if len(restored) != 2 {
+		t.Errorf("restored = %v, want design.md and implementation.md", restored)
+	}
+
+	for name, want := range docs {
+		got, err := os.ReadFile(filepath.Join(proposalPath, name))
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		if string(got) != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if workspace.FileExists(filepath.Join(specPath, sectionDir, "feature.md")) {
+		t.Errorf("section entry should be removed")
+	}
+	if workspace.FileExists(filepath.Join(specPath, archiveDir, "feature")) {
+		t.Errorf("archive entry should be removed")
+	}
+
+	// A second uncomplete has nothing to demote
+	if _, err := uncompleteProposal(specPath, "feature"); err == nil {
+		t.Errorf("expected error when section entry is gone")
+	}
+}
+
+func TestUncompleteProposalRefusesExisting(t *testing.T) {
+	t.Parallel()
+
+	specPath := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir, "feature"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(specPath, sectionDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	sectionFile := filepath.Join(specPath, sectionDir, "feature.md")
+	if err := os.WriteFile(sectionFile, []byte("# Spec\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	if _, err := uncompleteProposal(specPath, "feature"); err == nil {
+		t.Fatalf("expected error when proposal already exists")
+	}
+	if !workspace.FileExists(sectionFile) {
+		t.Errorf("section entry must be left untouched")
+	}
+}
+
+func TestProposalAddDependsOn(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, sectionDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(specPath, sectionDir, "auth.md"), []byte("# Auth\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	addDependsOn = "auth, rate-limiting"
+	t.Cleanup(func() { addDependsOn = "" })
+
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+
+	proposalPath := filepath.Join(specPath, proposalDir, "oauth-login")
+	deps, err := workspace.ProposalDependencies(proposalPath)
+	if err != nil {
+		t.Fatalf("getProposalDependencies error: %v", err)
+	}
+	if len(deps) != 2 || deps[0] != "auth" || deps[1] != "rate-limiting" {
+		t.Fatalf("Depends on = %v, want [auth rate-limiting]", deps)
+	}
+
+	content, err := os.ReadFile(filepath.Join(proposalPath, "specification.md"))
+	if err != nil {
+		t.Fatalf("read specification.md: %v", err)
+	}
+	if strings.Count(string(content), "**Depends on**:") != 1 {
+		t.Errorf("expected a single Depends on field:\n%s", content)
+	}
+}
+
+func TestProposalAddActivate(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	addActivate = true
+	t.Cleanup(func() { addActivate = false })
+
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+
+	state, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState error: %v", err)
+	}
+	if !state.IsProposalActive("oauth-login") {
+		t.Fatal("expected new proposal to be active")
+	}
+
+	// Dependency rules still apply: a missing completed dependency blocks
+	// activation but not creation
+	addDependsOn = "oauth-login"
+	t.Cleanup(func() { addDependsOn = "" })
+
+	runSpecProposalAdd(specProposalAddCmd, []string{"sso"})
+
+	if !workspace.FileExists(filepath.Join(specPath, proposalDir, "sso")) {
+		t.Fatal("expected proposal to be created")
+	}
+	state, err = loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState error: %v", err)
+	}
+	if state.IsProposalActive("sso") {
+		t.Error("expected proposal with uncompleted dependency not to be activated")
+	}
+}
+
+func TestProposalActivateRecordsHashes(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+
+	runSpecProposalActivate(specProposalActivateCmd, []string{"oauth-login"})
+
+	state, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState error: %v", err)
+	}
+	hashes := state.Hashes["oauth-login"]
+	proposalPath := filepath.Join(specPath, proposalDir, "oauth-login")
+	for _, doc := range []string{"specification.md", "design.md", "implementation.md"} {
+		want, err := workspace.HashFile(filepath.Join(proposalPath, doc))
+		if err != nil {
+			t.Fatalf("hashFile: %v", err)
+		}
+		if hashes[doc] != want {
+			t.Errorf("hash for %s = %q, want %q", doc, hashes[doc], want)
+		}
+	}
+
+	// The stored baseline lets drift be detected after a CLI activation
+	if err := os.WriteFile(filepath.Join(proposalPath, "design.md"), []byte("# Design: changed\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	changed, err := verifyProposalHashes(proposalPath, hashes)
+	if err != nil {
+		t.Fatalf("verifyProposalHashes: %v", err)
+	}
+	if !reflect.DeepEqual(changed, []string{"design.md"}) {
+		t.Errorf("changed = %v, want [design.md]", changed)
+	}
+}
+
+func TestValidateWarnsOnChangedGuidelines(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	guidelines := filepath.Join(specPath, "specification guidelines.md")
+	if err := os.WriteFile(guidelines, []byte("# Specification Guidelines\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+
+	if results := guidelineChangeResults(specPath, "oauth-login"); len(results) != 0 {
+		t.Fatalf("expected no warnings before guidelines change, got %+v", results)
+	}
+
+	if err := os.WriteFile(guidelines, []byte("# Specification Guidelines\n\n## New Rule\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	results := guidelineChangeResults(specPath, "oauth-login")
+	if len(results) != 1 || results[0].Document != "specification guidelines.md" || len(results[0].Warnings) != 1 {
+		t.Fatalf("results = %+v, want one warning for specification guidelines.md", results)
+	}
+
+	// Adding a guideline the proposal was not created against also counts
+	if err := os.WriteFile(filepath.Join(specPath, "design guidelines.md"), []byte("# Design Guidelines\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	changed, err := changedGuidelines(specPath, "oauth-login")
+	if err != nil {
+		t.Fatalf("changedGuidelines: %v", err)
+	}
+	if want := []string{"specification guidelines.md", "design guidelines.md"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("changed = %v, want %v", changed, want)
+	}
+
+	// Proposals without recorded guideline hashes are not flagged
+	if results := guidelineChangeResults(specPath, "unknown"); len(results) != 0 {
+		t.Errorf("expected no warnings for unstamped proposal, got %+v", results)
+	}
+}
+
+func TestProposalLeavingDropsGuidelineHashes(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	for _, dir := range []string{proposalDir, sectionDir} {
+		if err := os.MkdirAll(filepath.Join(specPath, dir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(specPath, "specification guidelines.md"), []byte("# Specification Guidelines\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	slugs := []string{"completed", "abandoned", "removed"}
+	for _, slug := range slugs {
+		runSpecProposalAdd(specProposalAddCmd, []string{slug})
+	}
+	state, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	if len(state.Guidelines) != len(slugs) {
+		t.Fatalf("guideline hashes = %v, want one entry per proposal", state.Guidelines)
+	}
+
+	if _, err := completeProposal(specPath, "completed", true, false, sectionConflictError); err != nil {
+		t.Fatalf("completeProposal: %v", err)
+	}
+	runSpecProposalAbandon(specProposalAbandonCmd, []string{"abandoned"})
+	runSpecProposalRemove(specProposalRemoveCmd, []string{"removed"})
+
+	state, err = loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	for _, slug := range slugs {
+		if _, ok := state.Guidelines[slug]; ok {
+			t.Errorf("guideline hashes of %s kept after it left proposal/", slug)
+		}
+	}
+}
+
+func TestProposalAddRecordsMeta(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	t.Cleanup(func() { addAuthor, addDate = "", "" })
+
+	addAuthor, addDate = "Ada Lovelace", "2026-03-04"
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+
+	// An invalid date is rejected before anything is created
+	addDate = "next tuesday"
+	captureStdout(t, func() { runSpecProposalAdd(specProposalAddCmd, []string{"sso"}) })
+	if workspace.FileExists(filepath.Join(specPath, proposalDir, "sso")) {
+		t.Error("expected proposal with invalid --date not to be created")
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	want := workspace.ProposalMeta{Author: "Ada Lovelace", Created: "2026-03-04T00:00:00Z"}
+	if got := state.Proposals["oauth-login"]; got != want {
+		t.Errorf("meta = %+v, want %+v", got, want)
+	}
+	if got := formatProposalMeta(state.Proposals["oauth-login"]); got != "2026-03-04 by Ada Lovelace" {
+		t.Errorf("formatProposalMeta = %q", got)
+	}
+
+	out := captureStdout(t, func() { runSpecProposalList(specProposalListCmd, nil) })
+	if !strings.Contains(out, "2026-03-04 by Ada Lovelace") {
+		t.Errorf("expected creation metadata in list output:\n%s", out)
+	}
+}
+
+func TestNewProposalMetaUsesGitUserName(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	t.Chdir(dir)
+	gitconfig := filepath.Join(dir, "gitconfig")
+	if err := os.WriteFile(gitconfig, []byte("[user]\n\tname = Grace Hopper\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	t.Setenv("GIT_CONFIG_GLOBAL", gitconfig)
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+
+	meta := newProposalMeta(getSpecPath())
+	if meta.Author != "Grace Hopper" {
+		t.Errorf("author = %q, want Grace Hopper", meta.Author)
+	}
+	if _, err := time.Parse(time.RFC3339, meta.Created); err != nil {
+		t.Errorf("created = %q is not RFC3339: %v", meta.Created, err)
+	}
+}
+
+func TestListCommandsCSV(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	for _, dir := range []string{proposalDir, maintenanceDir} {
+		if err := os.MkdirAll(filepath.Join(specPath, dir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+
+	runSpecProposalAdd(specProposalAddCmd, []string{"payments"})
+	spec := "# Billing\n\n**Depends on**: auth, payments\n"
+	billing := filepath.Join(specPath, proposalDir, "billing")
+	if err := os.MkdirAll(billing, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(billing, "specification.md"), []byte(spec), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(billing, "implementation.md"), []byte("- [x] one\n- [ ] two\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	maintenance := "# Maintenance: Deps\n\n## Requirements\n- Update modules [id=update] [freq=weekly]\n- Audit licenses [id=audit] [freq=yearly]\n"
+	if err := os.WriteFile(filepath.Join(specPath, maintenanceDir, "deps.md"), []byte(maintenance), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	proposalListFormat, maintenanceListFormat = "csv", "csv"
+	t.Cleanup(func() { proposalListFormat, maintenanceListFormat = "text", "text" })
+
+	tests := []struct {
+		name string
+		run  func()
+		want [][]string
+	}{
+		{
+			name: "proposals",
+			run:  func() { runSpecProposalList(specProposalListCmd, nil) },
+			want: [][]string{
+				{"name", "status", "progress", "completed", "total", "dependencies"},
+				{"billing", "inactive", "50", "1", "2", "auth, payments"},
+				{"payments", "inactive", "0", "0", "4", ""},
+			},
+		},
+		{
+			name: "maintenance",
+			run:  func() { runMaintenanceList(maintenanceListCmd, nil) },
+			want: [][]string{
+				{"slug", "total", "due"},
+				{"deps", "2", "2"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := captureStdout(t, tt.run)
+			records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+			if err != nil {
+				t.Fatalf("invalid CSV: %v\n%s", err, out)
+			}
+			if !reflect.DeepEqual(records, tt.want) {
+				t.Fatalf("records = %q, want %q", records, tt.want)
+			}
+		})
+	}
+}
+
+func TestProposalListFilter(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	for _, dir := range []string{proposalDir, sectionDir} {
+		if err := os.MkdirAll(filepath.Join(specPath, dir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(specPath, sectionDir, "storage.md"), []byte("# Storage\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	for _, slug := range []string{"auth", "billing", "search", "sso"} {
+		runSpecProposalAdd(specProposalAddCmd, []string{slug})
+	}
+	for slug, deps := range map[string]string{"billing": "ghost", "search": "storage", "sso": "auth"} {
+		specFile := filepath.Join(specPath, proposalDir, slug, "specification.md")
+		content, err := os.ReadFile(specFile)
+		if err != nil {
+			t.Fatalf("read: %v", err)
+		}
+		if err := os.WriteFile(specFile, []byte(setSpecField(string(content), "Depends on", deps)), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	runSpecProposalActivate(specProposalActivateCmd, []string{"auth"})
+
+	proposalListFormat = "csv"
+	t.Cleanup(func() { proposalListFormat, proposalListFilter = "text", "all" })
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{filter: "all", want: []string{"auth", "billing", "search", "sso"}},
+		{filter: "active", want: []string{"auth"}},
+		{filter: "pending", want: []string{"search"}},
+		{filter: "blocked", want: []string{"billing", "sso"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			proposalListFilter = tt.filter
+			out := captureStdout(t, func() { runSpecProposalList(specProposalListCmd, nil) })
+			records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+			if err != nil {
+				t.Fatalf("invalid CSV: %v\n%s", err, out)
+			}
+			var names []string
+			for _, record := range records[1:] {
+				names = append(names, record[0])
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("names = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgressStyleThresholds(t *testing.T) {
+	tests := []struct {
+		completed, total int
+		want             string
+	}{
+		{0, 10, "error"},
+		{32, 100, "error"},
+		{33, 100, "warning"},
+		{65, 100, "warning"},
+		{66, 100, "success"},
+		{10, 10, "success"},
+	}
+	styles := map[string]lipgloss.Style{"error": errorStyle, "warning": warningStyle, "success": successStyle}
+
+	for _, tt := range tests {
+		got := progressStyle(tt.completed, tt.total).GetForeground()
+		if want := styles[tt.want].GetForeground(); got != want {
+			t.Errorf("progressStyle(%d, %d) foreground = %v, want %s (%v)", tt.completed, tt.total, got, tt.want, want)
+		}
+	}
+
+	// The bar keeps its width and brackets whatever the color (lipgloss
+	// drops styling when not on a terminal)
+	bar := renderProgressBar(1, 4, 8)
+	if bar != "[██░░░░░░]" {
+		t.Errorf("renderProgressBar = %q", bar)
+	}
+}
+
+func TestValidateDesignMetadataConventions(t *testing.T) {
+	t.Parallel()
+
+	sections := "\n## Context\n\nText.\n\n## Goals and Non-Goals\n\nText.\n\n## Options Considered\n\n### Option 1: A\n\n### Option 2: B\n\n" +
+		"## Decision\n\nA.\n\n## Detailed Design\n\nText.\n\n## Cross-Cutting Concerns\n\nText.\n\n## Implementation Plan\n\nText.\n\n## Open Questions\n\nNone.\n"
+
+	tests := []struct {
+		name    string
+		content string
+		missing []string
+	}{
+		{
+			name:    "inline markers",
+			content: "# Design: OAuth Login\n**Specification Reference**: [specification.md](specification.md)\n**Status**: Draft\n" + sections,
+		},
+		{
+			name:    "front matter",
+			content: "---\ntitle: OAuth Login\nstatus: Draft\nspecification_reference: specification.md\n---\n" + sections,
+		},
+		{
+			name:    "front matter without status",
+			content: "---\ntitle: OAuth Login\nspecification_reference: specification.md\n---\n" + sections,
+			missing: []string{"Status"},
+		},
+		{
+			name:    "neither",
+			content: "# OAuth Login\n" + sections,
+			missing: []string{"Title", "Specification Reference", "Status"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validateDesign(tt.content, false, designSections)
+			var metadata []string
+			for _, message := range append(result.Errors, result.Warnings...) {
+				if strings.HasPrefix(message, "Missing metadata: ") {
+					metadata = append(metadata, message)
+				} else {
+					t.Errorf("unexpected issue: %s", message)
+				}
+			}
+			if len(metadata) != len(tt.missing) {
+				t.Fatalf("metadata issues = %q, want %d for %v", metadata, len(tt.missing), tt.missing)
+			}
+			for i, field := range tt.missing {
+				if !strings.HasPrefix(metadata[i], "Missing metadata: "+field) {
+					t.Errorf("metadata issue %d = %q, want %s", i, metadata[i], field)
+				}
+			}
+		})
+	}
+}
@@ -1,120 +1,144 @@
 package cmd
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"os"
 	"path/filepath"
-)
+	"time"
 
-const stateFile = ".nocturnal.json"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
 
-// State represents the nocturnal state file (spec/.nocturnal.json).
-type State struct {
-	Version      int                                    `json:"version"`
-	Active       []string                               `json:"active"`
-	Primary      string                                 `json:"primary"`
-	Hashes       map[string]map[string]string           `json:"hashes,omitempty"`
-	Maintenance  map[string]map[string]MaintenanceState `json:"maintenance,omitempty"`
-	GitSnapshots map[string]GitSnapshotState            `json:"git_snapshots,omitempty"`
+// loadState reads the state file, logging its path in verbose mode.
+// Returns empty state if file doesn't exist.
+func loadState(specPath string) (*workspace.State, error) {
+	statePath := workspace.StatePath(specPath)
+	debugf("loading state from %s", statePath)
+	if !workspace.FileExists(statePath) {
+		debugf("no state file; starting with empty state")
+	}
+	return workspace.LoadState(specPath)
 }
 
-// GitSnapshotState tracks git snapshots for task execution
-type GitSnapshotState struct {
-	SnapshotRef string `json:"snapshot_ref,omitempty"` // Git ref at snapshot time
-	TaskID      string `json:"task_id"`
-	Timestamp   string `json:"timestamp"` // RFC3339 timestamp
+// saveState writes the state file, logging its path in verbose mode.
+func saveState(specPath string, state *workspace.State) error {
+	debugf("writing state to %s", workspace.StatePath(specPath))
+	return workspace.SaveState(specPath, state)
 }
 
-// MaintenanceState tracks when a maintenance requirement was last actioned.
-type MaintenanceState struct {
-	LastActioned string `json:"last_actioned"` // RFC3339 timestamp
+// recordProposalEvent appends a lifecycle event to the state file.
+func recordProposalEvent(specPath, slug, eventType string) error {
+	state, err := loadState(specPath)
+	if err != nil {
+		return err
+	}
+	state.RecordEvent(slug, eventType)
+	return saveState(specPath, state)
 }
 
-// getStatePath returns the path to the state file.
-func getStatePath(specPath string) string {
-	return filepath.Join(specPath, stateFile)
-}
+// validationGuidelines are the guideline files proposal validation follows.
+var validationGuidelines = []string{"specification guidelines.md", "design guidelines.md"}
 
-// loadState reads the state file. Returns empty state if file doesn't exist.
-func loadState(specPath string) (*State, error) {
-	statePath := getStatePath(specPath)
-	data, err := os.ReadFile(statePath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return &State{
-				Version:     1,
-				Active:      []string{},
-				Hashes:      make(map[string]map[string]string),
-				Maintenance: make(map[string]map[string]MaintenanceState),
-			}, nil
+// computeGuidelineHashes hashes the workspace's validation guidelines,
+// skipping any that don't exist.
+func computeGuidelineHashes(specPath string) (map[string]string, error) {
+	hashes := make(map[string]string)
+	for _, filename := range validationGuidelines {
+		hash, err := workspace.HashFile(filepath.Join(specPath, filename))
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash %s: %w", filename, err)
+		}
+		if hash != "" {
+			hashes[filename] = hash
 		}
-		return nil, fmt.Errorf("failed to read state file: %w", err)
 	}
+	return hashes, nil
+}
 
-	var state State
-	if err := json.Unmarshal(data, &state); err != nil {
-		return nil, fmt.Errorf("failed to parse state file: %w", err)
+// newProposalMeta returns creation metadata stamped now and attributed to
+// the workspace's git user.name, if one is configured.
+func newProposalMeta(specPath string) workspace.ProposalMeta {
+	return workspace.ProposalMeta{
+		Author:  gitUserName(specPath),
+		Created: time.Now().UTC().Format(time.RFC3339),
 	}
+}
 
-	if state.Hashes == nil {
-		state.Hashes = make(map[string]map[string]string)
+// recordProposalCreation appends a created event to the state file, stores
+// the proposal's creation metadata, and stamps the guideline hashes the
+// proposal was written against.
+func recordProposalCreation(specPath, slug string, meta workspace.ProposalMeta) error {
+	state, err := loadState(specPath)
+	if err != nil {
+		return err
 	}
-
-	if state.Maintenance == nil {
-		state.Maintenance = make(map[string]map[string]MaintenanceState)
+	hashes, err := computeGuidelineHashes(specPath)
+	if err != nil {
+		return err
 	}
-
-	return &state, nil
+	if state.Guidelines == nil {
+		state.Guidelines = make(map[string]map[string]string)
+	}
+	state.Guidelines[slug] = hashes
+	if state.Proposals == nil {
+		state.Proposals = make(map[string]workspace.ProposalMeta)
+	}
+	state.Proposals[slug] = meta
+	state.RecordEvent(slug, workspace.EventCreated)
+	return saveState(specPath, state)
 }
 
-// saveState writes the state file.
-func saveState(specPath string, state *State) error {
-	statePath := getStatePath(specPath)
-	data, err := json.MarshalIndent(state, "", "  ")
+// changedGuidelines returns the guideline files that changed since a
+// proposal was created. Proposals created before guideline hashes were
+// recorded report no changes.
+func changedGuidelines(specPath, slug string) ([]string, error) {
+	state, err := loadState(specPath)
 	if err != nil {
-		return fmt.Errorf("failed to serialize state: %w", err)
+		return nil, err
+	}
+	stamped, exists := state.Guidelines[slug]
+	if !exists {
+		return nil, nil
 	}
 
-	if err := os.WriteFile(statePath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write state file: %w", err)
+	current, err := computeGuidelineHashes(specPath)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	var changed []string
+	for _, filename := range validationGuidelines {
+		if current[filename] != stamped[filename] {
+			changed = append(changed, filename)
+		}
+	}
+	return changed, nil
 }
 
-// hashFile computes SHA256 hash of a file's contents.
-func hashFile(path string) (string, error) {
-	content, err := os.ReadFile(path)
+// recordTaskCompletion appends a task_completed event to the state file.
+func recordTaskCompletion(specPath, slug, taskID string) error {
+	state, err := loadState(specPath)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return "", nil
-		}
-		return "", err
+		return err
 	}
-
-	h := sha256.Sum256(content)
-	return hex.EncodeToString(h[:]), nil
+	state.History = append(state.History, workspace.Event{
+		Type:      workspace.EventTaskCompleted,
+		Slug:      slug,
+		Task:      taskID,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	})
+	return saveState(specPath, state)
 }
 
-// computeProposalHashes computes hashes for all proposal documents.
+// computeProposalHashes computes hashes for the configured proposal
+// documents.
 func computeProposalHashes(proposalPath string) (map[string]string, error) {
-	hashes := make(map[string]string)
-
-	for _, filename := range proposalDocFiles {
-		filePath := filepath.Join(proposalPath, filename)
-		hash, err := hashFile(filePath)
-		if err != nil {
-			return nil, fmt.Errorf("failed to hash %s: %w", filename, err)
-		}
-		if hash != "" {
-			hashes[filename] = hash
-		}
-	}
+	return workspace.ComputeProposalHashes(proposalPath, proposalDocFilenames(proposalDocumentsAt(proposalPath)))
+}
 
-	return hashes, nil
+// readProposalBaseline returns the contents of the configured proposal
+// documents that exist, keyed by filename.
+func readProposalBaseline(proposalPath string) (map[string]string, error) {
+	return workspace.ReadProposalBaseline(proposalPath, proposalDocFilenames(proposalDocumentsAt(proposalPath)))
 }
 
 // verifyProposalHashes checks if current file hashes match stored hashes.
@@ -122,9 +146,9 @@ func computeProposalHashes(proposalPath string) (map[string]string, error) {
 func verifyProposalHashes(proposalPath string, storedHashes map[string]string) ([]string, error) {
 	var changed []string
 
-	for _, filename := range proposalDocFiles {
+	for _, filename := range proposalDocFilenames(proposalDocumentsAt(proposalPath)) {
 		filePath := filepath.Join(proposalPath, filename)
-		currentHash, err := hashFile(filePath)
+		currentHash, err := workspace.HashFile(filePath)
 		if err != nil {
 			return nil, fmt.Errorf("failed to hash %s: %w", filename, err)
 		}
@@ -142,46 +166,6 @@ func verifyProposalHashes(proposalPath string, storedHashes map[string]string) (
 	return changed, nil
 }
 
-// isProposalActive checks if a proposal is in the active list.
-func (s *State) isProposalActive(slug string) bool {
-	for _, active := range s.Active {
-		if active == slug {
-			return true
-		}
-	}
-	return false
-}
-
-// activateProposal adds a proposal to the active list and sets it as primary.
-func (s *State) activateProposal(slug string, hashes map[string]string) {
-	if !s.isProposalActive(slug) {
-		s.Active = append(s.Active, slug)
-	}
-	s.Primary = slug
-	s.Hashes[slug] = hashes
-}
-
-// deactivateProposal removes a proposal from the active list.
-func (s *State) deactivateProposal(slug string) {
-	var newActive []string
-	for _, active := range s.Active {
-		if active != slug {
-			newActive = append(newActive, active)
-		}
-	}
-	s.Active = newActive
-	delete(s.Hashes, slug)
-
-	// Update primary if needed
-	if s.Primary == slug {
-		if len(s.Active) > 0 {
-			s.Primary = s.Active[0]
-		} else {
-			s.Primary = ""
-		}
-	}
-}
-
 // getPrimaryProposal returns the primary proposal slug and path.
 func getPrimaryProposal(specPath string) (slug string, proposalPath string, err error) {
 	state, err := loadState(specPath)
@@ -194,7 +178,7 @@ func getPrimaryProposal(specPath string) (slug string, proposalPath string, err
 	}
 
 	proposalPath = filepath.Join(specPath, proposalDir, state.Primary)
-	if !fileExists(proposalPath) {
+	if !workspace.FileExists(proposalPath) {
 		return state.Primary, "", fmt.Errorf("primary proposal '%s' no longer exists (stale state)", state.Primary)
 	}
 
@@ -210,15 +194,16 @@ func getPrimaryProposalSlug(specPath string) string {
 	return state.Primary
 }
 
-// clearProposalIfMatches removes a proposal from active/primary if it matches.
+// clearProposalIfMatches removes a proposal from active/primary if it matches
+// and drops its guideline hashes. It is called once the proposal has left
+// proposal/.
 func clearProposalIfMatches(specPath, slug string) error {
 	state, err := loadState(specPath)
 	if err != nil {
 		return err
 	}
 
-	if state.isProposalActive(slug) {
-		state.deactivateProposal(slug)
+	if state.ForgetProposal(slug) {
 		return saveState(specPath, state)
 	}
 	return nil
@@ -246,3 +231,82 @@ func checkProposalIntegrity(specPath, slug string) (changedFiles []string, requi
 
 	return changed, len(changed) > 0, nil
 }
+
+// rebaselineProposalHashes stores fresh hashes for an active proposal, accepting
+// its current content. Returns the files that had drifted from the old baseline.
+func rebaselineProposalHashes(specPath, slug string) ([]string, error) {
+	state, err := loadState(specPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !state.IsProposalActive(slug) {
+		return nil, fmt.Errorf("proposal '%s' is not active", slug)
+	}
+
+	proposalPath := filepath.Join(specPath, proposalDir, slug)
+	changed, err := verifyProposalHashes(proposalPath, state.Hashes[slug])
+	if err != nil {
+		return nil, err
+	}
+
+	hashes, err := computeProposalHashes(proposalPath)
+	if err != nil {
+		return nil, err
+	}
+	baseline, err := readProposalBaseline(proposalPath)
+	if err != nil {
+		return nil, err
+	}
+	state.Hashes[slug] = hashes
+	state.Baselines[slug] = baseline
+
+	if err := saveState(specPath, state); err != nil {
+		return nil, err
+	}
+
+	return changed, nil
+}
+
+// activateProposalChecked activates a proposal after checking that its
+// dependencies are completed. Missing dependencies block activation unless
+// force is set; either way they are returned so callers can report them.
+func activateProposalChecked(specPath, slug string, force bool) ([]string, error) {
+	proposalPath := filepath.Join(specPath, proposalDir, slug)
+
+	missing, err := getMissingCompletedDependencies(specPath, proposalPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check dependencies: %w", err)
+	}
+	if len(missing) > 0 && !force {
+		return missing, fmt.Errorf("cannot activate '%s': missing completed dependencies", slug)
+	}
+	if len(missing) > 0 {
+		debugf("activating %s despite missing dependencies (--force)", slug)
+	}
+
+	hashes, err := computeProposalHashes(proposalPath)
+	if err != nil {
+		return missing, fmt.Errorf("failed to compute file hashes: %w", err)
+	}
+	debugf("recorded hashes for %d document(s) of %s", len(hashes), slug)
+	baseline, err := readProposalBaseline(proposalPath)
+	if err != nil {
+		return missing, fmt.Errorf("failed to record baseline: %w", err)
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		return missing, fmt.Errorf("failed to load state: %w", err)
+	}
+
+	state.ActivateProposal(slug, hashes)
+	state.Baselines[slug] = baseline
+	state.RecordEvent(slug, workspace.EventActivated)
+
+	if err := saveState(specPath, state); err != nil {
+		return missing, fmt.Errorf("failed to save state: %w", err)
+	}
+
+	return missing, nil
+}
@@ -4,174 +4,210 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"gitlab.com/caffeinatedjack/nocturnal/cmd/tui"
 )
 
-func TestStateLoadSave(t *testing.T) {
+func TestVerifyProposalHashes(t *testing.T) {
 	t.Parallel()
 
-	specPath := t.TempDir()
+	proposalPath := t.TempDir()
 
-	// Load from non-existent file returns empty state
-	state, err := loadState(specPath)
-	if err != nil {
-		t.Fatalf("loadState error: %v", err)
-	}
-	if state.Version != 1 {
-		t.Fatalf("expected version 1, got %d", state.Version)
+	// Create initial files
+	specContent := "# Spec\n"
+	designContent := "# Design\n"
+	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte(specContent), 0644); err != nil {
+		t.Fatal(err)
 	}
-	if len(state.Active) != 0 {
-		t.Fatalf("expected empty active list, got %v", state.Active)
+	if err := os.WriteFile(filepath.Join(proposalPath, "design.md"), []byte(designContent), 0644); err != nil {
+		t.Fatal(err)
 	}
 
-	// Save and reload
-	state.activateProposal("test-proposal", map[string]string{
-		"specification.md": "abc123",
-	})
-
-	if err := saveState(specPath, state); err != nil {
-		t.Fatalf("saveState error: %v", err)
+	// Compute initial hashes
+	hashes, err := computeProposalHashes(proposalPath)
+	if err != nil {
+		t.Fatalf("computeProposalHashes error: %v", err)
 	}
 
-	loaded, err := loadState(specPath)
+	// Verify unchanged files
+	changed, err := verifyProposalHashes(proposalPath, hashes)
 	if err != nil {
-		t.Fatalf("loadState after save error: %v", err)
+		t.Fatalf("verifyProposalHashes error: %v", err)
+	}
+	if len(changed) != 0 {
+		t.Fatalf("expected no changes, got %v", changed)
 	}
 
-	if loaded.Primary != "test-proposal" {
-		t.Fatalf("expected primary 'test-proposal', got %q", loaded.Primary)
+	// Modify a file
+	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte(specContent+"modified"), 0644); err != nil {
+		t.Fatal(err)
 	}
-	if len(loaded.Active) != 1 || loaded.Active[0] != "test-proposal" {
-		t.Fatalf("expected active ['test-proposal'], got %v", loaded.Active)
+
+	changed, err = verifyProposalHashes(proposalPath, hashes)
+	if err != nil {
+		t.Fatalf("verifyProposalHashes error: %v", err)
 	}
-	if loaded.Hashes["test-proposal"]["specification.md"] != "abc123" {
-		t.Fatalf("hash mismatch")
+	if len(changed) != 1 || changed[0] != "specification.md" {
+		t.Fatalf("expected ['specification.md'], got %v", changed)
 	}
 }
 
-func TestStateActivateDeactivate(t *testing.T) {
+func TestRebaselineProposalHashes(t *testing.T) {
 	t.Parallel()
 
-	state := &State{Version: 1, Active: []string{}, Hashes: make(map[string]map[string]string)}
-
-	// Activate first proposal
-	state.activateProposal("a", map[string]string{"spec.md": "hash-a"})
-	if state.Primary != "a" {
-		t.Fatalf("expected primary 'a', got %q", state.Primary)
+	specPath := t.TempDir()
+	proposalPath := filepath.Join(specPath, proposalDir, "feature")
+	if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
 	}
-	if !state.isProposalActive("a") {
-		t.Fatal("expected 'a' to be active")
+	specFile := filepath.Join(proposalPath, "specification.md")
+	if err := os.WriteFile(specFile, []byte("# Feature\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
 	}
 
-	// Activate second proposal (becomes primary)
-	state.activateProposal("b", map[string]string{"spec.md": "hash-b"})
-	if state.Primary != "b" {
-		t.Fatalf("expected primary 'b', got %q", state.Primary)
-	}
-	if len(state.Active) != 2 {
-		t.Fatalf("expected 2 active, got %d", len(state.Active))
+	// Inactive proposals cannot be touched
+	if _, err := rebaselineProposalHashes(specPath, "feature"); err == nil {
+		t.Fatal("expected error for inactive proposal")
 	}
 
-	// Deactivate primary
-	state.deactivateProposal("b")
-	if state.Primary != "a" {
-		t.Fatalf("expected primary to fall back to 'a', got %q", state.Primary)
+	hashes, err := computeProposalHashes(proposalPath)
+	if err != nil {
+		t.Fatalf("computeProposalHashes error: %v", err)
 	}
-	if state.isProposalActive("b") {
-		t.Fatal("expected 'b' to be inactive")
+	state, _ := loadState(specPath)
+	state.ActivateProposal("feature", hashes)
+	if err := saveState(specPath, state); err != nil {
+		t.Fatalf("saveState error: %v", err)
 	}
 
-	// Deactivate last
-	state.deactivateProposal("a")
-	if state.Primary != "" {
-		t.Fatalf("expected empty primary, got %q", state.Primary)
+	// Edit after activation causes drift
+	if err := os.WriteFile(specFile, []byte("# Feature\n\nEdited\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
 	}
-}
-
-func TestHashFile(t *testing.T) {
-	t.Parallel()
-
-	dir := t.TempDir()
-	path := filepath.Join(dir, "test.md")
-
-	// Non-existent file returns empty hash
-	hash, err := hashFile(path)
+	_, drifted, err := checkProposalIntegrity(specPath, "feature")
 	if err != nil {
-		t.Fatalf("hashFile error: %v", err)
+		t.Fatalf("checkProposalIntegrity error: %v", err)
 	}
-	if hash != "" {
-		t.Fatalf("expected empty hash for non-existent file, got %q", hash)
+	if !drifted {
+		t.Fatal("expected drift after edit")
 	}
 
-	// Write file and hash it
-	content := "# Test\n\nHello world\n"
-	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
-		t.Fatalf("write file: %v", err)
-	}
-
-	hash, err = hashFile(path)
+	changed, err := rebaselineProposalHashes(specPath, "feature")
 	if err != nil {
-		t.Fatalf("hashFile error: %v", err)
-	}
-	if hash == "" {
-		t.Fatal("expected non-empty hash")
+		t.Fatalf("rebaselineProposalHashes error: %v", err)
 	}
-
-	// Same content = same hash
-	hash2, _ := hashFile(path)
-	if hash != hash2 {
-		t.Fatal("expected same hash for same content")
+	if len(changed) != 1 || changed[0] != "specification.md" {
+		t.Fatalf("expected [specification.md] changed, got %v", changed)
 	}
 
-	// Modified content = different hash
-	if err := os.WriteFile(path, []byte(content+"extra"), 0644); err != nil {
-		t.Fatalf("write file: %v", err)
+	// Drift clears after touch
+	_, drifted, err = checkProposalIntegrity(specPath, "feature")
+	if err != nil {
+		t.Fatalf("checkProposalIntegrity error: %v", err)
 	}
-	hash3, _ := hashFile(path)
-	if hash == hash3 {
-		t.Fatal("expected different hash for modified content")
+	if drifted {
+		t.Fatal("expected no drift after touch")
 	}
 }
 
-func TestVerifyProposalHashes(t *testing.T) {
+func TestActivateProposalCheckedForce(t *testing.T) {
 	t.Parallel()
 
-	proposalPath := t.TempDir()
-
-	// Create initial files
-	specContent := "# Spec\n"
-	designContent := "# Design\n"
-	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte(specContent), 0644); err != nil {
-		t.Fatal(err)
+	specPath := t.TempDir()
+	proposalPath := filepath.Join(specPath, proposalDir, "child")
+	if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
 	}
-	if err := os.WriteFile(filepath.Join(proposalPath, "design.md"), []byte(designContent), 0644); err != nil {
-		t.Fatal(err)
+	spec := "# Child\n\n**Depends on**: parent\n"
+	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte(spec), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
 	}
 
-	// Compute initial hashes
-	hashes, err := computeProposalHashes(proposalPath)
-	if err != nil {
-		t.Fatalf("computeProposalHashes error: %v", err)
+	missing, err := activateProposalChecked(specPath, "child", false)
+	if err == nil {
+		t.Fatalf("expected activation to be blocked by missing dependency")
 	}
-
-	// Verify unchanged files
-	changed, err := verifyProposalHashes(proposalPath, hashes)
+	if len(missing) != 1 || missing[0] != "parent" {
+		t.Fatalf("expected missing [parent], got %v", missing)
+	}
+	state, err := loadState(specPath)
 	if err != nil {
-		t.Fatalf("verifyProposalHashes error: %v", err)
+		t.Fatalf("loadState error: %v", err)
 	}
-	if len(changed) != 0 {
-		t.Fatalf("expected no changes, got %v", changed)
+	if state.IsProposalActive("child") {
+		t.Fatalf("blocked activation must not change state")
 	}
 
-	// Modify a file
-	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte(specContent+"modified"), 0644); err != nil {
-		t.Fatal(err)
+	missing, err = activateProposalChecked(specPath, "child", true)
+	if err != nil {
+		t.Fatalf("forced activation error: %v", err)
 	}
-
-	changed, err = verifyProposalHashes(proposalPath, hashes)
+	if len(missing) != 1 || missing[0] != "parent" {
+		t.Fatalf("expected forced activation to report [parent], got %v", missing)
+	}
+	state, err = loadState(specPath)
 	if err != nil {
-		t.Fatalf("verifyProposalHashes error: %v", err)
+		t.Fatalf("loadState error: %v", err)
 	}
-	if len(changed) != 1 || changed[0] != "specification.md" {
-		t.Fatalf("expected ['specification.md'], got %v", changed)
+	if state.Primary != "child" {
+		t.Fatalf("expected primary 'child', got %q", state.Primary)
+	}
+}
+
+func TestActivationDecisionMatchesTUI(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		dependsOn string
+		completed []string
+		force     bool
+		wantBlock bool
+	}{
+		{name: "no dependencies", dependsOn: "none"},
+		{name: "dependency completed", dependsOn: "parent", completed: []string{"parent"}},
+		{name: "dependency missing", dependsOn: "parent", wantBlock: true},
+		{name: "partially completed", dependsOn: "a, b", completed: []string{"a"}, wantBlock: true},
+		{name: "dependency missing with force", dependsOn: "parent", force: true},
+	}
+
+	seed := func(t *testing.T, dependsOn string, completed []string) string {
+		t.Helper()
+		specPath := t.TempDir()
+		proposalPath := filepath.Join(specPath, proposalDir, "child")
+		if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		spec := "# Child\n\n**Depends on**: " + dependsOn + "\n"
+		if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte(spec), 0o644); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+		if err := os.MkdirAll(filepath.Join(specPath, sectionDir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		for _, dep := range completed {
+			if err := os.WriteFile(filepath.Join(specPath, sectionDir, dep+".md"), []byte("# "+dep+"\n"), 0o644); err != nil {
+				t.Fatalf("write file: %v", err)
+			}
+		}
+		return specPath
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cliPath := seed(t, tt.dependsOn, tt.completed)
+			_, err := activateProposalChecked(cliPath, "child", tt.force)
+			cliBlocked := err != nil
+
+			tuiPath := seed(t, tt.dependsOn, tt.completed)
+			_, tuiBlocked := tui.ActivateProposal(tuiPath, "child", tt.force)().(tui.ErrorMsg)
+
+			if cliBlocked != tt.wantBlock {
+				t.Errorf("CLI blocked = %v, want %v", cliBlocked, tt.wantBlock)
+			}
+			if tuiBlocked != cliBlocked {
+				t.Errorf("TUI blocked = %v, CLI blocked = %v", tuiBlocked, cliBlocked)
+			}
+		})
 	}
 }
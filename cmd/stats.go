@@ -1,12 +1,21 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+var (
+	statsPerProposal bool
+	statsJSON        bool
+	statsVelocity    bool
+	statsWeeks       int
 )
 
 var specStatsCmd = &cobra.Command{
@@ -17,29 +26,48 @@ var specStatsCmd = &cobra.Command{
 
 func init() {
 	specStatsCmd.Long = helpText("spec-stats")
+	specStatsCmd.Flags().BoolVar(&statsPerProposal, "per-proposal", false, "Show a breakdown for each proposal")
+	specStatsCmd.Flags().BoolVar(&statsJSON, "json", false, "Print the statistics as JSON")
+	specStatsCmd.Flags().BoolVar(&statsVelocity, "velocity", false, "Show tasks completed per week and an estimated completion date")
+	specStatsCmd.Flags().IntVar(&statsWeeks, "weeks", 4, "Number of weeks to include with --velocity")
 	specCmd.AddCommand(specStatsCmd)
 }
 
 // Stats holds aggregated project statistics.
 type Stats struct {
 	// Specifications
-	CompletedSpecs    int
-	TotalRequirements int
-	MustCount         int
-	ShouldCount       int
-	MayCount          int
+	CompletedSpecs    int `json:"completed_specs"`
+	TotalRequirements int `json:"total_requirements"`
+	MustCount         int `json:"must_count"`
+	ShouldCount       int `json:"should_count"`
+	MayCount          int `json:"may_count"`
 
 	// Proposals
-	ActiveProposals   int
-	PendingProposals  int
-	ArchivedTotal     int
-	ArchivedCompleted int
-	ArchivedAbandoned int
+	ActiveProposals   int `json:"active_proposals"`
+	PendingProposals  int `json:"pending_proposals"`
+	ArchivedTotal     int `json:"archived_total"`
+	ArchivedCompleted int `json:"archived_completed"`
+	ArchivedAbandoned int `json:"archived_abandoned"`
+
+	// Effort across active proposals, in working days
+	ActiveEstimatedEffort float64 `json:"active_estimated_effort"`
+	ActiveActualEffort    float64 `json:"active_actual_effort"`
 
 	// Current proposal progress
-	CurrentProposal  string
-	CurrentTotal     int
-	CurrentCompleted int
+	CurrentProposal  string `json:"current_proposal"`
+	CurrentTotal     int    `json:"current_total"`
+	CurrentCompleted int    `json:"current_completed"`
+}
+
+// ProposalStats is the per-proposal breakdown shown by 'spec stats
+// --per-proposal'.
+type ProposalStats struct {
+	Slug           string `json:"slug"`
+	Status         string `json:"status"` // primary, active, or pending
+	TasksTotal     int    `json:"tasks_total"`
+	TasksCompleted int    `json:"tasks_completed"`
+	Requirements   int    `json:"requirements"`
+	Dependencies   int    `json:"dependencies"`
 }
 
 func runSpecStats(cmd *cobra.Command, args []string) {
@@ -49,20 +77,34 @@ func runSpecStats(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	if statsPerProposal {
+		runSpecStatsPerProposal(specPath)
+		return
+	}
+	if statsVelocity {
+		runSpecStatsVelocity(specPath)
+		return
+	}
+
 	stats, err := gatherStats(specPath)
 	if err != nil {
 		printError(fmt.Sprintf("Failed to gather stats: %v", err))
 		return
 	}
 
+	if statsJSON {
+		printJSON(stats)
+		return
+	}
+
 	fmt.Println()
 
 	// Specifications section
 	fmt.Println(boldStyle.Render("Specifications"))
 	fmt.Println()
-	fmt.Printf("  Completed: %d\n", stats.CompletedSpecs)
+	rows := [][]string{{"Completed:", fmt.Sprint(stats.CompletedSpecs)}}
 	if stats.TotalRequirements > 0 {
-		fmt.Printf("  Requirements: %d ", stats.TotalRequirements)
+		requirements := fmt.Sprint(stats.TotalRequirements)
 		parts := []string{}
 		if stats.MustCount > 0 {
 			parts = append(parts, fmt.Sprintf("MUST: %d", stats.MustCount))
@@ -74,44 +116,151 @@ func runSpecStats(cmd *cobra.Command, args []string) {
 			parts = append(parts, fmt.Sprintf("MAY: %d", stats.MayCount))
 		}
 		if len(parts) > 0 {
-			fmt.Printf("%s\n", dimStyle.Render("("+strings.Join(parts, ", ")+")"))
-		} else {
-			fmt.Println()
+			requirements += " " + dimStyle.Render("("+strings.Join(parts, ", ")+")")
 		}
+		rows = append(rows, []string{"Requirements:", requirements})
 	} else {
-		fmt.Printf("  Requirements: %s\n", dimStyle.Render("0"))
+		rows = append(rows, []string{"Requirements:", dimStyle.Render("0")})
 	}
+	fmt.Print(renderTable(nil, rows))
 	fmt.Println()
 
 	// Proposals section
 	fmt.Println(boldStyle.Render("Proposals"))
 	fmt.Println()
-	fmt.Printf("  Active: %d\n", stats.ActiveProposals)
-	fmt.Printf("  Pending: %d\n", stats.PendingProposals)
+	rows = [][]string{
+		{"Active:", fmt.Sprint(stats.ActiveProposals)},
+		{"Pending:", fmt.Sprint(stats.PendingProposals)},
+	}
 	if stats.ArchivedTotal > 0 {
-		fmt.Printf("  Archived: %d ", stats.ArchivedTotal)
-		fmt.Printf("%s\n", dimStyle.Render(fmt.Sprintf("(%d completed, %d abandoned)", stats.ArchivedCompleted, stats.ArchivedAbandoned)))
+		rows = append(rows, []string{"Archived:", fmt.Sprintf("%d %s", stats.ArchivedTotal,
+			dimStyle.Render(fmt.Sprintf("(%d completed, %d abandoned)", stats.ArchivedCompleted, stats.ArchivedAbandoned)))})
 	} else {
-		fmt.Printf("  Archived: %s\n", dimStyle.Render("0"))
+		rows = append(rows, []string{"Archived:", dimStyle.Render("0")})
 	}
+	if stats.ActiveEstimatedEffort > 0 || stats.ActiveActualEffort > 0 {
+		effort := fmt.Sprintf("%s estimated", formatEffort(stats.ActiveEstimatedEffort))
+		if stats.ActiveActualEffort > 0 {
+			effort += fmt.Sprintf(", %s actual", formatEffort(stats.ActiveActualEffort))
+		}
+		rows = append(rows, []string{"Effort (active):", effort})
+	}
+	fmt.Print(renderTable(nil, rows))
 	fmt.Println()
 
 	// Progress section
 	fmt.Println(boldStyle.Render("Progress"))
 	fmt.Println()
 	if stats.CurrentProposal != "" {
-		fmt.Printf("  Current: %s\n", infoStyle.Render(stats.CurrentProposal))
+		rows = [][]string{{"Current:", infoStyle.Render(stats.CurrentProposal)}}
 		if stats.CurrentTotal > 0 {
 			percentage := (stats.CurrentCompleted * 100) / stats.CurrentTotal
 			progressBar := renderProgressBar(stats.CurrentCompleted, stats.CurrentTotal, 20)
-			fmt.Printf("  Tasks: %s %s\n", progressBar, dimStyle.Render(fmt.Sprintf("%d/%d (%d%%)", stats.CurrentCompleted, stats.CurrentTotal, percentage)))
+			rows = append(rows, []string{"Tasks:", progressBar + " " + dimStyle.Render(fmt.Sprintf("%d/%d (%d%%)", stats.CurrentCompleted, stats.CurrentTotal, percentage))})
 		} else {
-			fmt.Printf("  Tasks: %s\n", dimStyle.Render("no tasks defined"))
+			rows = append(rows, []string{"Tasks:", dimStyle.Render("no tasks defined")})
 		}
 	} else {
-		fmt.Printf("  Current: %s\n", dimStyle.Render("no active proposal"))
+		rows = [][]string{{"Current:", dimStyle.Render("no active proposal")}}
+	}
+	fmt.Print(renderTable(nil, rows))
+	fmt.Println()
+}
+
+func runSpecStatsPerProposal(specPath string) {
+	proposals, err := gatherProposalStats(specPath)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to gather stats: %v", err))
+		return
+	}
+
+	if statsJSON {
+		if proposals == nil {
+			proposals = []ProposalStats{}
+		}
+		printJSON(proposals)
+		return
+	}
+
+	if len(proposals) == 0 {
+		printDim("No proposals found")
+		return
 	}
+
+	var rows [][]string
+	for _, p := range proposals {
+		status := dimStyle.Render(p.Status)
+		if p.Status != "pending" {
+			status = successStyle.Render(p.Status)
+		}
+
+		progress := dimStyle.Render("no tasks")
+		if p.TasksTotal > 0 {
+			progress = renderProgressBar(p.TasksCompleted, p.TasksTotal, 10) + " " +
+				dimStyle.Render(fmt.Sprintf("%d/%d", p.TasksCompleted, p.TasksTotal))
+		}
+
+		rows = append(rows, []string{infoStyle.Render(p.Slug), status, progress, fmt.Sprint(p.Requirements), fmt.Sprint(p.Dependencies)})
+	}
+
+	fmt.Println()
+	fmt.Println(boldStyle.Render(fmt.Sprintf("Proposals (%d)", len(proposals))))
 	fmt.Println()
+	fmt.Print(renderTable([]string{"NAME", "STATUS", "TASKS", "REQUIREMENTS", "DEPENDENCIES"}, rows))
+	fmt.Println()
+}
+
+// printJSON prints v as indented JSON.
+func printJSON(v any) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		printError(fmt.Sprintf("Failed to serialize JSON: %v", err))
+		return
+	}
+	fmt.Println(string(data))
+}
+
+// gatherProposalStats returns the task progress, requirement count,
+// dependency count, and status of each proposal.
+func gatherProposalStats(specPath string) ([]ProposalStats, error) {
+	state, err := loadState(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load state: %w", err)
+	}
+
+	var proposals []ProposalStats
+	for _, slug := range listProposalSlugs(specPath) {
+		proposalPath := filepath.Join(specPath, proposalDir, slug)
+
+		status := "pending"
+		if slug == state.Primary {
+			status = "primary"
+		} else if state.IsProposalActive(slug) {
+			status = "active"
+		}
+
+		total, completed := workspace.ProposalProgress(proposalPath)
+
+		requirements := 0
+		if content, err := os.ReadFile(filepath.Join(proposalPath, "specification.md")); err == nil {
+			requirements = countRequirements(string(content))
+		}
+
+		deps, err := workspace.ProposalDependencies(proposalPath)
+		if err != nil {
+			return nil, err
+		}
+
+		proposals = append(proposals, ProposalStats{
+			Slug:           slug,
+			Status:         status,
+			TasksTotal:     total,
+			TasksCompleted: completed,
+			Requirements:   requirements,
+			Dependencies:   len(deps),
+		})
+	}
+	return proposals, nil
 }
 
 func gatherStats(specPath string) (*Stats, error) {
@@ -152,8 +301,11 @@ func gatherStats(specPath string) (*Stats, error) {
 
 	for _, entry := range entries {
 		if entry.IsDir() {
-			if state.isProposalActive(entry.Name()) {
+			if state.IsProposalActive(entry.Name()) {
 				stats.ActiveProposals++
+				estimated, actual := getProposalEffort(filepath.Join(proposalsPath, entry.Name()))
+				stats.ActiveEstimatedEffort += estimated
+				stats.ActiveActualEffort += actual
 			} else {
 				stats.PendingProposals++
 			}
@@ -171,7 +323,7 @@ func gatherStats(specPath string) (*Stats, error) {
 		if entry.IsDir() {
 			stats.ArchivedTotal++
 			abandonedPath := filepath.Join(archivePath, entry.Name(), ".abandoned")
-			if fileExists(abandonedPath) {
+			if workspace.FileExists(abandonedPath) {
 				stats.ArchivedAbandoned++
 			} else {
 				stats.ArchivedCompleted++
@@ -183,7 +335,7 @@ func gatherStats(specPath string) (*Stats, error) {
 	if state.Primary != "" {
 		stats.CurrentProposal = state.Primary
 		proposalPath := filepath.Join(specPath, proposalDir, state.Primary)
-		stats.CurrentTotal, stats.CurrentCompleted = getProposalProgress(proposalPath)
+		stats.CurrentTotal, stats.CurrentCompleted = workspace.ProposalProgress(proposalPath)
 	}
 
 	return stats, nil
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGatherProposalStats(t *testing.T) {
+	specPath := t.TempDir()
+
+	writeProposal := func(slug, spec, impl string) {
+		t.Helper()
+		dir := filepath.Join(specPath, proposalDir, slug)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "specification.md"), []byte(spec), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "implementation.md"), []byte(impl), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+
+	writeProposal("oauth-login",
+		"# OAuth\n\n**Depends on**: sessions, users\n\nThe system MUST redirect.\nTokens SHALL expire.\n",
+		"### Phase 1: Setup\n- [x] One\n- [x] Two\n- [ ] Three\n")
+	writeProposal("sso",
+		"# SSO\n\n**Depends on**: none\n\nThe system SHOULD federate.\n",
+		"### Phase 1: Setup\n")
+
+	state, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState error: %v", err)
+	}
+	state.ActivateProposal("oauth-login", nil)
+	if err := saveState(specPath, state); err != nil {
+		t.Fatalf("saveState error: %v", err)
+	}
+
+	got, err := gatherProposalStats(specPath)
+	if err != nil {
+		t.Fatalf("gatherProposalStats error: %v", err)
+	}
+
+	want := []ProposalStats{
+		{Slug: "oauth-login", Status: "primary", TasksTotal: 3, TasksCompleted: 2, Requirements: 2, Dependencies: 2},
+		{Slug: "sso", Status: "pending", TasksTotal: 0, TasksCompleted: 0, Requirements: 0, Dependencies: 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d proposals, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("proposal[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
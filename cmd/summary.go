@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+var specProposalSummaryCmd = &cobra.Command{
+	Use:               "summary <change-slug>",
+	Short:             "Show agent context for one proposal and the specs it depends on",
+	Args:              cobra.ExactArgs(1),
+	Run:               runSpecProposalSummary,
+	ValidArgsFunction: completeProposalNames,
+}
+
+func init() {
+	specProposalSummaryCmd.Long = helpText("spec-proposal-summary")
+	specProposalCmd.AddCommand(specProposalSummaryCmd)
+}
+
+func runSpecProposalSummary(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	content, err := buildProposalSummary(specPath, args[0])
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	printPaged(content)
+}
+
+// buildProposalSummary returns the context for working on one proposal: its
+// documents, the completed specifications it depends on directly or
+// transitively, and the project rules and design.
+func buildProposalSummary(specPath, slug string) (string, error) {
+	proposalPath, err := checkProposal(specPath, slug)
+	if err != nil {
+		return "", err
+	}
+
+	nodes, err := buildDependencyGraph(specPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to build dependency graph: %w", err)
+	}
+	completed, pending, missing := dependencyClosure(specPath, nodes, slug)
+
+	var sections []string
+
+	header := fmt.Sprintf("# Proposal: %s\n\nLocation: %s\n", slug, proposalPath)
+	docs, err := readProposalDocsFiltered(proposalPath, nil)
+	if err != nil {
+		return "", err
+	}
+	if docs != "" {
+		sections = append(sections, header+"\n"+docs)
+	} else {
+		sections = append(sections, header+"\n(No proposal documents found)")
+	}
+
+	var deps strings.Builder
+	deps.WriteString("# Dependencies\n\n")
+	if len(completed)+len(pending)+len(missing) == 0 {
+		deps.WriteString("None\n")
+	}
+	for _, dep := range completed {
+		content, err := os.ReadFile(filepath.Join(specPath, sectionDir, dep+".md"))
+		if err != nil {
+			return "", fmt.Errorf("failed to read specification '%s': %w", dep, err)
+		}
+		deps.WriteString(fmt.Sprintf("## %s\n\n", dep))
+		deps.Write(content)
+		deps.WriteString("\n")
+	}
+	if len(pending) > 0 {
+		deps.WriteString(fmt.Sprintf("Not yet completed: %s\n", strings.Join(pending, ", ")))
+	}
+	if len(missing) > 0 {
+		deps.WriteString(fmt.Sprintf("Missing: %s\n", strings.Join(missing, ", ")))
+	}
+	sections = append(sections, deps.String())
+
+	rules, err := readRulesAndProject(specPath)
+	if err != nil {
+		return "", err
+	}
+	if rules != "" {
+		sections = append(sections, rules)
+	}
+
+	return strings.Join(sections, "\n\n---\n\n"), nil
+}
+
+// dependencyClosure walks the dependencies of slug transitively and returns
+// them sorted by kind: completed specifications, proposals that are not
+// completed yet, and slugs that match neither. A completed specification's
+// own "Depends on" field is followed too, since the graph only records
+// dependencies of open proposals.
+func dependencyClosure(specPath string, nodes map[string]*ProposalNode, slug string) (completed, pending, missing []string) {
+	seen := map[string]bool{slug: true}
+	queue := dependenciesOf(specPath, nodes, slug)
+
+	for len(queue) > 0 {
+		dep := queue[0]
+		queue = queue[1:]
+		if seen[dep] {
+			continue
+		}
+		seen[dep] = true
+
+		node, exists := nodes[dep]
+		switch {
+		case !exists || node.IsMissing:
+			missing = append(missing, dep)
+		case node.IsCompleted:
+			completed = append(completed, dep)
+		default:
+			pending = append(pending, dep)
+		}
+		queue = append(queue, dependenciesOf(specPath, nodes, dep)...)
+	}
+
+	sort.Strings(completed)
+	sort.Strings(pending)
+	sort.Strings(missing)
+	return completed, pending, missing
+}
+
+// dependenciesOf returns the direct dependencies of a graph node, reading a
+// completed specification's "Depends on" field from section/.
+func dependenciesOf(specPath string, nodes map[string]*ProposalNode, slug string) []string {
+	node, exists := nodes[slug]
+	if !exists || node.IsMissing {
+		return nil
+	}
+	if !node.IsCompleted {
+		return node.Dependencies
+	}
+	content, err := os.ReadFile(filepath.Join(specPath, sectionDir, slug+".md"))
+	if err != nil {
+		return nil
+	}
+	return workspace.ParseDependsOn(string(content))
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildProposalSummaryIncludesDependencySpecs(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	for _, dir := range []string{proposalDir, sectionDir, ruleDir} {
+		if err := os.MkdirAll(filepath.Join(specPath, dir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+
+	sections := map[string]string{
+		"sessions": "# Sessions\n\n**Depends on**: storage\n\nSESSIONS-SPEC\n",
+		"storage":  "# Storage\n\nSTORAGE-SPEC\n",
+		"billing":  "# Billing\n\nBILLING-SPEC\n",
+	}
+	for slug, content := range sections {
+		if err := os.WriteFile(filepath.Join(specPath, sectionDir, slug+".md"), []byte(content), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(specPath, ruleDir, "style.md"), []byte("# Style\n\nSTYLE-RULE\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	proposalPath := filepath.Join(specPath, proposalDir, "oauth-login")
+	if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	spec := "# OAuth Login\n\n**Depends on**: sessions, audit-log\n\nOAUTH-SPEC\n"
+	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte(spec), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	out, err := buildProposalSummary(specPath, "oauth-login")
+	if err != nil {
+		t.Fatalf("buildProposalSummary: %v", err)
+	}
+
+	// Direct and transitive dependencies are included, unrelated specs are not
+	for _, want := range []string{"OAUTH-SPEC", "SESSIONS-SPEC", "STORAGE-SPEC", "STYLE-RULE", "Missing: audit-log"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("summary missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "BILLING-SPEC") {
+		t.Errorf("summary includes an unrelated specification:\n%s", out)
+	}
+
+	if _, err := buildProposalSummary(specPath, "nope"); err == nil {
+		t.Error("expected an error for a missing proposal")
+	}
+}
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+var (
+	taskDone   bool
+	taskUndone bool
+)
+
+var specProposalTaskCmd = &cobra.Command{
+	Use:               "task <slug> <task-id>",
+	Short:             "Check or uncheck a task in implementation.md",
+	Args:              cobra.ExactArgs(2),
+	Run:               runSpecProposalTask,
+	ValidArgsFunction: completeProposalNames,
+}
+
+func init() {
+	specProposalTaskCmd.Long = helpText("spec-proposal-task")
+	specProposalTaskCmd.Flags().BoolVar(&taskDone, "done", false, "Check the task off")
+	specProposalTaskCmd.Flags().BoolVar(&taskUndone, "undone", false, "Uncheck the task")
+	specProposalTaskCmd.MarkFlagsMutuallyExclusive("done", "undone")
+	specProposalTaskCmd.MarkFlagsOneRequired("done", "undone")
+	specProposalCmd.AddCommand(specProposalTaskCmd)
+}
+
+func runSpecProposalTask(cmd *cobra.Command, args []string) {
+	slug, taskID := args[0], strings.TrimSpace(args[1])
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	proposalPath, err := checkProposal(specPath, slug)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	task, changed, err := setProposalTask(proposalPath, taskID, taskDone)
+	if err != nil {
+		printError(err.Error())
+		return
+	}
+
+	state := "not done"
+	if taskDone {
+		state = "done"
+	}
+	if !changed {
+		printDim(fmt.Sprintf("Task %s is already %s: %s", task.ID, state, task.Text))
+		return
+	}
+
+	if taskDone {
+		if err := recordTaskCompletion(specPath, slug, task.ID); err != nil {
+			printWarning(fmt.Sprintf("Failed to record task completion: %v", err))
+		}
+	}
+
+	printSuccess(fmt.Sprintf("Marked task %s %s: %s", task.ID, state, task.Text))
+	total, completed := workspace.ProposalProgress(proposalPath)
+	printDim(fmt.Sprintf("Progress: %d/%d tasks complete", completed, total))
+}
+
+// setProposalTask checks or unchecks the task matching taskID in a
+// proposal's implementation.md, leaving every other line untouched. It
+// returns the task and whether the file changed.
+func setProposalTask(proposalPath, taskID string, done bool) (Task, bool, error) {
+	implPath := filepath.Join(proposalPath, "implementation.md")
+	content, err := os.ReadFile(implPath)
+	if err != nil {
+		return Task{}, false, fmt.Errorf("failed to read implementation.md: %w", err)
+	}
+
+	task := findTask(extractPhases(string(content)), taskID)
+	if task == nil {
+		return Task{}, false, fmt.Errorf("task ID not found: %s", taskID)
+	}
+	if task.Complete == done {
+		return *task, false, nil
+	}
+
+	lines := strings.Split(string(content), "\n")
+	if !setTaskChecked(lines, *task, done) {
+		return *task, false, fmt.Errorf("invalid line number for task %s", taskID)
+	}
+	if err := os.WriteFile(implPath, []byte(strings.Join(lines, "\n")), 0644); err != nil {
+		return *task, false, fmt.Errorf("failed to write implementation.md: %w", err)
+	}
+	return *task, true, nil
+}
+
+// findTask returns the task whose phase.task ID (e.g. "1.2") or {#id}
+// marker matches taskID, or nil if there is none.
+func findTask(phases []Phase, taskID string) *Task {
+	for _, phase := range phases {
+		for i := range phase.Tasks {
+			task := &phase.Tasks[i]
+			if task.ID == taskID {
+				return task
+			}
+			if id, _ := parseTaskMarker(task.Text); id != "" && id == taskID {
+				return task
+			}
+		}
+	}
+	return nil
+}
+
+// setTaskChecked rewrites a task's checkbox line as checked or unchecked,
+// preserving its indentation. It reports false if the task's line is out of
+// range.
+func setTaskChecked(lines []string, task Task, checked bool) bool {
+	lineIdx := task.Line - 1 // Convert to 0-indexed
+	if lineIdx < 0 || lineIdx >= len(lines) {
+		return false
+	}
+	box := "- [ ] "
+	if checked {
+		box = "- [x] "
+	}
+	line := lines[lineIdx]
+	indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+	lines[lineIdx] = indent + box + task.Text
+	return true
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetProposalTask(t *testing.T) {
+	proposalPath := t.TempDir()
+	implPath := filepath.Join(proposalPath, "implementation.md")
+	content := `### Phase 1: Setup
+
+- [x] Add config {#add-config}
+  - [ ] Add login form {#add-login}
+- [ ] Write docs
+`
+	if err := os.WriteFile(implPath, []byte(content), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	read := func() string {
+		data, err := os.ReadFile(implPath)
+		if err != nil {
+			t.Fatalf("read: %v", err)
+		}
+		return string(data)
+	}
+
+	// Check off by {#id} marker; only that line changes
+	task, changed, err := setProposalTask(proposalPath, "add-login", true)
+	if err != nil || !changed || task.ID != "1.2" {
+		t.Fatalf("setProposalTask(add-login, done) = %+v, %v, %v", task, changed, err)
+	}
+	want := strings.Replace(content, "  - [ ] Add login form", "  - [x] Add login form", 1)
+	if got := read(); got != want {
+		t.Errorf("after done:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Doing it again is a no-op
+	if _, changed, err := setProposalTask(proposalPath, "add-login", true); err != nil || changed {
+		t.Errorf("repeat done: changed = %v, err = %v", changed, err)
+	}
+
+	// Uncheck by marker and by phase.task ID
+	if _, changed, err := setProposalTask(proposalPath, "add-login", false); err != nil || !changed {
+		t.Fatalf("undone add-login: changed = %v, err = %v", changed, err)
+	}
+	if _, changed, err := setProposalTask(proposalPath, "1.1", false); err != nil || !changed {
+		t.Fatalf("undone 1.1: changed = %v, err = %v", changed, err)
+	}
+	want = strings.Replace(content, "- [x] Add config", "- [ ] Add config", 1)
+	if got := read(); got != want {
+		t.Errorf("after undone:\n%s\nwant:\n%s", got, want)
+	}
+
+	if _, _, err := setProposalTask(proposalPath, "missing", true); err == nil {
+		t.Error("expected error for unknown task")
+	}
+}
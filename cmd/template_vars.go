@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// templateVarKeyPattern matches keys usable as .Vars.<key> in a template.
+var templateVarKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// proposalTemplateData is the data proposal document templates render with.
+// Vars holds the --var values; templates should guard them with {{with}}
+// since any key may be absent.
+type proposalTemplateData struct {
+	Name string
+	Slug string
+	Vars map[string]string
+}
+
+// parseTemplateVars parses repeated key=value flags. Keys must be valid
+// template identifiers and may be given only once; values may be empty and
+// may contain '='.
+func parseTemplateVars(pairs []string) (map[string]string, error) {
+	vars := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid --var '%s': expected key=value", pair)
+		}
+		key = strings.TrimSpace(key)
+		if !templateVarKeyPattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid --var key '%s': use letters, digits, and underscores, not starting with a digit", key)
+		}
+		if _, dup := vars[key]; dup {
+			return nil, fmt.Errorf("--var '%s' given more than once", key)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
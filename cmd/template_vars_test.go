@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTemplateVars(t *testing.T) {
+	t.Parallel()
+
+	got, err := parseTemplateVars([]string{"ticket=PROJ-42", "author=Sam", "query=a=b", "empty="})
+	if err != nil {
+		t.Fatalf("parseTemplateVars error: %v", err)
+	}
+	want := map[string]string{"ticket": "PROJ-42", "author": "Sam", "query": "a=b", "empty": ""}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("vars = %v, want %v", got, want)
+	}
+
+	for _, pairs := range [][]string{{"ticket"}, {"=x"}, {"1st=x"}, {"my-key=x"}, {"a.b=x"}, {"ticket=1", "ticket=2"}} {
+		if _, err := parseTemplateVars(pairs); err == nil {
+			t.Errorf("parseTemplateVars(%q) succeeded, want error", pairs)
+		}
+	}
+}
+
+func TestProposalTemplatesRenderVars(t *testing.T) {
+	t.Parallel()
+
+	spec := ProposalDocument{Name: "Specification", File: "specification.md", Template: "templates/proposal/specification.md"}
+
+	plain, err := renderProposalDocument(t.TempDir(), spec, proposalTemplateData{Name: "OAuth Login", Slug: "oauth-login"})
+	if err != nil {
+		t.Fatalf("render without vars: %v", err)
+	}
+	if strings.Contains(plain, "**Ticket**") {
+		t.Errorf("Ticket line rendered without a ticket var:\n%s", plain)
+	}
+
+	vars, err := parseTemplateVars([]string{"ticket=PROJ-42"})
+	if err != nil {
+		t.Fatalf("parseTemplateVars error: %v", err)
+	}
+	content, err := renderProposalDocument(t.TempDir(), spec, proposalTemplateData{Name: "OAuth Login", Slug: "oauth-login", Vars: vars})
+	if err != nil {
+		t.Fatalf("render with vars: %v", err)
+	}
+	if !strings.Contains(content, "-->\n**Ticket**: PROJ-42\n\n## Abstract") {
+		t.Errorf("specification.md missing the Ticket line:\n%s", content)
+	}
+	if strings.Contains(content, "**Team**") {
+		t.Errorf("Team line rendered without a team var:\n%s", content)
+	}
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+var timelineSlug string
+
+var specProposalTimelineCmd = &cobra.Command{
+	Use:   "timeline",
+	Short: "Show proposal lifecycle history, newest first",
+	Args:  cobra.NoArgs,
+	Run:   runSpecProposalTimeline,
+}
+
+func init() {
+	specProposalTimelineCmd.Long = helpText("spec-proposal-timeline")
+	specProposalTimelineCmd.Flags().StringVar(&timelineSlug, "slug", "", "Only show events for this proposal")
+	specProposalCmd.AddCommand(specProposalTimelineCmd)
+}
+
+func runSpecProposalTimeline(cmd *cobra.Command, args []string) {
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		printWorkspaceError()
+		return
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to load state: %v", err))
+		return
+	}
+
+	events := proposalTimeline(state, timelineSlug)
+	if len(events) == 0 {
+		if timelineSlug != "" {
+			printDim(fmt.Sprintf("No recorded events for '%s'", timelineSlug))
+		} else {
+			printDim("No recorded events")
+		}
+		return
+	}
+
+	var rows [][]string
+	for _, event := range events {
+		eventType := renderEventType(event.Type)
+		if event.Task != "" {
+			eventType += " " + dimStyle.Render(event.Task)
+		}
+		rows = append(rows, []string{formatEventTime(event.Timestamp), eventType, event.Slug})
+	}
+
+	fmt.Println()
+	fmt.Print(renderTable([]string{"TIME", "EVENT", "PROPOSAL"}, rows))
+	fmt.Println()
+}
+
+// proposalTimeline returns the history newest first, optionally limited to
+// one proposal.
+func proposalTimeline(state *workspace.State, slug string) []workspace.Event {
+	var events []workspace.Event
+	for i := len(state.History) - 1; i >= 0; i-- {
+		if slug == "" || state.History[i].Slug == slug {
+			events = append(events, state.History[i])
+		}
+	}
+	return events
+}
+
+// formatEventTime renders an RFC3339 timestamp in local time, falling back
+// to the raw value if it does not parse.
+func formatEventTime(timestamp string) string {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return timestamp
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
+
+// renderEventType colors an event type by its outcome.
+func renderEventType(eventType string) string {
+	switch eventType {
+	case workspace.EventCompleted:
+		return successStyle.Render(eventType)
+	case workspace.EventAbandoned:
+		return errorStyle.Render(eventType)
+	case workspace.EventActivated:
+		return infoStyle.Render(eventType)
+	default:
+		return dimStyle.Render(eventType)
+	}
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+func TestProposalLifecycleEvents(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	for _, dir := range []string{proposalDir, sectionDir} {
+		if err := os.MkdirAll(filepath.Join(specPath, dir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+	runSpecProposalAdd(specProposalAddCmd, []string{"sso"})
+
+	if _, err := activateProposalChecked(specPath, "oauth-login", false); err != nil {
+		t.Fatalf("activateProposalChecked error: %v", err)
+	}
+	if _, err := completeProposal(specPath, "oauth-login", true, false, sectionConflictError); err != nil {
+		t.Fatalf("completeProposal error: %v", err)
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState error: %v", err)
+	}
+
+	want := []workspace.Event{
+		{Type: workspace.EventCreated, Slug: "oauth-login"},
+		{Type: workspace.EventCreated, Slug: "sso"},
+		{Type: workspace.EventActivated, Slug: "oauth-login"},
+		{Type: workspace.EventCompleted, Slug: "oauth-login"},
+	}
+	if len(state.History) != len(want) {
+		t.Fatalf("history has %d events, want %d: %+v", len(state.History), len(want), state.History)
+	}
+	for i, event := range state.History {
+		if event.Type != want[i].Type || event.Slug != want[i].Slug {
+			t.Errorf("history[%d] = %s %s, want %s %s", i, event.Type, event.Slug, want[i].Type, want[i].Slug)
+		}
+		if event.Timestamp == "" {
+			t.Errorf("history[%d] has no timestamp", i)
+		}
+	}
+
+	timeline := proposalTimeline(state, "oauth-login")
+	if len(timeline) != 3 || timeline[0].Type != workspace.EventCompleted || timeline[2].Type != workspace.EventCreated {
+		t.Errorf("expected oauth-login events newest first, got %+v", timeline)
+	}
+}
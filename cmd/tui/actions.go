@@ -1,226 +1,43 @@
 package tui
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
-	"sort"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
 const (
 	proposalDir = "proposal"
 	archiveDir  = "archive"
 	sectionDir  = "section"
-	stateFile   = ".nocturnal.json"
 )
 
+// proposalDocFiles are the documents hashed on activation when no
+// Documents hook is set.
 var proposalDocFiles = []string{"specification.md", "design.md", "implementation.md"}
 
-// Helper functions copied/adapted from cmd package
-
-// fileExists returns true if the path exists.
-func fileExists(path string) bool {
-	_, err := os.Stat(path)
-	return err == nil
-}
-
-// copyFile copies a file from src to dst with 0644 permissions.
-func copyFile(src, dst string) error {
-	content, err := os.ReadFile(src)
-	if err != nil {
-		return err
-	}
-	return os.WriteFile(dst, content, 0644)
-}
-
-// getStatePath returns the path to the state file.
-func getStatePath(specPath string) string {
-	return filepath.Join(specPath, stateFile)
-}
-
-// saveState writes the state file.
-func saveState(specPath string, state *State) error {
-	statePath := getStatePath(specPath)
-	data, err := json.MarshalIndent(state, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to serialize state: %w", err)
-	}
-	return os.WriteFile(statePath, data, 0644)
-}
-
-// hashFile computes SHA256 hash of a file's contents.
-func hashFile(path string) (string, error) {
-	content, err := os.ReadFile(path)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return "", nil
-		}
-		return "", err
-	}
-
-	h := sha256.Sum256(content)
-	return hex.EncodeToString(h[:]), nil
-}
-
-// computeProposalHashes computes hashes for all proposal documents.
-func computeProposalHashes(proposalPath string) (map[string]string, error) {
-	hashes := make(map[string]string)
-
-	for _, filename := range proposalDocFiles {
-		filePath := filepath.Join(proposalPath, filename)
-		hash, err := hashFile(filePath)
-		if err != nil {
-			return nil, fmt.Errorf("failed to hash %s: %w", filename, err)
-		}
-		if hash != "" {
-			hashes[filename] = hash
-		}
-	}
-
-	return hashes, nil
-}
-
-// archiveProposalDocs copies proposal documents to the archive directory
-func archiveProposalDocs(proposalPath, archivePath string, files []string) error {
-	if err := os.MkdirAll(archivePath, 0755); err != nil {
-		return fmt.Errorf("failed to create archive directory: %w", err)
-	}
-
-	for _, filename := range files {
-		src := filepath.Join(proposalPath, filename)
-		if fileExists(src) {
-			dst := filepath.Join(archivePath, filename)
-			if err := copyFile(src, dst); err != nil {
-				return fmt.Errorf("failed to archive %s: %w", filename, err)
-			}
-		}
-	}
-	return nil
-}
-
-// parseDependsOn extracts dependencies from the "**Depends on**:" field in content
-func parseDependsOn(content string) []string {
-	lines := strings.Split(content, "\n")
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-		lower := strings.ToLower(trimmed)
-		if strings.HasPrefix(lower, "**depends on**:") || strings.HasPrefix(lower, "depends on:") {
-			idx := strings.Index(trimmed, ":")
-			if idx == -1 {
-				continue
-			}
-			value := strings.TrimSpace(trimmed[idx+1:])
-			if commentIdx := strings.Index(value, "<!--"); commentIdx != -1 {
-				value = strings.TrimSpace(value[:commentIdx])
-			}
-			if value == "" || strings.ToLower(value) == "none" || strings.Contains(value, "<!--") {
-				return nil
-			}
-			var deps []string
-			for _, dep := range strings.Split(value, ",") {
-				dep = strings.TrimSpace(dep)
-				if dep != "" {
-					deps = append(deps, dep)
-				}
-			}
-			return deps
-		}
-	}
-	return nil
-}
-
-// getProposalDependencies reads the specification.md file and extracts the "Depends on" field
-func getProposalDependencies(proposalPath string) ([]string, error) {
-	specPath := filepath.Join(proposalPath, "specification.md")
-	content, err := os.ReadFile(specPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, nil
-		}
-		return nil, fmt.Errorf("failed to read specification.md: %w", err)
-	}
-	return parseDependsOn(string(content)), nil
-}
-
-// getMissingCompletedDependencies returns dependencies that are not completed.
-func getMissingCompletedDependencies(specPath, proposalPath string) ([]string, error) {
-	deps, err := getProposalDependencies(proposalPath)
-	if err != nil {
-		return nil, err
-	}
-
-	var missing []string
-	for _, dep := range deps {
-		completedSpecPath := filepath.Join(specPath, sectionDir, dep+".md")
-		if !fileExists(completedSpecPath) {
-			missing = append(missing, dep)
-		}
-	}
-
-	sort.Strings(missing)
-	return missing, nil
-}
-
-// clearProposalIfMatches removes a proposal from active/primary if it matches.
+// clearProposalIfMatches removes a proposal from active/primary if it matches
+// and drops its guideline hashes, once it has left proposal/.
 func clearProposalIfMatches(specPath, slug string) error {
-	state, err := loadState(specPath)
+	state, err := workspace.LoadState(specPath)
 	if err != nil {
 		return err
 	}
 
-	if state.isProposalActive(slug) {
-		state.deactivateProposal(slug)
-		return saveState(specPath, state)
+	if state.ForgetProposal(slug) {
+		return workspace.SaveState(specPath, state)
 	}
 	return nil
 }
 
-// State methods
-
-func (s *State) isProposalActive(slug string) bool {
-	for _, active := range s.Active {
-		if active == slug {
-			return true
-		}
-	}
-	return false
-}
-
-func (s *State) activateProposal(slug string, hashes map[string]string) {
-	if !s.isProposalActive(slug) {
-		s.Active = append(s.Active, slug)
-	}
-	s.Primary = slug
-	s.Hashes[slug] = hashes
-}
-
-func (s *State) deactivateProposal(slug string) {
-	var newActive []string
-	for _, active := range s.Active {
-		if active != slug {
-			newActive = append(newActive, active)
-		}
-	}
-	s.Active = newActive
-	delete(s.Hashes, slug)
-
-	if s.Primary == slug {
-		if len(s.Active) > 0 {
-			s.Primary = s.Active[0]
-		} else {
-			s.Primary = ""
-		}
-	}
-}
-
-// ActivateProposal activates a proposal by slug.
-func ActivateProposal(specPath, slug string) tea.Cmd {
+// ActivateProposal activates a proposal by slug. With force, dependencies that
+// are not yet completed do not block activation.
+func ActivateProposal(specPath, slug string, force bool) tea.Cmd {
 	return func() tea.Msg {
 		proposalPath := filepath.Join(specPath, "proposal", slug)
 
@@ -230,32 +47,42 @@ func ActivateProposal(specPath, slug string) tea.Cmd {
 		}
 
 		// Check dependencies
-		missing, err := getMissingCompletedDependencies(specPath, proposalPath)
+		missing, err := workspace.MissingCompletedDependencies(specPath, proposalPath)
 		if err != nil {
 			return ErrorMsg{Err: fmt.Errorf("failed to check dependencies: %w", err)}
 		}
-		if len(missing) > 0 {
+		if len(missing) > 0 && !force {
 			return ErrorMsg{Err: fmt.Errorf("missing dependencies: %s", strings.Join(missing, ", "))}
 		}
 
 		// Compute hashes
-		hashes, err := computeProposalHashes(proposalPath)
+		files := documentFiles(specPath)
+		hashes, err := workspace.ComputeProposalHashes(proposalPath, files)
 		if err != nil {
 			return ErrorMsg{Err: fmt.Errorf("failed to compute hashes: %w", err)}
 		}
+		baseline, err := workspace.ReadProposalBaseline(proposalPath, files)
+		if err != nil {
+			return ErrorMsg{Err: fmt.Errorf("failed to record baseline: %w", err)}
+		}
 
 		// Load state and activate
-		state, err := loadState(specPath)
+		state, err := workspace.LoadState(specPath)
 		if err != nil {
 			return ErrorMsg{Err: fmt.Errorf("failed to load state: %w", err)}
 		}
 
-		state.activateProposal(slug, hashes)
+		state.ActivateProposal(slug, hashes)
+		state.Baselines[slug] = baseline
+		state.RecordEvent(slug, workspace.EventActivated)
 
-		if err := saveState(specPath, state); err != nil {
+		if err := workspace.SaveState(specPath, state); err != nil {
 			return ErrorMsg{Err: fmt.Errorf("failed to save state: %w", err)}
 		}
 
+		if len(missing) > 0 {
+			return SuccessMsg{Message: fmt.Sprintf("Activated proposal: %s (missing dependencies: %s)", slug, strings.Join(missing, ", "))}
+		}
 		return SuccessMsg{Message: fmt.Sprintf("Activated proposal: %s", slug)}
 	}
 }
@@ -263,7 +90,7 @@ func ActivateProposal(specPath, slug string) tea.Cmd {
 // DeactivateProposal deactivates the current proposal.
 func DeactivateProposal(specPath string) tea.Cmd {
 	return func() tea.Msg {
-		state, err := loadState(specPath)
+		state, err := workspace.LoadState(specPath)
 		if err != nil {
 			return ErrorMsg{Err: fmt.Errorf("failed to load state: %w", err)}
 		}
@@ -273,9 +100,10 @@ func DeactivateProposal(specPath string) tea.Cmd {
 		}
 
 		slug := state.Primary
-		state.deactivateProposal(slug)
+		state.DeactivateProposal(slug)
+		state.RecordEvent(slug, workspace.EventDeactivated)
 
-		if err := saveState(specPath, state); err != nil {
+		if err := workspace.SaveState(specPath, state); err != nil {
 			return ErrorMsg{Err: fmt.Errorf("failed to save state: %w", err)}
 		}
 
@@ -292,34 +120,73 @@ func CompleteProposal(specPath, slug string) tea.Cmd {
 		if _, err := os.Stat(proposalPath); os.IsNotExist(err) {
 			return ErrorMsg{Err: fmt.Errorf("proposal '%s' not found", slug)}
 		}
-
-		archivePath := filepath.Join(specPath, "archive", slug)
-		sectionPath := filepath.Join(specPath, "section")
-
-		specFile := filepath.Join(proposalPath, "specification.md")
-		if _, err := os.Stat(specFile); os.IsNotExist(err) {
-			return ErrorMsg{Err: fmt.Errorf("proposal '%s' is missing specification.md", slug)}
+		if hooks.Complete == nil {
+			return ErrorMsg{Err: fmt.Errorf("completing a proposal is not available")}
 		}
 
-		// Archive design and implementation documents
-		if err := archiveProposalDocs(proposalPath, archivePath, []string{"design.md", "implementation.md"}); err != nil {
+		if err := hooks.Complete(specPath, slug); err != nil {
 			return ErrorMsg{Err: err}
 		}
+		return SuccessMsg{Message: fmt.Sprintf("Completed proposal: %s", slug)}
+	}
+}
 
-		// Promote specification to section
-		specDst := filepath.Join(sectionPath, slug+".md")
-		if err := copyFile(specFile, specDst); err != nil {
-			return ErrorMsg{Err: fmt.Errorf("failed to promote specification: %w", err)}
-		}
+// DocumentValidation is the validation outcome of a single proposal document.
+type DocumentValidation struct {
+	Document string
+	Errors   []string
+	Warnings []string
+	Notes    []string
+}
 
-		if err := os.RemoveAll(proposalPath); err != nil {
-			return ErrorMsg{Err: fmt.Errorf("failed to remove proposal workspace: %w", err)}
-		}
+// Validator validates the documents of the proposal at proposalPath.
+type Validator func(specPath, proposalPath string) []DocumentValidation
 
-		clearProposalIfMatches(specPath, slug)
+// Scaffolder creates a workspace file for the given display name from its
+// template and returns the slug it was saved under.
+type Scaffolder func(specPath, name string) (string, error)
 
-		return SuccessMsg{Message: fmt.Sprintf("Completed proposal: %s", slug)}
+// Hooks are the workspace operations supplied by the caller of Run, so that
+// the TUI applies the same rules as the equivalent nocturnal commands.
+type Hooks struct {
+	Validate       Validator  // 'nocturnal spec proposal validate'
+	NewRule        Scaffolder // 'nocturnal spec rule add'
+	NewMaintenance Scaffolder // 'nocturnal spec maintenance add'
+
+	// Documents returns the proposal document filenames configured in
+	// nocturnal.yaml, so activation hashes the same files the CLI checks
+	Documents func(specPath string) []string
+
+	// WatchDebounce returns ui.watch_debounce_ms from nocturnal.yaml
+	WatchDebounce func(specPath string) time.Duration
+
+	// Rename is 'nocturnal spec proposal rename'. It returns the proposals
+	// whose dependencies were repointed
+	Rename func(specPath, oldSlug, newSlug string) ([]string, error)
+
+	// Complete is 'nocturnal spec proposal complete', archiving every
+	// configured document and refusing to replace a completed specification
+	Complete func(specPath, slug string) error
+}
+
+// hooks holds the operations passed to Run.
+var hooks Hooks
+
+// documentFiles returns the proposal documents to hash on activation.
+func documentFiles(specPath string) []string {
+	if hooks.Documents != nil {
+		return hooks.Documents(specPath)
+	}
+	return proposalDocFiles
+}
+
+// watchDebounce returns the file watcher's debounce, or zero for the
+// default.
+func watchDebounce(specPath string) time.Duration {
+	if hooks.WatchDebounce != nil {
+		return hooks.WatchDebounce(specPath)
 	}
+	return 0
 }
 
 // ValidateProposal validates a proposal by slug.
@@ -331,47 +198,77 @@ func ValidateProposal(specPath, slug string) tea.Cmd {
 		if _, err := os.Stat(proposalPath); os.IsNotExist(err) {
 			return ErrorMsg{Err: fmt.Errorf("proposal '%s' not found", slug)}
 		}
+		if hooks.Validate == nil {
+			return ErrorMsg{Err: fmt.Errorf("validation is not available")}
+		}
 
-		// Check for required files
-		errors := []string{}
-		warnings := []string{}
+		results := hooks.Validate(specPath, proposalPath)
 
-		// Check specification.md
-		specFile := filepath.Join(proposalPath, "specification.md")
-		if _, err := os.Stat(specFile); os.IsNotExist(err) {
-			errors = append(errors, "missing specification.md")
+		// Activation needs completed dependencies, so surface them alongside
+		// the document checks
+		deps := DocumentValidation{Document: "dependencies"}
+		missing, err := workspace.MissingCompletedDependencies(specPath, proposalPath)
+		if err != nil {
+			deps.Warnings = append(deps.Warnings, fmt.Sprintf("failed to check dependencies: %v", err))
+		} else if len(missing) > 0 {
+			deps.Warnings = append(deps.Warnings, fmt.Sprintf("missing completed dependencies: %s", strings.Join(missing, ", ")))
 		}
+		results = append(results, deps)
 
-		// Check implementation.md
-		implFile := filepath.Join(proposalPath, "implementation.md")
-		if _, err := os.Stat(implFile); os.IsNotExist(err) {
-			warnings = append(warnings, "missing implementation.md (recommended)")
-		}
+		return ValidationMsg{Slug: slug, Results: results}
+	}
+}
 
-		// Check design.md
-		designFile := filepath.Join(proposalPath, "design.md")
-		if _, err := os.Stat(designFile); os.IsNotExist(err) {
-			warnings = append(warnings, "missing design.md (optional)")
+// CreateRule scaffolds a new rule named name.
+func CreateRule(specPath, name string) tea.Cmd {
+	return scaffold("rule", hooks.NewRule, specPath, name)
+}
+
+// CreateMaintenance scaffolds a new maintenance item named name.
+func CreateMaintenance(specPath, name string) tea.Cmd {
+	return scaffold("maintenance item", hooks.NewMaintenance, specPath, name)
+}
+
+// scaffold runs create for name, reporting the outcome as a status message.
+func scaffold(kind string, create Scaffolder, specPath, name string) tea.Cmd {
+	return func() tea.Msg {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return ErrorMsg{Err: fmt.Errorf("%s name cannot be empty", kind)}
+		}
+		if create == nil {
+			return ErrorMsg{Err: fmt.Errorf("creating a %s is not available", kind)}
 		}
 
-		// Check dependencies
-		missing, err := getMissingCompletedDependencies(specPath, proposalPath)
+		slug, err := create(specPath, name)
 		if err != nil {
-			warnings = append(warnings, fmt.Sprintf("failed to check dependencies: %v", err))
-		} else if len(missing) > 0 {
-			warnings = append(warnings, fmt.Sprintf("missing dependencies: %s", strings.Join(missing, ", ")))
+			return ErrorMsg{Err: err}
 		}
+		return SuccessMsg{Message: fmt.Sprintf("Created %s '%s'", kind, slug)}
+	}
+}
 
-		if len(errors) > 0 {
-			return ErrorMsg{Err: fmt.Errorf("validation failed:\n  - %s", strings.Join(errors, "\n  - "))}
+// RenameProposal renames a proposal to newSlug, reporting the outcome as a
+// status message.
+func RenameProposal(specPath, slug, newSlug string) tea.Cmd {
+	return func() tea.Msg {
+		newSlug = strings.TrimSpace(newSlug)
+		if newSlug == "" {
+			return ErrorMsg{Err: fmt.Errorf("new slug cannot be empty")}
 		}
-
-		msg := fmt.Sprintf("Proposal '%s' is valid", slug)
-		if len(warnings) > 0 {
-			msg += fmt.Sprintf("\nWarnings:\n  - %s", strings.Join(warnings, "\n  - "))
+		if hooks.Rename == nil {
+			return ErrorMsg{Err: fmt.Errorf("renaming a proposal is not available")}
 		}
 
-		return SuccessMsg{Message: msg}
+		repointed, err := hooks.Rename(specPath, slug, newSlug)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		message := fmt.Sprintf("Renamed proposal '%s' to '%s'", slug, newSlug)
+		if len(repointed) > 0 {
+			message += fmt.Sprintf(" (repointed %d dependent proposal(s))", len(repointed))
+		}
+		return SuccessMsg{Message: message}
 	}
 }
 
@@ -386,12 +283,12 @@ func DeleteProposal(specPath, slug string, force bool) tea.Cmd {
 		}
 
 		// Check if proposal is active
-		state, err := loadState(specPath)
+		state, err := workspace.LoadState(specPath)
 		if err != nil {
 			return ErrorMsg{Err: fmt.Errorf("failed to load state: %w", err)}
 		}
 
-		if !force && state.isProposalActive(slug) {
+		if !force && state.IsProposalActive(slug) {
 			return ErrorMsg{Err: fmt.Errorf("proposal '%s' is active; deactivate first or use force", slug)}
 		}
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/lipgloss"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
 // Detail represents a two-pane detail view (list + content).
@@ -12,6 +13,8 @@ type Detail struct {
 	leftList *List
 	viewport *viewport.Model
 	content  string
+	markdown string // raw markdown, rendered to the content panel width
+	mdWidth  int    // width markdown was last rendered at
 	height   int
 	showLeft bool
 	percent  int // percentage of width for left panel
@@ -53,11 +56,22 @@ func (d *Detail) SetItems(items []ListItem) {
 
 // SetContent sets the right content.
 func (d *Detail) SetContent(content string) {
+	d.markdown = ""
 	d.content = content
 	d.viewport.SetContent(content)
 	d.viewport.GotoTop()
 }
 
+// SetMarkdown sets the right content from markdown. It is rendered when the
+// panel is drawn, and again whenever the panel width changes.
+func (d *Detail) SetMarkdown(markdown string) {
+	d.markdown = markdown
+	d.mdWidth = 0
+	d.content = ""
+	d.viewport.SetContent("")
+	d.viewport.GotoTop()
+}
+
 // SetHeight sets the detail view height.
 func (d *Detail) SetHeight(height int) {
 	d.height = height
@@ -130,6 +144,11 @@ func (d *Detail) renderLeft(width int) string {
 // renderContent renders the right content panel.
 func (d *Detail) renderContent(width int) string {
 	d.viewport.Width = width
+	if d.markdown != "" && d.mdWidth != width {
+		d.mdWidth = width
+		d.content = RenderMarkdown(d.markdown, width)
+		d.viewport.SetContent(d.content)
+	}
 	return d.viewport.View()
 }
 
@@ -169,13 +188,32 @@ func (d *Detail) GotoBottom() {
 	d.viewport.GotoBottom()
 }
 
-// RenderMarkdown renders markdown content with basic styling.
+// RenderMarkdown renders markdown content with basic styling. Regular text,
+// list items, and quotes are word-wrapped to width; code blocks are not.
 func RenderMarkdown(content string, width int) string {
 	lines := strings.Split(content, "\n")
 	var rendered []string
+	inCode := false
+
+	// A leading YAML front-matter block is metadata, not two separators
+	if end := workspace.FrontMatterEnd(lines); end > 0 {
+		for _, line := range lines[1:end] {
+			rendered = append(rendered, detailDimStyle.Render(line))
+		}
+		lines = lines[end+1:]
+	}
 
 	for _, line := range lines {
-		if strings.HasPrefix(line, "# ") {
+		if strings.HasPrefix(line, "```") {
+			// Code block - simple handling
+			if !inCode {
+				rendered = append(rendered, detailDimStyle.Render("  Code block"))
+			}
+			inCode = !inCode
+		} else if inCode {
+			// Code is left as written, even if it overflows
+			rendered = append(rendered, line)
+		} else if strings.HasPrefix(line, "# ") {
 			// Heading 1
 			rendered = append(rendered, detailTitleStyle.Render(line))
 		} else if strings.HasPrefix(line, "## ") {
@@ -185,26 +223,43 @@ func RenderMarkdown(content string, width int) string {
 			// Heading 3
 			rendered = append(rendered, lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("14")).Render(line))
 		} else if strings.HasPrefix(line, "- ") {
-			// List item
-			rendered = append(rendered, "  "+line)
+			// List item, with continuation lines indented past the marker
+			rendered = append(rendered, wrapLine(line, width, "  ", "    "))
 		} else if strings.TrimSpace(line) == "---" {
 			// Separator
 			rendered = append(rendered, detailDimStyle.Render(strings.Repeat("─", width)))
 		} else if strings.HasPrefix(line, "> ") {
 			// Quote
-			rendered = append(rendered, detailDimStyle.Render("  "+line))
-		} else if strings.HasPrefix(line, "```") {
-			// Code block - simple handling
-			rendered = append(rendered, detailDimStyle.Render("  Code block"))
+			rendered = append(rendered, detailDimStyle.Render(wrapLine(strings.TrimPrefix(line, "> "), width, "  > ", "  > ")))
 		} else {
 			// Regular text
-			rendered = append(rendered, line)
+			rendered = append(rendered, wrapLine(line, width, "", ""))
 		}
 	}
 
 	return strings.Join(rendered, "\n")
 }
 
+// wrapLine word-wraps line so that, with prefix on the first line and indent
+// on the rest, no line is wider than width. Lines that already fit, or a
+// width too narrow to wrap into, leave the line as is.
+func wrapLine(line string, width int, prefix, indent string) string {
+	avail := width - max(lipgloss.Width(prefix), lipgloss.Width(indent))
+	if avail < 10 || lipgloss.Width(prefix+line) <= width {
+		return prefix + line
+	}
+	wrapped := strings.Split(lipgloss.NewStyle().Width(avail).Render(line), "\n")
+	for i, l := range wrapped {
+		l = strings.TrimRight(l, " ")
+		if i == 0 {
+			wrapped[i] = prefix + l
+		} else {
+			wrapped[i] = indent + l
+		}
+	}
+	return strings.Join(wrapped, "\n")
+}
+
 // GetContentPreview returns a preview of content (first few lines).
 func GetContentPreview(content string, maxLines int) string {
 	lines := strings.Split(content, "\n")
@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestWrapLine(t *testing.T) {
+	line := "The quick brown fox jumps over the lazy dog while the cat watches from the windowsill"
+
+	tests := []struct {
+		name           string
+		width          int
+		prefix, indent string
+	}{
+		{name: "plain", width: 30},
+		{name: "list item", width: 30, prefix: "  ", indent: "    "},
+		{name: "quote", width: 25, prefix: "  > ", indent: "  > "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := strings.Split(wrapLine(line, tt.width, tt.prefix, tt.indent), "\n")
+			if len(got) < 2 {
+				t.Fatalf("expected the line to wrap, got %q", got)
+			}
+
+			var words []string
+			for i, l := range got {
+				if w := lipgloss.Width(l); w > tt.width {
+					t.Errorf("line %d is %d wide, over %d: %q", i, w, tt.width, l)
+				}
+				want := tt.indent
+				if i == 0 {
+					want = tt.prefix
+				}
+				rest, ok := strings.CutPrefix(l, want)
+				if !ok {
+					t.Errorf("line %d = %q, want prefix %q", i, l, want)
+				}
+				words = append(words, strings.Fields(rest)...)
+			}
+
+			// Breaking only between words keeps every word intact
+			if joined := strings.Join(words, " "); joined != line {
+				t.Errorf("wrapped words = %q, want %q", joined, line)
+			}
+		})
+	}
+
+	if got := wrapLine("short", 30, "  ", "    "); got != "  short" {
+		t.Errorf("fitting line = %q, want it prefixed only", got)
+	}
+	if got := wrapLine(line, 12, "  > ", "  > "); got != "  > "+line {
+		t.Errorf("too narrow to wrap = %q, want the line as is", got)
+	}
+}
+
+func TestRenderMarkdownLeavesCodeUnwrapped(t *testing.T) {
+	code := "    result := someFunction(argumentOne, argumentTwo, argumentThree, argumentFour)"
+	content := "Intro\n```go\n" + code + "\n```\n" + strings.Repeat("word ", 20)
+
+	rendered := RenderMarkdown(content, 40)
+	lines := strings.Split(rendered, "\n")
+
+	found := false
+	for _, l := range lines {
+		if l == code {
+			found = true
+			continue
+		}
+		if w := lipgloss.Width(l); w > 40 {
+			t.Errorf("text line is %d wide, over 40: %q", w, l)
+		}
+	}
+	if !found {
+		t.Errorf("code line was changed, rendered:\n%s", rendered)
+	}
+}
@@ -4,42 +4,64 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 
 	bubbletea "github.com/charmbracelet/bubbletea"
 )
 
+// defaultEditors is searched in order when no editor is configured.
+var defaultEditors = []string{"vim", "nvim", "vi", "nano", "code --wait"}
+
+// editorOverride is the editor chosen by the caller of Run (flag or config).
+var editorOverride string
+
 // Editor handles opening external editors for file editing.
 type Editor struct {
 	editor string
 	path   string
 }
 
-// NewEditor creates a new editor instance for the given file.
-func NewEditor(path string) *Editor {
-	editor := os.Getenv("EDITOR")
-	if editor == "" {
-		// Try common editors
-		editors := []string{"vim", "nvim", "vi", "nano", "code", "code --wait"}
-		for _, e := range editors {
-			if _, err := exec.LookPath(e); err == nil {
-				editor = e
-				break
-			}
+// ResolveEditor returns the editor command to use. Precedence is the
+// --editor flag, then the configured editor, then $EDITOR, then the first
+// installed editor from the default list.
+func ResolveEditor(flagEditor, configEditor string) string {
+	for _, e := range []string{flagEditor, configEditor, os.Getenv("EDITOR")} {
+		if e = strings.TrimSpace(e); e != "" {
+			return e
 		}
 	}
-	if editor == "" {
-		editor = "vim" // Final fallback
+
+	for _, e := range defaultEditors {
+		if _, err := exec.LookPath(strings.Fields(e)[0]); err == nil {
+			return e
+		}
 	}
 
+	return "vim" // Final fallback
+}
+
+// EditorCommand builds the command that opens paths in editor. The editor
+// may include arguments, e.g. "code --wait".
+func EditorCommand(editor string, paths ...string) *exec.Cmd {
+	parts := strings.Fields(editor)
+	if len(parts) == 0 {
+		parts = []string{"vim"}
+	}
+	args := append(parts[1:], paths...)
+	return exec.Command(parts[0], args...)
+}
+
+// NewEditor creates a new editor instance for the given file.
+func NewEditor(path string) *Editor {
 	return &Editor{
-		editor: editor,
+		editor: ResolveEditor(editorOverride, ""),
 		path:   path,
 	}
 }
 
 // RunCmd returns a bubbletea.Cmd that opens the editor.
 func (e *Editor) RunCmd() bubbletea.Cmd {
-	c := exec.Command(e.editor, e.path)
+	c := EditorCommand(e.editor, e.path)
 	return bubbletea.ExecProcess(c, func(err error) bubbletea.Msg {
 		if err != nil {
 			return ErrorMsg{Err: fmt.Errorf("editor error: %w", err)}
@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// HelpKey describes a single key binding shown in the help overlay.
+type HelpKey struct {
+	Key  string
+	Desc string
+}
+
+// globalHelpKeys are the bindings handled by the model on every tab.
+var globalHelpKeys = []HelpKey{
+	{Key: "←/h →/l", Desc: "Switch tab"},
+	{Key: "r", Desc: "Refresh"},
+	{Key: "?", Desc: "Toggle this help"},
+	{Key: "q", Desc: "Quit"},
+}
+
+// listHelpKeys are the bindings shared by the list/detail pages.
+var listHelpKeys = []HelpKey{
+	{Key: "↑/k ↓/j", Desc: "Move selection or scroll content"},
+	{Key: "enter", Desc: "View selected item"},
+	{Key: "e", Desc: "Open in editor"},
+	{Key: "esc", Desc: "Back to list"},
+}
+
+// Styles for the help overlay.
+var (
+	helpOverlayStyle = lipgloss.NewStyle().
+				Border(lipgloss.RoundedBorder()).
+				BorderForeground(lipgloss.Color("12")).
+				Padding(1, 3)
+
+	helpTitleStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("12"))
+
+	helpSectionStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(lipgloss.Color("15"))
+
+	helpKeyStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("11"))
+)
+
+// renderHelpOverlay renders the key bindings for a tab centered in a
+// width x height area.
+func renderHelpOverlay(tab Tab, pageKeys []HelpKey, width, height int) string {
+	var lines []string
+	lines = append(lines, helpTitleStyle.Render(fmt.Sprintf("Keys: %s", tab)))
+	lines = append(lines, "")
+
+	if len(pageKeys) > 0 {
+		lines = append(lines, helpSectionStyle.Render(tab.String()))
+		lines = append(lines, renderHelpKeys(pageKeys)...)
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, helpSectionStyle.Render("Global"))
+	lines = append(lines, renderHelpKeys(globalHelpKeys)...)
+
+	box := helpOverlayStyle.Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// renderHelpKeys renders key bindings as aligned "key  description" rows.
+func renderHelpKeys(keys []HelpKey) []string {
+	keyWidth := 0
+	for _, k := range keys {
+		if w := lipgloss.Width(k.Key); w > keyWidth {
+			keyWidth = w
+		}
+	}
+
+	rows := make([]string, 0, len(keys))
+	for _, k := range keys {
+		padding := strings.Repeat(" ", keyWidth-lipgloss.Width(k.Key))
+		rows = append(rows, fmt.Sprintf("  %s%s  %s", helpKeyStyle.Render(k.Key), padding, k.Desc))
+	}
+	return rows
+}
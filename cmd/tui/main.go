@@ -3,12 +3,12 @@ package tui
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"time"
 
 	"github.com/charmbracelet/bubbles/viewport"
 	bubbletea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
 // Model is the main TUI model.
@@ -18,6 +18,7 @@ type Model struct {
 	tabs       *Tabs
 	header     *Header
 	status     *Status
+	prompt     *Prompt
 	viewport   *viewport.Model
 
 	// Page models
@@ -34,6 +35,7 @@ type Model struct {
 	quitting  bool
 	lastError string
 	watcher   *Watcher
+	showHelp  bool
 }
 
 // Init initializes the TUI model.
@@ -48,11 +50,14 @@ func (m Model) Init() bubbletea.Cmd {
 	m.statsPage.LoadData(m.specPath)
 
 	// Update header with active proposal
-	activeSlug := getActiveProposal(m.specPath)
+	activeSlug := workspace.PrimaryProposal(m.specPath)
 	if activeSlug != "" {
 		m.header.UpdateActiveProposal(activeSlug)
 	}
 
+	if m.watcher != nil {
+		return m.watcher.Start()
+	}
 	return nil
 }
 
@@ -62,6 +67,17 @@ func (m Model) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
 
 	switch msg := msg.(type) {
 	case bubbletea.KeyMsg:
+		// An open prompt takes every key until it is submitted or cancelled
+		if m.prompt.Active() {
+			return m, m.prompt.Update(msg)
+		}
+
+		// While the help overlay is open, ? and esc only close it
+		if m.showHelp && (m.keys.IsHelpKey(msg) || msg.String() == "esc") {
+			m.showHelp = false
+			return m, nil
+		}
+
 		// Check for quit
 		if m.keys.IsQuitKey(msg) {
 			if m.watcher != nil {
@@ -73,7 +89,7 @@ func (m Model) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
 
 		// Check for help
 		if m.keys.IsHelpKey(msg) {
-			m.status.ToggleHelp()
+			m.showHelp = true
 			return m, nil
 		}
 
@@ -151,24 +167,37 @@ func (m Model) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
 
 	case RefreshMsg:
 		m.refreshData()
-		return m, nil
+		return m, m.revalidate()
+
+	case FileChangedMsg:
+		m.refreshData()
+		return m, bubbletea.Batch(m.revalidate(), m.watcher.Listen())
+
+	case WatchErrorMsg:
+		return m, bubbletea.Batch(m.status.Update(ErrorMsg(msg)), m.watcher.Listen())
 
 	case EditorDoneMsg:
-		m.status.SetSuccess("File saved")
 		m.refreshData()
-		return m, nil
+		return m, bubbletea.Batch(m.status.Push("File saved", "success"), m.revalidate())
 
 	case ErrorMsg:
-		m.status.SetError(msg.Err.Error())
-		return m, nil
+		return m, m.status.Update(msg)
+
+	case ValidationMsg:
+		m.proposalsPage.ShowValidation(msg.Slug, msg.Results)
+		errors, warnings := countValidationIssues(msg.Results)
+		summary := fmt.Sprintf("Validated '%s': %d error(s), %d warning(s)", msg.Slug, errors, warnings)
+		if errors > 0 {
+			return m, m.status.Push(summary, "error")
+		}
+		return m, m.status.Push(summary, "success")
 
 	case SuccessMsg:
-		m.status.SetSuccess(msg.Message)
 		m.refreshData()
-		return m, nil
+		return m, m.status.Update(msg)
 
 	case ShowHelpMsg:
-		m.status.ToggleHelp()
+		m.showHelp = msg.Show
 		return m, nil
 	}
 
@@ -205,16 +234,46 @@ func (m Model) View() string {
 		pageView = m.statsPage.View()
 	}
 
+	if m.showHelp {
+		pageView = renderHelpOverlay(m.currentTab, m.pageHelpKeys(), m.viewport.Width, m.viewport.Height)
+	}
+
+	statusView := m.status.View(m.viewport.Width)
+	if m.prompt.Active() {
+		statusView = m.prompt.View(m.viewport.Width)
+	}
+
 	// Build full view
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		m.header.View(m.viewport.Width),
 		m.tabs.View(),
 		pageView,
-		m.status.View(m.viewport.Width),
+		statusView,
 	)
 }
 
+// pageHelpKeys returns the key bindings of the current tab's page.
+func (m Model) pageHelpKeys() []HelpKey {
+	switch m.currentTab {
+	case TabOverview:
+		return m.overviewPage.HelpKeys()
+	case TabProposals:
+		return m.proposalsPage.HelpKeys()
+	case TabRules:
+		return m.rulesPage.HelpKeys()
+	case TabMaintenance:
+		return m.maintenancePage.HelpKeys()
+	case TabDocs:
+		return m.docsPage.HelpKeys()
+	case TabConfig:
+		return m.configPage.HelpKeys()
+	case TabStats:
+		return m.statsPage.HelpKeys()
+	}
+	return nil
+}
+
 // refreshData refreshes data for all pages.
 func (m *Model) refreshData() {
 	m.overviewPage.LoadData(m.specPath)
@@ -224,6 +283,16 @@ func (m *Model) refreshData() {
 	m.docsPage.LoadData(m.specPath)
 	m.configPage.LoadData(m.specPath)
 	m.statsPage.LoadData(m.specPath)
+	m.header.UpdateActiveProposal(workspace.PrimaryProposal(m.specPath))
+}
+
+// revalidate re-runs validation when the proposals page shows validation
+// results, keeping them in step with edits on disk.
+func (m *Model) revalidate() bubbletea.Cmd {
+	if slug := m.proposalsPage.Validating(); slug != "" {
+		return ValidateProposal(m.specPath, slug)
+	}
+	return nil
 }
 
 // NewModel creates a new TUI model.
@@ -245,7 +314,7 @@ func NewModel(specPath, version string) Model {
 	statsPage := NewStatsPage(specPath)
 
 	// Create watcher
-	watcher, _ := NewWatcher(specPath)
+	watcher, _ := NewWatcher(specPath, watchDebounce(specPath))
 
 	// Create viewport
 	vp := viewport.New(80, 24)
@@ -256,6 +325,7 @@ func NewModel(specPath, version string) Model {
 		tabs:            tabs,
 		header:          header,
 		status:          status,
+		prompt:          NewPrompt(),
 		viewport:        &vp,
 		specPath:        specPath,
 		overviewPage:    overviewPage,
@@ -269,8 +339,12 @@ func NewModel(specPath, version string) Model {
 	}
 }
 
-// Run starts the TUI.
-func Run(specPath, version string) error {
+// Run starts the TUI. editor is the resolved editor command used when
+// opening files; pass "" to fall back to $EDITOR and the default list.
+func Run(specPath, version, editor string, h Hooks) error {
+	editorOverride = editor
+	hooks = h
+
 	// Check if workspace exists
 	if _, err := os.Stat(specPath); os.IsNotExist(err) {
 		return fmt.Errorf("specification workspace not initialized. Run 'nocturnal spec init' first")
@@ -290,24 +364,9 @@ func Run(specPath, version string) error {
 	return nil
 }
 
-// Editor runs external editor (simplified version without tea dependency).
-func EditorRun(path string) error {
-	editor := os.Getenv("EDITOR")
-	if editor == "" {
-		// Try common editors
-		editors := []string{"vim", "nvim", "vi", "nano", "code --wait"}
-		for _, e := range editors {
-			if _, err := exec.LookPath(e); err == nil {
-				editor = e
-				break
-			}
-		}
-	}
-	if editor == "" {
-		editor = "vim"
-	}
-
-	cmd := exec.Command(editor, path)
+// EditorRun opens paths in editor and waits for it to exit (without tea dependency).
+func EditorRun(editor string, paths ...string) error {
+	cmd := EditorCommand(editor, paths...)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
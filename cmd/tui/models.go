@@ -74,3 +74,9 @@ type ErrorMsg struct {
 type SuccessMsg struct {
 	Message string
 }
+
+// ValidationMsg carries the per-document results of validating a proposal.
+type ValidationMsg struct {
+	Slug    string
+	Results []DocumentValidation
+}
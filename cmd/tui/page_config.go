@@ -54,6 +54,11 @@ func (p *ConfigPage) Update(msg interface{}, model *Model) tea.Cmd {
 	return nil
 }
 
+// HelpKeys returns the key bindings handled by the config page.
+func (p *ConfigPage) HelpKeys() []HelpKey {
+	return nil
+}
+
 // View renders the config page.
 func (p *ConfigPage) View() string {
 	style := lipgloss.NewStyle().
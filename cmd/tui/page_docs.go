@@ -123,8 +123,7 @@ func (p *DocsPage) Update(msg interface{}, model *Model) tea.Cmd {
 				// Load doc content
 				docPath := filepath.Join(p.specPath, "third", item.ID+".md")
 				if data, err := os.ReadFile(docPath); err == nil {
-					content := RenderMarkdown(string(data), p.width)
-					p.detail.SetContent(content)
+					p.detail.SetMarkdown(string(data))
 					p.detail.leftList.Select()
 				}
 			}
@@ -142,6 +141,11 @@ func (p *DocsPage) Update(msg interface{}, model *Model) tea.Cmd {
 	return nil
 }
 
+// HelpKeys returns the key bindings handled by the docs page.
+func (p *DocsPage) HelpKeys() []HelpKey {
+	return listHelpKeys
+}
+
 // View renders the docs page.
 func (p *DocsPage) View() string {
 	return p.detail.View(p.width)
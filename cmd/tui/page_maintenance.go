@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
 // MaintenancePage is the maintenance items page.
@@ -52,20 +53,23 @@ func (p *MaintenancePage) LoadData(specPath string) {
 		return
 	}
 
+	state, _ := workspace.LoadState(specPath)
+
 	for _, file := range files {
 		if !file.IsDir() && strings.HasSuffix(file.Name(), ".md") {
 			name := strings.TrimSuffix(file.Name(), ".md")
-
-			// Read first line for subtitle
 			filePath := filepath.Join(maintPath, file.Name())
-			subtitle := ""
-			if data, err := os.ReadFile(filePath); err == nil {
-				lines := strings.Split(string(data), "\n")
-				if len(lines) > 0 {
-					firstLine := strings.TrimPrefix(lines[0], "# ")
-					if len(firstLine) < 80 {
-						subtitle = firstLine
-					}
+
+			status := "pending"
+			var subtitle string
+			reqs, err := workspace.ParseMaintenanceFile(filePath, state, name)
+			if err != nil {
+				subtitle = fmt.Sprintf("Error: %v", err)
+			} else {
+				due := workspace.CountDue(reqs)
+				subtitle = fmt.Sprintf("%d/%d due", due, len(reqs))
+				if due > 0 {
+					status = "due"
 				}
 			}
 
@@ -73,7 +77,7 @@ func (p *MaintenancePage) LoadData(specPath string) {
 				ID:       name,
 				Title:    name,
 				Subtitle: subtitle,
-				Status:   "pending",
+				Status:   status,
 			})
 		}
 	}
@@ -123,8 +127,7 @@ func (p *MaintenancePage) Update(msg interface{}, model *Model) tea.Cmd {
 				// Load maintenance content
 				maintPath := filepath.Join(p.specPath, "maintenance", item.ID+".md")
 				if data, err := os.ReadFile(maintPath); err == nil {
-					content := RenderMarkdown(string(data), p.width)
-					p.detail.SetContent(content)
+					p.detail.SetMarkdown(string(data))
 					p.detail.leftList.Select()
 				}
 			}
@@ -134,6 +137,11 @@ func (p *MaintenancePage) Update(msg interface{}, model *Model) tea.Cmd {
 				maintPath := filepath.Join(p.specPath, "maintenance", item.ID+".md")
 				return OpenEditor(maintPath)
 			}
+		case "n":
+			// Prompt for a name and scaffold from the template
+			model.prompt.Open("New maintenance item name", func(name string) tea.Cmd {
+				return CreateMaintenance(p.specPath, name)
+			})
 		case "esc":
 			// Deselect to go back to list navigation
 			p.detail.leftList.ClearSelection()
@@ -142,6 +150,11 @@ func (p *MaintenancePage) Update(msg interface{}, model *Model) tea.Cmd {
 	return nil
 }
 
+// HelpKeys returns the key bindings handled by the maintenance page.
+func (p *MaintenancePage) HelpKeys() []HelpKey {
+	return append(append([]HelpKey{}, listHelpKeys...), HelpKey{Key: "n", Desc: "New maintenance item"})
+}
+
 // View renders the maintenance page.
 func (p *MaintenancePage) View() string {
 	return p.detail.View(p.width)
@@ -8,6 +8,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
 // OverviewPage is the overview dashboard page.
@@ -101,8 +102,17 @@ func (p *OverviewPage) LoadData(specPath string) {
 	lines = append(lines, fmt.Sprintf("%s %s", labelStyle.Render("Documentation:"), valueStyle.Render(fmt.Sprintf("%d", docCount))))
 	lines = append(lines, "")
 
+	// Overall completion: specs already promoted vs proposals still open
+	if specCount+proposalCount > 0 {
+		lines = append(lines, fmt.Sprintf("%s %s %s",
+			labelStyle.Render("Completion:"),
+			renderProgressBar(specCount, specCount+proposalCount, 20),
+			labelStyle.Render(fmt.Sprintf("%d completed specs, %d open proposals", specCount, proposalCount))))
+		lines = append(lines, "")
+	}
+
 	// Check for active proposal
-	activeSlug := getActiveProposal(specPath)
+	activeSlug := workspace.PrimaryProposal(specPath)
 	if activeSlug != "" {
 		lines = append(lines, titleStyle.Render("📋 Active Proposal"))
 		lines = append(lines, "")
@@ -122,6 +132,18 @@ func (p *OverviewPage) LoadData(specPath string) {
 				}
 			}
 		}
+
+		lines = append(lines, "")
+		total, completed := workspace.ProposalProgress(filepath.Join(specPath, "proposal", activeSlug))
+		if total > 0 {
+			percentage := (completed * 100) / total
+			lines = append(lines, fmt.Sprintf("%s %s %s",
+				labelStyle.Render("Tasks:"),
+				renderProgressBar(completed, total, 20),
+				labelStyle.Render(fmt.Sprintf("%d/%d (%d%%)", completed, total, percentage))))
+		} else {
+			lines = append(lines, fmt.Sprintf("%s %s", labelStyle.Render("Tasks:"), labelStyle.Render("no tasks defined")))
+		}
 	} else {
 		lines = append(lines, titleStyle.Render("📋 No Active Proposal"))
 		lines = append(lines, "")
@@ -142,6 +164,11 @@ func (p *OverviewPage) Update(msg interface{}, model *Model) tea.Cmd {
 	return nil
 }
 
+// HelpKeys returns the key bindings handled by the overview page.
+func (p *OverviewPage) HelpKeys() []HelpKey {
+	return nil
+}
+
 // View renders the overview page.
 func (p *OverviewPage) View() string {
 	style := lipgloss.NewStyle().
@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
 // ProposalsPage is the proposals management page.
@@ -15,6 +19,9 @@ type ProposalsPage struct {
 	detail   *Detail
 	specPath string
 	items    []ListItem
+
+	// validating is the proposal whose validation results are shown, if any
+	validating string
 }
 
 // NewProposalsPage creates a new proposals page.
@@ -51,24 +58,28 @@ func (p *ProposalsPage) LoadData(specPath string) {
 		return
 	}
 
-	// Get active proposal
-	activeSlug := getActiveProposal(specPath)
+	// Active proposals come from the state file, the same source the CLI uses
+	state, err := workspace.LoadState(specPath)
+	if err != nil {
+		state = &workspace.State{}
+	}
 
 	for _, entry := range entries {
 		if entry.IsDir() {
 			slug := entry.Name()
 			status := "pending"
-			if slug == activeSlug {
+			if state.IsProposalActive(slug) {
 				status = "active"
 			}
 
 			// Get proposal path
 			proposalPath := filepath.Join(proposalsPath, slug)
 
-			// Check if implementation.md exists
-			implPath := filepath.Join(proposalPath, "implementation.md")
+			// Prefer dependency info, falling back to implementation.md presence
 			subtitle := ""
-			if _, err := os.Stat(implPath); err == nil {
+			if deps, err := workspace.ProposalDependencies(proposalPath); err == nil && len(deps) > 0 {
+				subtitle = "Depends on: " + strings.Join(deps, ", ")
+			} else if _, err := os.Stat(filepath.Join(proposalPath, "implementation.md")); err == nil {
 				subtitle = "Has implementation.md"
 			}
 
@@ -81,6 +92,28 @@ func (p *ProposalsPage) LoadData(specPath string) {
 		}
 	}
 
+	// Primary proposal first, then other active proposals, then the rest
+	rank := func(item ListItem) int {
+		switch {
+		case item.ID == state.Primary:
+			return 0
+		case item.Status == "active":
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(p.items, func(i, j int) bool {
+		return rank(p.items[i]) < rank(p.items[j])
+	})
+
+	// A renamed or removed proposal's validation results no longer apply
+	if p.validating != "" {
+		if _, err := os.Stat(filepath.Join(proposalsPath, p.validating)); err != nil {
+			p.validating = ""
+		}
+	}
+
 	if len(p.items) == 0 {
 		p.items = append(p.items, ListItem{
 			ID:     "none",
@@ -127,8 +160,8 @@ func (p *ProposalsPage) Update(msg interface{}, model *Model) tea.Cmd {
 				proposalPath := filepath.Join(p.specPath, "proposal", item.ID)
 				implPath := filepath.Join(proposalPath, "implementation.md")
 				if data, err := os.ReadFile(implPath); err == nil {
-					content := RenderMarkdown(string(data), p.width)
-					p.detail.SetContent(content)
+					p.validating = ""
+					p.detail.SetMarkdown(string(data))
 					p.detail.leftList.Select()
 				}
 			}
@@ -142,7 +175,12 @@ func (p *ProposalsPage) Update(msg interface{}, model *Model) tea.Cmd {
 		case "a":
 			// Activate proposal
 			if item := p.detail.Selected(); item != nil && item.ID != "none" && item.ID != "error" {
-				return ActivateProposal(p.specPath, item.ID)
+				return ActivateProposal(p.specPath, item.ID, false)
+			}
+		case "A":
+			// Activate proposal even if dependencies are not completed
+			if item := p.detail.Selected(); item != nil && item.ID != "none" && item.ID != "error" {
+				return ActivateProposal(p.specPath, item.ID, true)
 			}
 		case "c":
 			// Complete proposal
@@ -154,6 +192,14 @@ func (p *ProposalsPage) Update(msg interface{}, model *Model) tea.Cmd {
 			if item := p.detail.Selected(); item != nil && item.ID != "none" && item.ID != "error" {
 				return ValidateProposal(p.specPath, item.ID)
 			}
+		case "R":
+			// Prompt for a new slug; r is the global refresh key
+			if item := p.detail.Selected(); item != nil && item.ID != "none" && item.ID != "error" {
+				slug := item.ID
+				model.prompt.Open(fmt.Sprintf("Rename '%s' to", slug), func(newSlug string) tea.Cmd {
+					return RenameProposal(p.specPath, slug, newSlug)
+				})
+			}
 		case "d":
 			// Delete proposal (no force for safety)
 			if item := p.detail.Selected(); item != nil && item.ID != "none" && item.ID != "error" {
@@ -164,12 +210,82 @@ func (p *ProposalsPage) Update(msg interface{}, model *Model) tea.Cmd {
 			return DeactivateProposal(p.specPath)
 		case "esc":
 			// Deselect to go back to list navigation
+			p.validating = ""
 			p.detail.leftList.ClearSelection()
 		}
 	}
 	return nil
 }
 
+// ShowValidation shows a proposal's validation results in the content panel.
+func (p *ProposalsPage) ShowValidation(slug string, results []DocumentValidation) {
+	p.validating = slug
+	p.detail.SetContent(renderValidation(slug, results))
+	p.detail.leftList.Select()
+}
+
+// Validating returns the proposal whose validation results are shown, or
+// empty if the panel shows something else.
+func (p *ProposalsPage) Validating() string {
+	return p.validating
+}
+
+// renderValidation lists each document with its errors, warnings and
+// waiver notes.
+func renderValidation(slug string, results []DocumentValidation) string {
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+	okStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("11"))
+	errStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+
+	lines := []string{titleStyle.Render(fmt.Sprintf("Validation: %s", slug)), ""}
+	for _, result := range results {
+		switch {
+		case len(result.Errors) > 0:
+			lines = append(lines, errStyle.Render("✗ "+result.Document))
+		case len(result.Warnings) > 0:
+			lines = append(lines, warnStyle.Render("⚠ "+result.Document))
+		default:
+			lines = append(lines, okStyle.Render("✓ "+result.Document))
+		}
+		for _, err := range result.Errors {
+			lines = append(lines, errStyle.Render("    ✗ "+err))
+		}
+		for _, warn := range result.Warnings {
+			lines = append(lines, warnStyle.Render("    ⚠ "+warn))
+		}
+		for _, note := range result.Notes {
+			lines = append(lines, detailDimStyle.Render("    ℹ "+note))
+		}
+	}
+
+	errors, warnings := countValidationIssues(results)
+	lines = append(lines, "", detailDimStyle.Render(fmt.Sprintf("%d error(s), %d warning(s) · r to re-validate · esc to close", errors, warnings)))
+	return strings.Join(lines, "\n")
+}
+
+// countValidationIssues totals the errors and warnings across results.
+func countValidationIssues(results []DocumentValidation) (errors, warnings int) {
+	for _, result := range results {
+		errors += len(result.Errors)
+		warnings += len(result.Warnings)
+	}
+	return errors, warnings
+}
+
+// HelpKeys returns the key bindings handled by the proposals page.
+func (p *ProposalsPage) HelpKeys() []HelpKey {
+	return append(append([]HelpKey{}, listHelpKeys...),
+		HelpKey{Key: "a", Desc: "Activate proposal"},
+		HelpKey{Key: "A", Desc: "Activate despite missing dependencies"},
+		HelpKey{Key: "c", Desc: "Complete proposal"},
+		HelpKey{Key: "v", Desc: "Validate proposal"},
+		HelpKey{Key: "R", Desc: "Rename proposal"},
+		HelpKey{Key: "d", Desc: "Delete proposal"},
+		HelpKey{Key: "x", Desc: "Deactivate primary proposal"},
+	)
+}
+
 // View renders the proposals page.
 func (p *ProposalsPage) View() string {
 	return p.detail.View(p.width)
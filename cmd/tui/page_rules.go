@@ -123,8 +123,7 @@ func (p *RulesPage) Update(msg interface{}, model *Model) tea.Cmd {
 				// Load rule content
 				rulePath := filepath.Join(p.specPath, "rule", item.ID+".md")
 				if data, err := os.ReadFile(rulePath); err == nil {
-					content := RenderMarkdown(string(data), p.width)
-					p.detail.SetContent(content)
+					p.detail.SetMarkdown(string(data))
 					p.detail.leftList.Select()
 				}
 			}
@@ -134,6 +133,11 @@ func (p *RulesPage) Update(msg interface{}, model *Model) tea.Cmd {
 				rulePath := filepath.Join(p.specPath, "rule", item.ID+".md")
 				return OpenEditor(rulePath)
 			}
+		case "n":
+			// Prompt for a name and scaffold from the template
+			model.prompt.Open("New rule name", func(name string) tea.Cmd {
+				return CreateRule(p.specPath, name)
+			})
 		case "esc":
 			// Deselect to go back to list navigation
 			p.detail.leftList.ClearSelection()
@@ -142,6 +146,11 @@ func (p *RulesPage) Update(msg interface{}, model *Model) tea.Cmd {
 	return nil
 }
 
+// HelpKeys returns the key bindings handled by the rules page.
+func (p *RulesPage) HelpKeys() []HelpKey {
+	return append(append([]HelpKey{}, listHelpKeys...), HelpKey{Key: "n", Desc: "New rule"})
+}
+
 // View renders the rules page.
 func (p *RulesPage) View() string {
 	return p.detail.View(p.width)
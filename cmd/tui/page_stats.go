@@ -128,6 +128,11 @@ func (p *StatsPage) Update(msg interface{}, model *Model) tea.Cmd {
 	return nil
 }
 
+// HelpKeys returns the key bindings handled by the stats page.
+func (p *StatsPage) HelpKeys() []HelpKey {
+	return nil
+}
+
 // View renders the stats page.
 func (p *StatsPage) View() string {
 	style := lipgloss.NewStyle().
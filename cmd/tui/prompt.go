@@ -0,0 +1,81 @@
+package tui
+
+import (
+	bubbletea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Prompt is a single-line text input shown in place of the status bar.
+// Pages open it with a label and a submit callback; while it is open the
+// model sends it every key press.
+type Prompt struct {
+	label  string
+	value  []rune
+	active bool
+	submit func(value string) bubbletea.Cmd
+}
+
+// Styles for the prompt.
+var (
+	promptLabelStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(lipgloss.Color("11"))
+
+	promptCursorStyle = lipgloss.NewStyle().
+				Reverse(true)
+)
+
+// NewPrompt creates a closed prompt.
+func NewPrompt() *Prompt {
+	return &Prompt{}
+}
+
+// Open shows the prompt with an empty value. submit is called with the
+// entered text when enter is pressed.
+func (p *Prompt) Open(label string, submit func(value string) bubbletea.Cmd) {
+	p.label = label
+	p.value = nil
+	p.active = true
+	p.submit = submit
+}
+
+// Active reports whether the prompt is open.
+func (p *Prompt) Active() bool {
+	return p.active
+}
+
+// Close hides the prompt without submitting.
+func (p *Prompt) Close() {
+	p.active = false
+	p.value = nil
+	p.submit = nil
+}
+
+// Update handles a key press while the prompt is open.
+func (p *Prompt) Update(msg bubbletea.KeyMsg) bubbletea.Cmd {
+	switch msg.Type {
+	case bubbletea.KeyEsc, bubbletea.KeyCtrlC:
+		p.Close()
+	case bubbletea.KeyEnter:
+		submit, value := p.submit, string(p.value)
+		p.Close()
+		if submit != nil {
+			return submit(value)
+		}
+	case bubbletea.KeyBackspace:
+		if len(p.value) > 0 {
+			p.value = p.value[:len(p.value)-1]
+		}
+	case bubbletea.KeySpace:
+		p.value = append(p.value, ' ')
+	case bubbletea.KeyRunes:
+		p.value = append(p.value, msg.Runes...)
+	}
+	return nil
+}
+
+// View renders the prompt line.
+func (p *Prompt) View(width int) string {
+	line := promptLabelStyle.Render(p.label+": ") + string(p.value) + promptCursorStyle.Render(" ")
+	return statusContainerStyle.Width(width).Render(line)
+}
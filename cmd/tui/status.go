@@ -7,12 +7,30 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
+// How long success and error toasts stay in the status bar.
+const (
+	successDismissDelay = 3 * time.Second
+	errorDismissDelay   = 5 * time.Second
+)
+
 // Status represents status bar at the bottom.
+//
+// Info messages show progress and stay until replaced. Success and error
+// messages are toasts: each is shown for its dismiss delay, and toasts that
+// arrive while another is showing wait in a queue.
 type Status struct {
 	message     string
 	messageType string // "info", "error", "success"
 	showHelp    bool
 	autoDismiss bool
+	queue       []statusMessage
+	seq         int // identifies the message shown, so stale dismissals are ignored
+}
+
+// statusMessage is a toast waiting to be shown.
+type statusMessage struct {
+	text        string
+	messageType string
 }
 
 // Styles for status.
@@ -48,12 +66,41 @@ func NewStatus(keys KeyMap) *Status {
 	}
 }
 
-// SetMessage sets a status message.
+// SetMessage sets a status message immediately, without a dismiss timer.
 func (s *Status) SetMessage(msg string, msgType string) {
+	s.seq++
 	s.message = msg
 	s.messageType = msgType
 }
 
+// Push shows a toast, or queues it behind the toast currently showing. The
+// returned command dismisses it after its delay.
+func (s *Status) Push(msg string, msgType string) bubbletea.Cmd {
+	if s.showingToast() {
+		s.queue = append(s.queue, statusMessage{text: msg, messageType: msgType})
+		return nil
+	}
+	return s.show(statusMessage{text: msg, messageType: msgType})
+}
+
+// showingToast reports whether a success or error message is displayed.
+func (s *Status) showingToast() bool {
+	return s.message != "" && s.messageType != "info"
+}
+
+// show displays a toast and schedules its dismissal.
+func (s *Status) show(toast statusMessage) bubbletea.Cmd {
+	s.SetMessage(toast.text, toast.messageType)
+	if !s.autoDismiss {
+		return nil
+	}
+	delay := successDismissDelay
+	if toast.messageType == "error" {
+		delay = errorDismissDelay
+	}
+	return waitForDismiss(delay, s.seq)
+}
+
 // SetError sets an error message.
 func (s *Status) SetError(msg string) {
 	s.SetMessage(msg, "error")
@@ -69,10 +116,12 @@ func (s *Status) SetInfo(msg string) {
 	s.SetMessage(msg, "info")
 }
 
-// Clear clears the current message.
+// Clear clears the current message and any queued toasts.
 func (s *Status) Clear() {
+	s.seq++
 	s.message = ""
 	s.messageType = ""
+	s.queue = nil
 }
 
 // ToggleHelp toggles help display.
@@ -116,26 +165,32 @@ func (s *Status) Update(msg bubbletea.Msg) bubbletea.Cmd {
 	case ShowHelpMsg:
 		s.showHelp = msg.Show
 	case ErrorMsg:
-		s.SetError(msg.Err.Error())
-		if s.autoDismiss {
-			return waitForDismiss(5)
-		}
+		return s.Push(msg.Err.Error(), "error")
 	case SuccessMsg:
-		s.SetSuccess(msg.Message)
-		if s.autoDismiss {
-			return waitForDismiss(3)
-		}
+		return s.Push(msg.Message, "success")
 	case clearMsg:
-		s.Clear()
+		if msg.seq != s.seq {
+			return nil // the message was already replaced
+		}
+		if len(s.queue) > 0 {
+			next := s.queue[0]
+			s.queue = s.queue[1:]
+			return s.show(next)
+		}
+		s.message = ""
+		s.messageType = ""
 	}
 	return nil
 }
 
-// waitForDismiss creates a command to clear message after duration.
-func waitForDismiss(seconds int) bubbletea.Cmd {
-	return bubbletea.Tick(0, func(t time.Time) bubbletea.Msg {
-		return clearMsg{}
+// waitForDismiss creates a command that dismisses message seq after delay.
+func waitForDismiss(delay time.Duration, seq int) bubbletea.Cmd {
+	return bubbletea.Tick(delay, func(t time.Time) bubbletea.Msg {
+		return clearMsg{seq: seq}
 	})
 }
 
-type clearMsg struct{}
+// clearMsg dismisses the status message identified by seq.
+type clearMsg struct {
+	seq int
+}
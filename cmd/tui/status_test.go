@@ -0,0 +1,66 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitForDismissDelays(t *testing.T) {
+	const delay = 50 * time.Millisecond
+
+	start := time.Now()
+	msg := waitForDismiss(delay, 7)()
+	if elapsed := time.Since(start); elapsed < delay {
+		t.Errorf("dismissed after %v, want at least %v", elapsed, delay)
+	}
+	if msg != (clearMsg{seq: 7}) {
+		t.Errorf("msg = %#v, want clearMsg{seq: 7}", msg)
+	}
+}
+
+func TestStatusQueuesToasts(t *testing.T) {
+	s := NewStatus(DefaultKeyMap())
+
+	if cmd := s.Update(SuccessMsg{Message: "first"}); cmd == nil {
+		t.Fatal("expected a dismiss command for the first toast")
+	}
+	first := s.seq
+	if cmd := s.Update(ErrorMsg{Err: errors.New("second")}); cmd != nil {
+		t.Error("expected the second toast to be queued")
+	}
+	if s.message != "first" {
+		t.Fatalf("message = %q, want first", s.message)
+	}
+
+	// Dismissing the first toast shows the queued one
+	if cmd := s.Update(clearMsg{seq: first}); cmd == nil {
+		t.Error("expected a dismiss command for the queued toast")
+	}
+	if s.message != "second" || s.messageType != "error" {
+		t.Fatalf("message = %q (%s), want second (error)", s.message, s.messageType)
+	}
+
+	// A stale dismissal leaves the current toast alone
+	s.Update(clearMsg{seq: first})
+	if s.message != "second" {
+		t.Errorf("stale dismissal cleared %q", s.message)
+	}
+
+	s.Update(clearMsg{seq: s.seq})
+	if s.message != "" {
+		t.Errorf("message = %q after final dismissal, want empty", s.message)
+	}
+}
+
+func TestStatusToastReplacesProgress(t *testing.T) {
+	s := NewStatus(DefaultKeyMap())
+	s.SetInfo("Refreshing...")
+
+	if cmd := s.Push("Done", "success"); cmd == nil {
+		t.Fatal("expected the toast to be shown, not queued")
+	}
+	if s.message != "Done" {
+		t.Errorf("message = %q, want Done", s.message)
+	}
+}
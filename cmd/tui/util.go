@@ -1,78 +1,30 @@
 package tui
 
 import (
-	"encoding/json"
-	"os"
-	"path/filepath"
-)
-
-// State represents the nocturnal state file.
-type State struct {
-	Version      int                                    `json:"version"`
-	Active       []string                               `json:"active"`
-	Primary      string                                 `json:"primary"`
-	Hashes       map[string]map[string]string           `json:"hashes,omitempty"`
-	Maintenance  map[string]map[string]MaintenanceState `json:"maintenance,omitempty"`
-	GitSnapshots map[string]GitSnapshotState            `json:"git_snapshots,omitempty"`
-}
-
-// GitSnapshotState tracks git snapshots for task execution
-type GitSnapshotState struct {
-	SnapshotRef string `json:"snapshot_ref,omitempty"` // Git ref at snapshot time
-	TaskID      string `json:"task_id"`
-	Timestamp   string `json:"timestamp"` // RFC3339 timestamp
-}
-
-// MaintenanceState tracks when a maintenance requirement was last actioned.
-type MaintenanceState struct {
-	LastActioned string `json:"last_actioned"` // RFC3339 timestamp
-}
-
-// loadState reads the state file and returns active proposals.
-func loadState(specPath string) (*State, error) {
-	statePath := filepath.Join(specPath, ".nocturnal.json")
-	data, err := os.ReadFile(statePath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return &State{
-				Version:     1,
-				Active:      []string{},
-				Hashes:      make(map[string]map[string]string),
-				Maintenance: make(map[string]map[string]MaintenanceState),
-			}, nil
-		}
-		return nil, err
-	}
+	"strings"
 
-	var state State
-	if err := json.Unmarshal(data, &state); err != nil {
-		return nil, err
-	}
-
-	if state.Hashes == nil {
-		state.Hashes = make(map[string]map[string]string)
-	}
-	if state.Maintenance == nil {
-		state.Maintenance = make(map[string]map[string]MaintenanceState)
-	}
+	"github.com/charmbracelet/lipgloss"
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
 
-	return &state, nil
+// progressColors are the progress bar fill colors of each progress level,
+// matching the CLI's red, yellow, and green.
+var progressColors = map[workspace.ProgressLevel]lipgloss.Color{
+	workspace.ProgressLow:    lipgloss.Color("9"),
+	workspace.ProgressMedium: lipgloss.Color("11"),
+	workspace.ProgressHigh:   lipgloss.Color("10"),
 }
 
-// getActiveProposal returns the primary active proposal slug.
-func getActiveProposal(specPath string) string {
-	state, err := loadState(specPath)
-	if err != nil {
-		return ""
-	}
-
-	if state.Primary != "" {
-		return state.Primary
+// renderProgressBar renders a fixed-width task progress bar.
+func renderProgressBar(completed, total, width int) string {
+	dim := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	if total == 0 {
+		return dim.Render("[" + strings.Repeat("-", width) + "]")
 	}
 
-	if len(state.Active) > 0 {
-		return state.Active[0]
-	}
+	filled := (completed * width) / total
+	empty := width - filled
 
-	return ""
+	bar := lipgloss.NewStyle().Foreground(progressColors[workspace.ClassifyProgress(completed, total)]).Render(strings.Repeat("█", filled)) + dim.Render(strings.Repeat("░", empty))
+	return "[" + bar + "]"
 }
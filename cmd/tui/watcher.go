@@ -1,86 +1,120 @@
 package tui
 
 import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	bubbletea "github.com/charmbracelet/bubbletea"
 	"github.com/fsnotify/fsnotify"
 )
 
+// DefaultWatchDebounce is how long the watcher waits after a change for
+// further changes before refreshing, when nocturnal.yaml does not set
+// ui.watch_debounce_ms.
+const DefaultWatchDebounce = 500 * time.Millisecond
+
+// FileChangedMsg is sent by the watcher when files under the spec directory
+// change. The model refreshes and resumes watching.
+type FileChangedMsg struct{}
+
+// WatchErrorMsg is sent by the watcher when fsnotify reports an error.
+type WatchErrorMsg struct {
+	Err error
+}
+
 // Watcher monitors the spec directory for file changes.
 type Watcher struct {
-	watcher    *fsnotify.Watcher
-	specPath   string
-	debounceMs time.Duration
-	lastChange time.Time
+	watcher  *fsnotify.Watcher
+	specPath string
+	debounce time.Duration
 }
 
-// NewWatcher creates a new file system watcher.
-func NewWatcher(specPath string) (*Watcher, error) {
+// NewWatcher creates a new file system watcher. A zero debounce uses
+// DefaultWatchDebounce.
+func NewWatcher(specPath string, debounce time.Duration) (*Watcher, error) {
 	fsWatcher, err := fsnotify.NewWatcher()
 	if err != nil {
 		return nil, err
 	}
+	if debounce <= 0 {
+		debounce = DefaultWatchDebounce
+	}
 
 	return &Watcher{
-		watcher:    fsWatcher,
-		specPath:   specPath,
-		debounceMs: 500 * time.Millisecond,
+		watcher:  fsWatcher,
+		specPath: filepath.Clean(specPath),
+		debounce: debounce,
 	}, nil
 }
 
-// Start returns a tea.Cmd that begins watching for file changes.
+// Start watches the spec directory and every directory beneath it, then
+// returns a tea.Cmd that waits for the next change. Watching the root
+// rather than .nocturnal.json and nocturnal.yaml themselves catches editors
+// and state writes that replace the file, which many platforms only report
+// on the parent directory.
 func (w *Watcher) Start() bubbletea.Cmd {
-	// Add directories to watch
-	dirs := []string{
-		"proposal",
-		"rule",
-		"maintenance",
-		"section",
-		"third",
-	}
-
-	for _, dir := range dirs {
-		path := w.specPath + "/" + dir
-		if err := w.watcher.Add(path); err != nil {
-			// Directory might not exist, that's OK
-			continue
+	_ = filepath.WalkDir(w.specPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Unreadable directories are skipped, not fatal
+			return nil
 		}
-	}
-
-	// Watch state file and config file
-	if err := w.watcher.Add(w.specPath + "/.nocturnal.json"); err == nil {
-		// ignore error
-	}
-	if err := w.watcher.Add(w.specPath + "/nocturnal.yaml"); err == nil {
-		// ignore error
-	}
+		if d.IsDir() {
+			_ = w.watcher.Add(path)
+		}
+		return nil
+	})
+	return w.Listen()
+}
 
+// Listen returns a tea.Cmd that blocks until files under the spec
+// directory change, then waits out the debounce so a burst of writes
+// produces a single FileChangedMsg.
+func (w *Watcher) Listen() bubbletea.Cmd {
 	return func() bubbletea.Msg {
-		select {
-		case event, ok := <-w.watcher.Events:
-			if !ok {
-				return nil
-			}
-			// Filter for write and create events
-			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) != 0 {
-				// Debounce
-				now := time.Now()
-				if now.Sub(w.lastChange) > w.debounceMs {
-					w.lastChange = now
-					return RefreshMsg{}
+		var settled <-chan time.Time
+		for {
+			select {
+			case event, ok := <-w.watcher.Events:
+				if !ok {
+					return nil
 				}
+				if !w.relevant(event) {
+					continue
+				}
+				if event.Op&fsnotify.Create != 0 {
+					// New proposal directories need their own watch
+					if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+						_ = w.watcher.Add(event.Name)
+					}
+				}
+				settled = time.After(w.debounce)
+			case <-settled:
+				return FileChangedMsg{}
+			case err, ok := <-w.watcher.Errors:
+				if !ok {
+					return nil
+				}
+				return WatchErrorMsg{Err: err}
 			}
-		case err, ok := <-w.watcher.Errors:
-			if !ok {
-				return nil
-			}
-			return ErrorMsg{Err: err}
 		}
-		return nil
 	}
 }
 
+// relevant reports whether event changes a path under the spec directory.
+func (w *Watcher) relevant(event fsnotify.Event) bool {
+	if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) == 0 {
+		return false
+	}
+	rel, err := filepath.Rel(w.specPath, event.Name)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
 // Close stops the watcher.
 func (w *Watcher) Close() error {
 	if w.watcher != nil {
@@ -0,0 +1,95 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	bubbletea "github.com/charmbracelet/bubbletea"
+	"github.com/fsnotify/fsnotify"
+)
+
+// nextWatchMsg runs the watcher's command, failing if it does not return
+// within timeout.
+func nextWatchMsg(t *testing.T, cmd bubbletea.Cmd, timeout time.Duration) bubbletea.Msg {
+	t.Helper()
+	done := make(chan bubbletea.Msg, 1)
+	go func() { done <- cmd() }()
+	select {
+	case msg := <-done:
+		return msg
+	case <-time.After(timeout):
+		t.Fatalf("no watcher message within %v", timeout)
+		return nil
+	}
+}
+
+func TestWatcherConfigChangeRefreshes(t *testing.T) {
+	specPath := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(specPath, "proposal", "feature"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	configPath := filepath.Join(specPath, "nocturnal.yaml")
+	if err := os.WriteFile(configPath, []byte("ui:\n  editor: vim\n"), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	w, err := NewWatcher(specPath, 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewWatcher: %v", err)
+	}
+	t.Cleanup(func() { _ = w.Close() })
+
+	listen := w.Start()
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = os.WriteFile(configPath, []byte("ui:\n  editor: nano\n"), 0o644)
+	}()
+	if msg := nextWatchMsg(t, listen, 5*time.Second); msg != (FileChangedMsg{}) {
+		t.Fatalf("msg = %#v, want FileChangedMsg after a config change", msg)
+	}
+
+	// Nested proposal documents are watched too
+	listen = w.Listen()
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = os.WriteFile(filepath.Join(specPath, "proposal", "feature", "design.md"), []byte("# Design\n"), 0o644)
+	}()
+	if msg := nextWatchMsg(t, listen, 5*time.Second); msg != (FileChangedMsg{}) {
+		t.Fatalf("msg = %#v, want FileChangedMsg after a proposal change", msg)
+	}
+}
+
+func TestWatcherRelevant(t *testing.T) {
+	specPath := t.TempDir()
+	w := &Watcher{specPath: specPath}
+
+	tests := []struct {
+		name  string
+		event fsnotify.Event
+		want  bool
+	}{
+		{name: "config write", event: fsnotify.Event{Name: filepath.Join(specPath, "nocturnal.yaml"), Op: fsnotify.Write}, want: true},
+		{name: "nested create", event: fsnotify.Event{Name: filepath.Join(specPath, "proposal", "x"), Op: fsnotify.Create}, want: true},
+		{name: "chmod only", event: fsnotify.Event{Name: filepath.Join(specPath, "nocturnal.yaml"), Op: fsnotify.Chmod}},
+		{name: "outside spec", event: fsnotify.Event{Name: filepath.Join(filepath.Dir(specPath), "other.md"), Op: fsnotify.Write}},
+	}
+
+	for _, tt := range tests {
+		if got := w.relevant(tt.event); got != tt.want {
+			t.Errorf("%s: relevant = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewWatcherDefaultDebounce(t *testing.T) {
+	w, err := NewWatcher(t.TempDir(), 0)
+	if err != nil {
+		t.Fatalf("NewWatcher: %v", err)
+	}
+	defer w.Close()
+	if w.debounce != DefaultWatchDebounce {
+		t.Errorf("debounce = %v, want %v", w.debounce, DefaultWatchDebounce)
+	}
+}
@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"encoding/csv"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -23,7 +26,32 @@ var (
 	topicStyle   = lipgloss.NewStyle().Foreground(colorBlue).Bold(true)
 )
 
+// quiet suppresses success, info, and dim messages; set by the --quiet flag.
+// Errors, warnings, and command output are always printed.
+var quiet bool
+
+// verbosity is the number of times --verbose was given. At 1 or more, debugf
+// traces each significant step to stderr.
+var verbosity int
+
+func init() {
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Only print errors, warnings, and command output")
+	rootCmd.PersistentFlags().CountVarP(&verbosity, "verbose", "v", "Log each step (paths read, state, dependencies, git) to stderr")
+}
+
+// debugf writes a trace line to stderr when --verbose is set. It goes to
+// stderr so that tracing never mixes with output meant for pipes or JSON.
+func debugf(format string, args ...any) {
+	if verbosity < 1 {
+		return
+	}
+	fmt.Fprintln(os.Stderr, dimStyle.Render("debug: "+fmt.Sprintf(format, args...)))
+}
+
 func printSuccess(msg string) {
+	if quiet {
+		return
+	}
 	fmt.Println(successStyle.Render(msg))
 }
 
@@ -36,9 +64,80 @@ func printWarning(msg string) {
 }
 
 func printInfo(msg string) {
+	if quiet {
+		return
+	}
 	fmt.Println(infoStyle.Render(msg))
 }
 
 func printDim(msg string) {
+	if quiet {
+		return
+	}
 	fmt.Println(dimStyle.Render(msg))
 }
+
+// listFormats are the output formats accepted by --format on list commands.
+var listFormats = []string{"text", "csv"}
+
+// checkListFormat prints an error and returns false if format is not one of
+// listFormats.
+func checkListFormat(format string) bool {
+	if contains(listFormats, format) {
+		return true
+	}
+	printError(fmt.Sprintf("Unknown format '%s' (use %s)", format, strings.Join(listFormats, " or ")))
+	return false
+}
+
+// printCSV writes a header row followed by rows as CSV to stdout. Fields
+// containing commas, quotes, or newlines are quoted.
+func printCSV(header []string, rows [][]string) {
+	w := csv.NewWriter(os.Stdout)
+	_ = w.Write(header)
+	_ = w.WriteAll(rows)
+	if err := w.Error(); err != nil {
+		printError(fmt.Sprintf("Failed to write CSV: %v", err))
+	}
+}
+
+// renderTable lays out rows as left-aligned columns, indented and separated
+// by two spaces. Widths are measured with lipgloss.Width so that styled cells
+// pad to their visible width rather than their byte length. A non-nil header
+// is rendered dim and followed by a blank line.
+func renderTable(header []string, rows [][]string) string {
+	all := rows
+	if header != nil {
+		styled := make([]string, len(header))
+		for i, cell := range header {
+			styled[i] = dimStyle.Render(cell)
+		}
+		all = append([][]string{styled}, rows...)
+	}
+
+	var widths []int
+	for _, row := range all {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], lipgloss.Width(cell))
+		}
+	}
+
+	var buf strings.Builder
+	for r, row := range all {
+		buf.WriteString("  ")
+		for i, cell := range row {
+			buf.WriteString(cell)
+			if i < len(row)-1 {
+				buf.WriteString(strings.Repeat(" ", widths[i]-lipgloss.Width(cell)+2))
+			}
+		}
+		buf.WriteString("\n")
+		if header != nil && r == 0 {
+			buf.WriteString("\n")
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+// captureStdout returns everything fn prints to stdout.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	return captureFile(t, &os.Stdout, fn)
+}
+
+// captureStderr returns everything fn prints to stderr.
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+	return captureFile(t, &os.Stderr, fn)
+}
+
+// captureFile temporarily replaces *f with a pipe while fn runs and returns
+// what was written to it.
+func captureFile(t *testing.T, f **os.File, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("pipe: %v", err)
+	}
+	orig := *f
+	*f = w
+	defer func() { *f = orig }()
+
+	fn()
+
+	w.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	return string(out)
+}
+
+func TestRenderTablePadsStyledCells(t *testing.T) {
+	// Raw escape codes, since lipgloss drops styling when not on a terminal
+	red := func(s string) string { return "\x1b[31m" + s + "\x1b[0m" }
+
+	out := renderTable(nil, [][]string{
+		{red("active"), "first"},
+		{"inactive", "second"},
+		{red("x"), red("third")},
+	})
+
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d:\n%s", len(lines), out)
+	}
+
+	want := []string{
+		"  " + red("active") + "    first",
+		"  inactive  second",
+		"  " + red("x") + "         " + red("third"),
+	}
+	for i, line := range lines {
+		if line != want[i] {
+			t.Errorf("line %d = %q, want %q", i, line, want[i])
+		}
+	}
+}
+
+func TestRenderTableHeader(t *testing.T) {
+	out := renderTable([]string{"NAME", "STATUS"}, [][]string{{"long-proposal-name", "active"}})
+
+	lines := strings.Split(out, "\n")
+	if len(lines) < 3 || lines[1] != "" {
+		t.Fatalf("expected header followed by a blank line:\n%q", out)
+	}
+	if !strings.HasPrefix(lines[2], "  long-proposal-name  active") {
+		t.Errorf("unexpected row: %q", lines[2])
+	}
+	if idx := strings.Index(lines[0], "STATUS"); idx != strings.Index(lines[2], "active") {
+		t.Errorf("header and row columns misaligned:\n%s", out)
+	}
+}
+
+func TestQuietSuppressesChatter(t *testing.T) {
+	quiet = true
+	t.Cleanup(func() { quiet = false })
+
+	out := captureStdout(t, func() {
+		printDim("dim note")
+		printInfo("info note")
+		printSuccess("done")
+		printWarning("careful")
+		printError("failed")
+	})
+
+	for _, absent := range []string{"dim note", "info note", "done"} {
+		if strings.Contains(out, absent) {
+			t.Errorf("expected %q to be suppressed under --quiet, got:\n%s", absent, out)
+		}
+	}
+	for _, present := range []string{"careful", "failed"} {
+		if !strings.Contains(out, present) {
+			t.Errorf("expected %q to be printed under --quiet, got:\n%s", present, out)
+		}
+	}
+}
+
+func TestVerboseTracesStatePath(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(specPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	origVerbosity := verbosity
+	t.Cleanup(func() { verbosity = origVerbosity })
+
+	load := func() {
+		if _, err := loadState(specPath); err != nil {
+			t.Fatalf("loadState: %v", err)
+		}
+	}
+
+	verbosity = 0
+	if out := captureStderr(t, load); out != "" {
+		t.Errorf("expected no trace output without --verbose, got:\n%s", out)
+	}
+
+	verbosity = 1
+	out := captureStderr(t, load)
+	if !strings.Contains(out, workspace.StatePath(specPath)) {
+		t.Errorf("expected verbose output to mention %s, got:\n%s", workspace.StatePath(specPath), out)
+	}
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// unstartedPlaceholderRatio is the share of a proposal's content lines that
+// must still be template placeholders for it to count as unstarted.
+const unstartedPlaceholderRatio = 0.6
+
+// proposalResultDocument is the Document of validation results about the
+// proposal as a whole rather than one of its files.
+const proposalResultDocument = "proposal"
+
+// countPlaceholderLines returns how many of content's non-blank, non-heading
+// lines are template placeholders, and how many such lines there are. A
+// placeholder line holds only template comment text, including lines inside
+// a multi-line comment, or is a "**Field**:" whose value is only a comment.
+func countPlaceholderLines(content string) (placeholders, total int) {
+	inComment := false
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || (!inComment && strings.HasPrefix(trimmed, "#")) {
+			continue
+		}
+		total++
+
+		hadComment := inComment || strings.Contains(trimmed, "<!--")
+		var rest strings.Builder
+		for trimmed != "" {
+			if inComment {
+				_, after, closed := strings.Cut(trimmed, "-->")
+				if !closed {
+					trimmed = ""
+					break
+				}
+				inComment = false
+				trimmed = after
+				continue
+			}
+			before, after, opened := strings.Cut(trimmed, "<!--")
+			rest.WriteString(before)
+			if !opened {
+				break
+			}
+			inComment = true
+			trimmed = after
+		}
+
+		remainder := strings.TrimSpace(rest.String())
+		if match := scaffoldFieldPattern.FindStringSubmatch(remainder); match != nil && strings.TrimSpace(match[2]) == "" {
+			remainder = ""
+		}
+		if hadComment && remainder == "" {
+			placeholders++
+		}
+	}
+	return placeholders, total
+}
+
+// checkUnstartedProposal replaces the warnings of a proposal that is still
+// mostly template placeholders with a single "unstarted" warning, so a
+// proposal that has not been written yet is not reported as dozens of
+// separate gaps. Errors are kept.
+func checkUnstartedProposal(specPath, proposalPath string, results []ValidationResult) []ValidationResult {
+	placeholders, total := 0, 0
+	for _, doc := range proposalDocuments(specPath) {
+		content, err := os.ReadFile(filepath.Join(proposalPath, doc.File))
+		if err != nil {
+			continue
+		}
+		p, n := countPlaceholderLines(string(content))
+		placeholders += p
+		total += n
+	}
+	if total == 0 || float64(placeholders)/float64(total) < unstartedPlaceholderRatio {
+		return results
+	}
+
+	for i := range results {
+		results[i].Warnings = nil
+	}
+	return append(results, ValidationResult{
+		Document: proposalResultDocument,
+		Warnings: []string{fmt.Sprintf("Proposal appears unstarted: %d of %d content lines are template placeholders - fill in the documents before reviewing section warnings", placeholders, total)},
+	})
+}
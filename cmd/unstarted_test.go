@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCountPlaceholderLines(t *testing.T) {
+	t.Parallel()
+
+	content := "# Title\n\n**Goal**: <!-- what -->\n**Status**: Draft\n<!-- one line -->\n<!-- spans\n     lines -->\n" +
+		"Written text. <!-- a note -->\n- [ ] Task 1\n"
+	placeholders, total := countPlaceholderLines(content)
+	if placeholders != 4 || total != 7 {
+		t.Errorf("countPlaceholderLines = %d/%d, want 4/7", placeholders, total)
+	}
+}
+
+func TestValidateUnstartedProposal(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+	proposalPath := filepath.Join(specPath, proposalDir, "oauth-login")
+
+	// A pristine scaffold gets one warning instead of one per placeholder
+	var warnings []string
+	for _, result := range validateProposalDocuments(specPath, proposalPath) {
+		for _, warning := range result.Warnings {
+			warnings = append(warnings, result.Document+": "+warning)
+		}
+	}
+	if len(warnings) != 1 || !strings.HasPrefix(warnings[0], "proposal: Proposal appears unstarted") {
+		t.Fatalf("pristine proposal warnings = %q, want a single unstarted warning", warnings)
+	}
+
+	docs := map[string]string{
+		"specification.md": "# OAuth Login\n\n**Depends on**: none\n**Affected files**: cmd/login.go\n\n## Abstract\n\nThis specification adds OAuth login.\n\n" +
+			"## Introduction\n\nUsers sign in with their identity provider.\n\n## Requirements\n\nThe system MUST support OAuth.\n",
+		"design.md": "# Design: OAuth Login\n**Specification Reference**: [specification.md](specification.md)\n**Status**: Draft\n\n" +
+			"## Context\n\nLogin is password only.\n\n## Goals and Non-Goals\n\nAdd OAuth.\n\n## Options Considered\n\n" +
+			"### Option 1: Library\n\nUse a library.\n\n### Option 2: Custom\n\nWrite it.\n\n## Decision\n\nOption 1.\n\n" +
+			"## Detailed Design\n\nA callback handler.\n\n## Cross-Cutting Concerns\n\nTokens are encrypted.\n\n## Implementation Plan\n\nOne phase.\n",
+		"implementation.md": "# Implementation: OAuth Login\n\n## Phases\n\n### Phase 1: Login\n\n**Goal**: Sign in with OAuth\n\n" +
+			"- [ ] Add callback handler {#callback}\n\n**Milestone**: A user can sign in\n<!-- Add a second phase once the first ships -->\n",
+	}
+	for name, content := range docs {
+		if err := os.WriteFile(filepath.Join(proposalPath, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	// A written proposal keeps its individual warnings
+	results := validateProposalDocuments(specPath, proposalPath)
+	var sawComments bool
+	for _, result := range results {
+		if result.Document == "proposal" {
+			t.Errorf("filled proposal reported as unstarted: %v", result.Warnings)
+		}
+		for _, warning := range result.Warnings {
+			if result.Document == "implementation.md" && warning == "Document contains unfilled template comments" {
+				sawComments = true
+			}
+		}
+	}
+	if !sawComments {
+		t.Errorf("filled proposal lost its individual warnings: %+v", results)
+	}
+}
@@ -6,16 +6,9 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
-)
 
-// copyFile copies a file from src to dst with 0644 permissions.
-func copyFile(src, dst string) error {
-	content, err := os.ReadFile(src)
-	if err != nil {
-		return err
-	}
-	return os.WriteFile(dst, content, 0644)
-}
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
 
 // listMarkdownFiles returns sorted .md filenames in a directory.
 func listMarkdownFiles(dirPath string) ([]string, error) {
@@ -34,12 +27,6 @@ func listMarkdownFiles(dirPath string) ([]string, error) {
 	return files, nil
 }
 
-// fileExists returns true if the path exists.
-func fileExists(path string) bool {
-	_, err := os.Stat(path)
-	return err == nil
-}
-
 // cwdPath joins path elements with the current working directory.
 func cwdPath(elem ...string) string {
 	cwd, err := os.Getwd()
@@ -51,36 +38,73 @@ func cwdPath(elem ...string) string {
 }
 
 const (
-	specDir        = "spec"
+	defaultSpecDir = "spec"
 	ruleDir        = "rule"
 	proposalDir    = "proposal"
 	archiveDir     = "archive"
 	sectionDir     = "section"
 	maintenanceDir = "maintenance"
+	docsDir        = "third"
 	projectFile    = "project.md"
 	agentsFile     = "AGENTS.md"
 )
 
-var proposalDocFiles = []string{"specification.md", "design.md", "implementation.md"}
+// specDir is the workspace directory, relative to the working directory.
+// It is set from --dir or NOCTURNAL_DIR by applySpecDir.
+var specDir = defaultSpecDir
 
 // getSpecPath returns the path to the spec/ directory.
 func getSpecPath() string {
 	return cwdPath(specDir)
 }
 
+// resolveSpecDir returns the workspace directory to use: flag if set, then
+// the NOCTURNAL_DIR environment variable, then "spec". The directory must
+// be relative and stay within the working directory.
+func resolveSpecDir(flag string) (string, error) {
+	dir := flag
+	if dir == "" {
+		dir = os.Getenv("NOCTURNAL_DIR")
+	}
+	if dir == "" {
+		return defaultSpecDir, nil
+	}
+
+	cleaned := filepath.Clean(dir)
+	if filepath.IsAbs(cleaned) || cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid workspace directory '%s': must be a relative path inside the current directory", dir)
+	}
+	return cleaned, nil
+}
+
+// applySpecDir sets the workspace directory from flag or NOCTURNAL_DIR and
+// updates the paths derived from it.
+func applySpecDir(flag string) error {
+	dir, err := resolveSpecDir(flag)
+	if err != nil {
+		return err
+	}
+	specDir = dir
+	docsPath = filepath.Join(getSpecPath(), docsDir)
+	debugf("workspace directory: %s", specDir)
+	return nil
+}
+
 // checkSpecWorkspace returns the spec path or an error if not initialized.
 func checkSpecWorkspace() (string, error) {
 	specPath := getSpecPath()
-	if !fileExists(specPath) {
+	if !workspace.FileExists(specPath) {
+		debugf("no workspace at %s", specPath)
 		return "", fmt.Errorf("specification workspace not initialized. Run 'nocturnal spec init' first")
 	}
+	debugf("using workspace %s", specPath)
 	return specPath, nil
 }
 
 // checkProposal returns the proposal path or an error if it doesn't exist.
 func checkProposal(specPath, slug string) (string, error) {
 	proposalPath := filepath.Join(specPath, proposalDir, slug)
-	if !fileExists(proposalPath) {
+	if !workspace.FileExists(proposalPath) {
 		return "", fmt.Errorf("proposal '%s' does not exist", slug)
 	}
 	return proposalPath, nil
@@ -110,24 +134,6 @@ func clearActiveProposalIfMatches(specPath, slug string) {
 	_ = clearProposalIfMatches(specPath, slug)
 }
 
-// archiveProposalDocs copies proposal documents to the archive directory
-func archiveProposalDocs(proposalPath, archivePath string, files []string) error {
-	if err := os.MkdirAll(archivePath, 0755); err != nil {
-		return fmt.Errorf("failed to create archive directory: %w", err)
-	}
-
-	for _, filename := range files {
-		src := filepath.Join(proposalPath, filename)
-		if fileExists(src) {
-			dst := filepath.Join(archivePath, filename)
-			if err := copyFile(src, dst); err != nil {
-				return fmt.Errorf("failed to archive %s: %w", filename, err)
-			}
-		}
-	}
-	return nil
-}
-
 // getContentPreview returns the first line of content, truncated to 60 chars.
 func getContentPreview(content string) string {
 	preview := content
@@ -164,86 +170,45 @@ func nameToSlug(name string) string {
 
 // containsHeaderWithText checks if content has a markdown header containing the given text (case-insensitive)
 func containsHeaderWithText(content, text string) bool {
+	return len(headerLinesWithText(content, text)) > 0
+}
+
+// headerLinesWithText returns the 1-based line numbers of every markdown
+// header containing the given text (case-insensitive).
+func headerLinesWithText(content, text string) []int {
 	lowerText := strings.ToLower(text)
-	for _, line := range strings.Split(content, "\n") {
+	var lines []int
+	for i, line := range strings.Split(content, "\n") {
 		trimmed := strings.TrimSpace(line)
 		if strings.HasPrefix(trimmed, "#") {
 			if strings.Contains(strings.ToLower(trimmed), lowerText) {
-				return true
+				lines = append(lines, i+1)
 			}
 		}
 	}
-	return false
+	return lines
 }
 
-// getProposalDependencies reads the specification.md file and extracts the "Depends on" field
-func getProposalDependencies(proposalPath string) ([]string, error) {
-	specPath := filepath.Join(proposalPath, "specification.md")
-	content, err := os.ReadFile(specPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, nil
-		}
-		return nil, fmt.Errorf("failed to read specification.md: %w", err)
-	}
-
-	return parseDependsOn(string(content)), nil
-}
-
-// parseDependsOn extracts dependencies from the "**Depends on**:" field in content
-func parseDependsOn(content string) []string {
-	lines := strings.Split(content, "\n")
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-		// Match "**Depends on**:" or "Depends on:" (case-insensitive)
-		lower := strings.ToLower(trimmed)
-		if strings.HasPrefix(lower, "**depends on**:") || strings.HasPrefix(lower, "depends on:") {
-			// Extract the value after the colon
-			idx := strings.Index(trimmed, ":")
-			if idx == -1 {
-				continue
-			}
-			value := strings.TrimSpace(trimmed[idx+1:])
-			// Remove any trailing comments
-			if commentIdx := strings.Index(value, "<!--"); commentIdx != -1 {
-				value = strings.TrimSpace(value[:commentIdx])
-			}
-			// Skip if empty, "none", or still contains template placeholder
-			if value == "" || strings.ToLower(value) == "none" || strings.Contains(value, "<!--") {
-				return nil
-			}
-			// Parse comma-separated list
-			var deps []string
-			for _, dep := range strings.Split(value, ",") {
-				dep = strings.TrimSpace(dep)
-				if dep != "" {
-					deps = append(deps, dep)
-				}
-			}
-			return deps
-		}
+// headerTitle returns a header line's text without the leading hashes or a
+// section number such as "5." so "## 5. Requirements" becomes "Requirements".
+func headerTitle(line string) string {
+	title := strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#"))
+	if number, rest, found := strings.Cut(title, " "); found && strings.HasSuffix(number, ".") &&
+		strings.Trim(number, "0123456789.") == "" {
+		title = strings.TrimSpace(rest)
 	}
-	return nil
+	return title
 }
 
 // getMissingCompletedDependencies returns dependencies that are not completed.
 // A dependency is considered completed when it exists in spec/section/<dep>.md.
+// The check is shared with the TUI so both apply the same activation policy.
 func getMissingCompletedDependencies(specPath, proposalPath string) ([]string, error) {
-	deps, err := getProposalDependencies(proposalPath)
-	if err != nil {
-		return nil, err
+	missing, err := workspace.MissingCompletedDependencies(specPath, proposalPath)
+	if err == nil {
+		debugf("dependencies of %s: %d not completed %v", filepath.Base(proposalPath), len(missing), missing)
 	}
-
-	var missing []string
-	for _, dep := range deps {
-		completedSpecPath := filepath.Join(specPath, sectionDir, dep+".md")
-		if !fileExists(completedSpecPath) {
-			missing = append(missing, dep)
-		}
-	}
-
-	sort.Strings(missing)
-	return missing, nil
+	return missing, err
 }
 
 // getAffectedFiles reads the specification.md file and extracts the "Affected files" field
@@ -316,5 +281,6 @@ func readAffectedFileContent(filePath string, maxLines int) (string, bool, error
 // isGitRepo checks if the current directory is a git repository
 func isGitRepo() bool {
 	_, err := os.Stat(".git")
+	debugf("git repository in working directory: %v", err == nil)
 	return err == nil
 }
@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
 )
 
 func TestNameToSlug(t *testing.T) {
@@ -33,48 +35,37 @@ func TestNameToSlug(t *testing.T) {
 	}
 }
 
-func TestParseDependsOn(t *testing.T) {
+func TestHeaderLinesWithText(t *testing.T) {
+	t.Parallel()
+
+	content := "# Spec\n\n## 2. Requirements Notation\n\nRequirements text\n\n## 5. Requirements\n\n### requirements\n"
+
+	if got, want := headerLinesWithText(content, "Requirements"), []int{3, 7, 9}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("headerLinesWithText = %v, want %v", got, want)
+	}
+	if got := headerLinesWithText(content, "Abstract"); got != nil {
+		t.Fatalf("headerLinesWithText(Abstract) = %v, want nil", got)
+	}
+}
+
+func TestHeaderTitle(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name    string
-		content string
-		want    []string
+		in   string
+		want string
 	}{
-		{
-			name:    "markdown_field_bold",
-			content: "# X\n\n**Depends on**: auth, rate-limiting\n",
-			want:    []string{"auth", "rate-limiting"},
-		},
-		{
-			name:    "plain_field",
-			content: "Depends on: a, b\n",
-			want:    []string{"a", "b"},
-		},
-		{
-			name:    "none",
-			content: "Depends on: none\n",
-			want:    nil,
-		},
-		{
-			name:    "empty",
-			content: "Depends on:    \n",
-			want:    nil,
-		},
-		{
-			name:    "with_comment",
-			content: "Depends on: auth, rate-limiting <!-- note -->\n",
-			want:    []string{"auth", "rate-limiting"},
-		},
+		{in: "## Requirements", want: "Requirements"},
+		{in: "## 5. Requirements", want: "Requirements"},
+		{in: "### 1.2. Goals", want: "Goals"},
+		{in: "## 2024 Roadmap", want: "2024 Roadmap"},
+		{in: "#Context", want: "Context"},
 	}
 
 	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			if got := parseDependsOn(tt.content); !reflect.DeepEqual(got, tt.want) {
-				t.Fatalf("parseDependsOn() = %#v, want %#v", got, tt.want)
-			}
-		})
+		if got := headerTitle(tt.in); got != tt.want {
+			t.Errorf("headerTitle(%q) = %q, want %q", tt.in, got, tt.want)
+		}
 	}
 }
 
@@ -113,3 +104,77 @@ func TestGetMissingCompletedDependencies(t *testing.T) {
 		t.Fatalf("missing = %#v, want %#v", missing, want)
 	}
 }
+
+func TestResolveSpecDir(t *testing.T) {
+	tests := []struct {
+		name    string
+		flag    string
+		env     string
+		want    string
+		wantErr bool
+	}{
+		{name: "default", want: "spec"},
+		{name: "env", env: ".nocturnal", want: ".nocturnal"},
+		{name: "flag overrides env", flag: "specs", env: ".nocturnal", want: "specs"},
+		{name: "nested", flag: "docs/spec/", want: filepath.Join("docs", "spec")},
+		{name: "absolute", flag: "/tmp/spec", wantErr: true},
+		{name: "parent", env: "../spec", wantErr: true},
+		{name: "current", flag: ".", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NOCTURNAL_DIR", tt.env)
+			got, err := resolveSpecDir(tt.flag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveSpecDir(%q) error = %v, wantErr %v", tt.flag, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("resolveSpecDir(%q) = %q, want %q", tt.flag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenamedWorkspaceDir(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("NOCTURNAL_DIR", ".nocturnal")
+	oldDocsPath := docsPath
+	t.Cleanup(func() {
+		specDir = defaultSpecDir
+		docsPath = oldDocsPath
+	})
+
+	if err := applySpecDir(""); err != nil {
+		t.Fatalf("applySpecDir: %v", err)
+	}
+	if want := filepath.Join(dir, ".nocturnal", docsDir); docsPath != want {
+		t.Errorf("docsPath = %q, want %q", docsPath, want)
+	}
+
+	runSpecInit(specInitCmd, nil)
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+	runSpecProposalActivate(specProposalActivateCmd, []string{"oauth-login"})
+
+	specPath, err := checkSpecWorkspace()
+	if err != nil {
+		t.Fatalf("checkSpecWorkspace: %v", err)
+	}
+	if want := filepath.Join(dir, ".nocturnal"); specPath != want {
+		t.Errorf("specPath = %q, want %q", specPath, want)
+	}
+	if !workspace.FileExists(filepath.Join(specPath, proposalDir, "oauth-login", "specification.md")) {
+		t.Error("expected the proposal inside the renamed workspace")
+	}
+	if workspace.FileExists(filepath.Join(dir, defaultSpecDir)) {
+		t.Error("expected no spec/ directory to be created")
+	}
+	state, err := loadState(specPath)
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	if !state.IsProposalActive("oauth-login") {
+		t.Error("expected the proposal to be active")
+	}
+}
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// guidelineSectionsByDocument maps documents to their default guideline
+// sections, used when the guideline files do not list them.
+var guidelineSectionsByDocument = map[string][]guidelineSection{
+	"specification.md": specificationSections,
+	"design.md":        designSections,
+}
+
+// fixProposalSections inserts missing guideline sections into each of a
+// proposal's documents and reports what was added.
+func fixProposalSections(specPath, proposalPath string) {
+	fixed := false
+	for _, doc := range proposalDocuments(specPath) {
+		if _, ok := guidelineSectionsByDocument[doc.File]; !ok {
+			continue
+		}
+		sections := loadGuidelineSections(specPath, doc.File)
+
+		filePath := filepath.Join(proposalPath, doc.File)
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			// Missing files are reported by validation
+			continue
+		}
+
+		updated, added := fixMissingSections(string(content), sections)
+		if len(added) == 0 {
+			continue
+		}
+		if err := os.WriteFile(filePath, []byte(updated), 0644); err != nil {
+			printError(fmt.Sprintf("Failed to write %s: %v", doc.File, err))
+			continue
+		}
+		printInfo(fmt.Sprintf("Added %d section(s) to %s: %s", len(added), doc.File, strings.Join(added, ", ")))
+		fixed = true
+	}
+
+	if !fixed {
+		printDim("No missing sections to add")
+	}
+	fmt.Println()
+}
+
+// fixMissingSections inserts a header, with its hint as a placeholder
+// comment, for each missing section. A section is placed after the nearest
+// earlier section that exists, or before the nearest later one, or at the
+// end of the document. It returns the updated content and the names of the
+// sections added; running it again on the result adds nothing.
+func fixMissingSections(content string, sections []guidelineSection) (string, []string) {
+	var added []string
+
+	for i, section := range sections {
+		if containsHeaderWithText(content, section.Name) {
+			continue
+		}
+
+		lines := strings.Split(content, "\n")
+		at := -1
+		for j := i - 1; j >= 0 && at == -1; j-- {
+			if start := findHeaderLine(lines, sections[j].Name); start != -1 {
+				at = headerSectionEnd(lines, start)
+			}
+		}
+		for j := i + 1; j < len(sections) && at == -1; j++ {
+			at = findHeaderLine(lines, sections[j].Name)
+		}
+
+		block := []string{"## " + section.Name, "", "<!-- " + section.Hint + " -->", ""}
+		if at == -1 || at == len(lines) {
+			// Append, keeping the document's trailing newline
+			at = len(lines)
+			if lines[at-1] == "" {
+				at--
+				block = block[:len(block)-1]
+			}
+		}
+		if at > 0 && strings.TrimSpace(lines[at-1]) != "" {
+			block = append([]string{""}, block...)
+		}
+
+		lines = append(lines[:at], append(block, lines[at:]...)...)
+		content = strings.Join(lines, "\n")
+		added = append(added, section.Name)
+	}
+
+	return content, added
+}
+
+// findHeaderLine returns the index of the first header line containing text
+// (case-insensitive), or -1.
+func findHeaderLine(lines []string, text string) int {
+	lowerText := strings.ToLower(text)
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "#") && strings.Contains(strings.ToLower(trimmed), lowerText) {
+			return i
+		}
+	}
+	return -1
+}
+
+// headerSectionEnd returns the index of the line ending the section whose
+// header is at start: the next header of the same or a higher level, or
+// len(lines).
+func headerSectionEnd(lines []string, start int) int {
+	level := headerLevel(lines[start])
+	for i := start + 1; i < len(lines); i++ {
+		if l := headerLevel(lines[i]); l > 0 && l <= level {
+			return i
+		}
+	}
+	return len(lines)
+}
+
+// headerLevel returns the number of leading '#' characters of a markdown
+// header line, or 0 if the line is not a header.
+func headerLevel(line string) int {
+	trimmed := strings.TrimSpace(line)
+	level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+	if level == 0 || (len(trimmed) > level && trimmed[level] != ' ') {
+		return 0
+	}
+	return level
+}
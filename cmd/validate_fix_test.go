@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFixMissingSections(t *testing.T) {
+	sections := []guidelineSection{
+		{"Abstract", "Summarize", true},
+		{"Introduction", "Explain why", true},
+		{"Requirements", "List requirements", true},
+		{"Examples", "Show examples", false},
+	}
+
+	content := "# Title\n\n## Abstract\n\nSummary.\n\n## 5. Requirements\n\nThe tool MUST work.\n"
+	got, added := fixMissingSections(content, sections)
+
+	if strings.Join(added, ",") != "Introduction,Examples" {
+		t.Fatalf("added = %v", added)
+	}
+	want := "# Title\n\n## Abstract\n\nSummary.\n\n## Introduction\n\n<!-- Explain why -->\n\n" +
+		"## 5. Requirements\n\nThe tool MUST work.\n\n## Examples\n\n<!-- Show examples -->\n"
+	if got != want {
+		t.Fatalf("fixMissingSections =\n%q\nwant\n%q", got, want)
+	}
+
+	// A section with no earlier neighbour goes before the next one present
+	got, _ = fixMissingSections("# Title\n\n## Introduction\n\nWhy.\n", sections[:2])
+	if !strings.HasPrefix(got, "# Title\n\n## Abstract\n\n<!-- Summarize -->\n\n## Introduction") {
+		t.Fatalf("unexpected placement:\n%s", got)
+	}
+}
+
+func TestProposalValidateFixIsIdempotent(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+
+	specFile := filepath.Join(specPath, proposalDir, "oauth-login", "specification.md")
+	if err := os.WriteFile(specFile, []byte("# OAuth Login\n\n## 5. Requirements\n\nThe system MUST support OAuth.\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	validateFix = true
+	t.Cleanup(func() { validateFix = false })
+
+	runSpecProposalValidate(specProposalValidateCmd, []string{"oauth-login"})
+	first, err := os.ReadFile(specFile)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if result := validateSpecification(string(first), specificationSections); len(result.Errors) != 0 {
+		t.Fatalf("expected no missing sections after --fix, got %v", result.Errors)
+	}
+
+	runSpecProposalValidate(specProposalValidateCmd, []string{"oauth-login"})
+	second, err := os.ReadFile(specFile)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if string(second) != string(first) {
+		t.Fatalf("second --fix changed the document:\n%s\nwant\n%s", second, first)
+	}
+}
@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// validateReportFormats maps each --format value to its report file extension.
+var validateReportFormats = map[string]string{
+	"markdown": ".md",
+	"json":     ".json",
+	"junit":    ".xml",
+	"sarif":    ".sarif",
+}
+
+// jsonValidationReport is the --format json report of one proposal.
+type jsonValidationReport struct {
+	Proposal  string                   `json:"proposal"`
+	Errors    int                      `json:"errors"`
+	Warnings  int                      `json:"warnings"`
+	Documents []jsonValidationDocument `json:"documents"`
+}
+
+// jsonValidationDocument holds the results of one proposal document.
+type jsonValidationDocument struct {
+	Document string   `json:"document"`
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+	Notes    []string `json:"notes,omitempty"`
+}
+
+// sarifLog is the root of a SARIF 2.1.0 log.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+// sarifRun holds the results of one validation run.
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+// sarifTool identifies nocturnal as the producer of a run.
+type sarifTool struct {
+	Driver struct {
+		Name string `json:"name"`
+	} `json:"driver"`
+}
+
+// sarifResult is one error, warning, or note.
+type sarifResult struct {
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+// sarifMessage is the text of a result.
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+// sarifLocation points a result at the document it applies to.
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI string `json:"uri"`
+		} `json:"artifactLocation"`
+	} `json:"physicalLocation"`
+}
+
+// writeValidationReports writes one report per proposal into dir, named by
+// slug with the format's extension, and returns the number written. base is
+// the directory the proposals live in relative to the spec directory
+// (proposal or archive), used for SARIF document locations.
+func writeValidationReports(dir, format, base string, reports []ProposalValidation) (int, error) {
+	ext, ok := validateReportFormats[format]
+	if !ok {
+		return 0, fmt.Errorf("unknown report format '%s': use markdown, json, junit, or sarif", format)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return 0, fmt.Errorf("failed to create report directory: %w", err)
+	}
+
+	written := 0
+	for _, report := range reports {
+		content, err := renderValidationReport(format, base, report)
+		if err != nil {
+			return written, err
+		}
+		if err := os.WriteFile(filepath.Join(dir, report.Slug+ext), content, 0644); err != nil {
+			return written, fmt.Errorf("failed to write report for '%s': %w", report.Slug, err)
+		}
+		written++
+	}
+	return written, nil
+}
+
+// renderValidationReport renders one proposal's results in format.
+func renderValidationReport(format, base string, report ProposalValidation) ([]byte, error) {
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(buildJSONValidationReport(report), "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize JSON report: %w", err)
+		}
+		return append(data, '\n'), nil
+	case "junit":
+		data, err := xml.MarshalIndent(buildJUnitReport([]ProposalValidation{report}), "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize JUnit report: %w", err)
+		}
+		return []byte(xml.Header + string(data) + "\n"), nil
+	case "sarif":
+		data, err := json.MarshalIndent(buildSARIFReport(base, report), "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize SARIF report: %w", err)
+		}
+		return append(data, '\n'), nil
+	default:
+		return []byte(renderMarkdownValidationReport(report)), nil
+	}
+}
+
+// buildJSONValidationReport converts one proposal's results for --format json.
+// Empty lists are kept as [] so consumers need not check for null.
+func buildJSONValidationReport(report ProposalValidation) jsonValidationReport {
+	out := jsonValidationReport{Proposal: report.Slug, Documents: []jsonValidationDocument{}}
+	for _, result := range report.Results {
+		doc := jsonValidationDocument{
+			Document: result.Document,
+			Errors:   append([]string{}, result.Errors...),
+			Warnings: append([]string{}, result.Warnings...),
+			Notes:    result.Notes,
+		}
+		out.Errors += len(result.Errors)
+		out.Warnings += len(result.Warnings)
+		out.Documents = append(out.Documents, doc)
+	}
+	return out
+}
+
+// buildSARIFReport converts one proposal's results into a SARIF log with a
+// result per error, warning, and note, located at base/<slug>/<document>.
+func buildSARIFReport(base string, report ProposalValidation) sarifLog {
+	run := sarifRun{Results: []sarifResult{}}
+	run.Tool.Driver.Name = "nocturnal"
+
+	for _, result := range report.Results {
+		add := func(level string, messages []string) {
+			for _, message := range messages {
+				r := sarifResult{Level: level, Message: sarifMessage{Text: message}}
+				var location sarifLocation
+				location.PhysicalLocation.ArtifactLocation.URI = sarifArtifactURI(base, report.Slug, result.Document)
+				r.Locations = []sarifLocation{location}
+				run.Results = append(run.Results, r)
+			}
+		}
+		add("error", result.Errors)
+		add("warning", result.Warnings)
+		add("note", result.Notes)
+	}
+
+	return sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+}
+
+// sarifArtifactURI returns the location, relative to the spec directory, of
+// a result about document. Results about the whole proposal point at its
+// directory, and guideline change warnings at the guideline file in the
+// spec directory.
+func sarifArtifactURI(base, slug, document string) string {
+	switch {
+	case document == proposalResultDocument:
+		return path.Join(base, slug) + "/"
+	case slices.Contains(validationGuidelines, document):
+		return document
+	default:
+		return path.Join(base, slug, document)
+	}
+}
+
+// renderMarkdownValidationReport renders one proposal's results as a
+// markdown document with a section per proposal document.
+func renderMarkdownValidationReport(report ProposalValidation) string {
+	var b strings.Builder
+	errorCount, warningCount := 0, 0
+	for _, result := range report.Results {
+		errorCount += len(result.Errors)
+		warningCount += len(result.Warnings)
+	}
+
+	fmt.Fprintf(&b, "# Validation: %s\n\n", report.Slug)
+	fmt.Fprintf(&b, "%s, %s\n", countNoun(errorCount, "error"), countNoun(warningCount, "warning"))
+	for _, result := range report.Results {
+		fmt.Fprintf(&b, "\n## %s\n\n", result.Document)
+		if len(result.Errors)+len(result.Warnings)+len(result.Notes) == 0 {
+			b.WriteString("No issues\n")
+			continue
+		}
+		for _, message := range result.Errors {
+			fmt.Fprintf(&b, "- Error: %s\n", message)
+		}
+		for _, message := range result.Warnings {
+			fmt.Fprintf(&b, "- Warning: %s\n", message)
+		}
+		for _, message := range result.Notes {
+			fmt.Fprintf(&b, "- Note: %s\n", message)
+		}
+	}
+	return b.String()
+}
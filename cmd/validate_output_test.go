@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestWriteValidationReports(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	for _, slug := range []string{"oauth-login", "sso"} {
+		runSpecProposalAdd(specProposalAddCmd, []string{slug})
+	}
+	if err := os.Remove(filepath.Join(specPath, proposalDir, "sso", "design.md")); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+
+	var reports []ProposalValidation
+	captureStdout(t, func() {
+		var err error
+		reports, _, _, err = validateProposals(specPath, listProposalSlugs(specPath))
+		if err != nil {
+			t.Fatalf("validateProposals error: %v", err)
+		}
+	})
+
+	for format, ext := range validateReportFormats {
+		dir := filepath.Join(t.TempDir(), "reports")
+		written, err := writeValidationReports(dir, format, proposalDir, reports)
+		if err != nil {
+			t.Fatalf("%s: writeValidationReports error: %v", format, err)
+		}
+		if written != 2 {
+			t.Errorf("%s: wrote %d reports, want 2", format, written)
+		}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatalf("%s: read dir: %v", format, err)
+		}
+		var files []string
+		for _, entry := range entries {
+			files = append(files, entry.Name())
+		}
+		sort.Strings(files)
+		if want := []string{"oauth-login" + ext, "sso" + ext}; !reflect.DeepEqual(files, want) {
+			t.Fatalf("%s: files = %v, want %v", format, files, want)
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, "sso"+ext))
+		if err != nil {
+			t.Fatalf("%s: read report: %v", format, err)
+		}
+		switch format {
+		case "json":
+			var report jsonValidationReport
+			if err := json.Unmarshal(content, &report); err != nil {
+				t.Fatalf("json report does not parse: %v", err)
+			}
+			if report.Proposal != "sso" || report.Errors == 0 {
+				t.Errorf("json report = %+v, want sso with errors", report)
+			}
+		case "sarif":
+			var log sarifLog
+			if err := json.Unmarshal(content, &log); err != nil {
+				t.Fatalf("sarif report does not parse: %v", err)
+			}
+			if log.Version != "2.1.0" || len(log.Runs) != 1 || !strings.Contains(string(content), `"uri": "proposal/sso/design.md"`) {
+				t.Errorf("sarif report missing the design.md error:\n%s", content)
+			}
+		case "junit":
+			var suites junitTestSuites
+			if err := xml.Unmarshal(content, &suites); err != nil {
+				t.Fatalf("junit report does not parse: %v", err)
+			}
+			if len(suites.Suites) != 1 || suites.Suites[0].Name != "sso" || suites.Failures == 0 {
+				t.Errorf("junit report = %+v, want one failing sso suite", suites)
+			}
+		case "markdown":
+			if !strings.HasPrefix(string(content), "# Validation: sso\n") || !strings.Contains(string(content), "- Error: File not found") {
+				t.Errorf("markdown report missing expected content:\n%s", content)
+			}
+		}
+	}
+
+	if _, err := writeValidationReports(t.TempDir(), "html", proposalDir, reports); err == nil {
+		t.Error("writeValidationReports accepted an unknown format")
+	}
+
+	// A pristine scaffold is reported as unstarted, located at the proposal
+	// directory rather than a file named after the result
+	for _, report := range reports {
+		if report.Slug != "oauth-login" {
+			continue
+		}
+		content, err := renderValidationReport("sarif", proposalDir, report)
+		if err != nil {
+			t.Fatalf("renderValidationReport error: %v", err)
+		}
+		var log sarifLog
+		if err := json.Unmarshal(content, &log); err != nil {
+			t.Fatalf("sarif report does not parse: %v", err)
+		}
+		found := false
+		for _, result := range log.Runs[0].Results {
+			uri := result.Locations[0].PhysicalLocation.ArtifactLocation.URI
+			if strings.Contains(result.Message.Text, "appears unstarted") {
+				found = true
+				if uri != "proposal/oauth-login/" {
+					t.Errorf("unstarted result uri = %q, want the proposal directory", uri)
+				}
+			}
+			if strings.HasSuffix(uri, "/"+proposalResultDocument) {
+				t.Errorf("result points at a nonexistent file %q", uri)
+			}
+		}
+		if !found {
+			t.Errorf("sarif report of a pristine scaffold has no unstarted result:\n%s", content)
+		}
+	}
+}
+
+func TestSARIFArtifactURI(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		document, want string
+	}{
+		{"design.md", "proposal/sso/design.md"},
+		{proposalResultDocument, "proposal/sso/"},
+		{"specification guidelines.md", "specification guidelines.md"},
+	}
+	for _, tt := range tests {
+		if got := sarifArtifactURI(proposalDir, "sso", tt.document); got != tt.want {
+			t.Errorf("sarifArtifactURI(%q) = %q, want %q", tt.document, got, tt.want)
+		}
+	}
+}
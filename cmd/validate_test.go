@@ -0,0 +1,397 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateProposalForTUIMatchesCLI(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+
+	proposalPath := filepath.Join(specPath, proposalDir, "oauth-login")
+	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte("# OAuth Login\n\n## Requirements\n\nThe system MUST support OAuth.\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := os.Remove(filepath.Join(proposalPath, "design.md")); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+
+	cli := validateProposalDocuments(specPath, proposalPath)
+	tuiResults := validateProposalForTUI(specPath, proposalPath)
+	if len(tuiResults) != len(cli) {
+		t.Fatalf("TUI got %d results, CLI got %d", len(tuiResults), len(cli))
+	}
+	for i := range cli {
+		if tuiResults[i].Document != cli[i].Document ||
+			!reflect.DeepEqual(tuiResults[i].Errors, cli[i].Errors) ||
+			!reflect.DeepEqual(tuiResults[i].Warnings, cli[i].Warnings) {
+			t.Errorf("result %d differs: TUI %+v, CLI %+v", i, tuiResults[i], cli[i])
+		}
+	}
+
+	byDoc := make(map[string][]string)
+	for _, result := range tuiResults {
+		byDoc[result.Document] = result.Errors
+	}
+	if errs := strings.Join(byDoc["specification.md"], "\n"); !strings.Contains(errs, "Missing required section: Abstract") {
+		t.Errorf("expected rich specification checks, got errors:\n%s", errs)
+	}
+	if errs := byDoc["design.md"]; len(errs) != 1 || errs[0] != "File not found" {
+		t.Errorf("expected missing design.md to be reported, got %v", errs)
+	}
+}
+
+func TestValidateArchivedProposal(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	for _, dir := range []string{proposalDir, sectionDir, archiveDir} {
+		if err := os.MkdirAll(filepath.Join(specPath, dir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	runSpecProposalAdd(specProposalAddCmd, []string{"oauth-login"})
+
+	proposalPath := filepath.Join(specPath, proposalDir, "oauth-login")
+	if err := os.WriteFile(filepath.Join(proposalPath, "design.md"), []byte("# Design: OAuth Login\n\n## Context\n\nToday.\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, err := completeProposal(specPath, "oauth-login", true, false, sectionConflictError); err != nil {
+		t.Fatalf("completeProposal: %v", err)
+	}
+
+	byDoc := make(map[string]ValidationResult)
+	for _, result := range validateArchivedDocuments(specPath, "oauth-login") {
+		byDoc[result.Document] = result
+	}
+
+	// The specification is read from section/ since completion promotes it
+	for _, doc := range []string{"specification.md", "design.md", "implementation.md"} {
+		result, ok := byDoc[doc]
+		if !ok {
+			t.Fatalf("no result for %s", doc)
+		}
+		if len(result.Errors) > 0 && result.Errors[0] == "File not found" {
+			t.Errorf("%s was not found", doc)
+		}
+	}
+	if errs := strings.Join(byDoc["design.md"].Errors, "\n"); !strings.Contains(errs, "Missing required section: Decision") {
+		t.Errorf("expected the archived design to be validated, got errors:\n%s", errs)
+	}
+	if len(byDoc["implementation.md"].Errors) != 0 {
+		t.Errorf("expected the archived implementation to pass, got %v", byDoc["implementation.md"].Errors)
+	}
+}
+
+func TestValidateReportsUnfilledFields(t *testing.T) {
+	data := proposalTemplateData{Name: "OAuth Login", Slug: "oauth-login"}
+
+	tests := []struct {
+		template string
+		fill     map[string]string
+		validate func(string) ValidationResult
+		want     []string
+		notWant  []string
+	}{
+		{
+			template: "templates/proposal/specification.md",
+			fill:     map[string]string{"Depends on": "none <!-- nothing yet -->"},
+			validate: func(content string) ValidationResult { return validateSpecification(content, specificationSections) },
+			want:     []string{"Unfilled field: Affected files (line 4)"},
+			notWant:  []string{"Unfilled field: Depends on"},
+		},
+		{
+			template: "templates/proposal/design.md",
+			fill:     map[string]string{"Chosen Option": "Option 1"},
+			validate: func(content string) ValidationResult { return validateDesign(content, false, designSections) },
+			want:     []string{"Unfilled field: Rationale (line 53)", "Unfilled field: Complexity (line 35)", "Unfilled field: Complexity (line 47)"},
+			notWant:  []string{"Unfilled field: Chosen Option", "Unfilled field: Advantages"},
+		},
+		{
+			template: "templates/proposal/implementation.md",
+			fill:     map[string]string{"Goal": "Ship the login flow"},
+			validate: func(content string) ValidationResult { return validateImplementation(content, false) },
+			want:     []string{"Unfilled field: Milestone (line 23)"},
+			notWant:  []string{"Unfilled field: Goal"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(filepath.Base(tt.template), func(t *testing.T) {
+			content, err := renderTemplate(tt.template, data)
+			if err != nil {
+				t.Fatalf("renderTemplate: %v", err)
+			}
+			content = fillFields(content, tt.fill)
+
+			warnings := strings.Join(tt.validate(content).Warnings, "\n")
+			for _, want := range tt.want {
+				if !strings.Contains(warnings, want) {
+					t.Errorf("missing warning %q in:\n%s", want, warnings)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(warnings, notWant) {
+					t.Errorf("unexpected warning %q in:\n%s", notWant, warnings)
+				}
+			}
+		})
+	}
+}
+
+// fillFields replaces the value of every "**Label**:" field named in values.
+func fillFields(content string, values map[string]string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		for label, value := range values {
+			if strings.HasPrefix(line, "**"+label+"**:") {
+				lines[i] = "**" + label + "**: " + value
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestValidateDesignOptions(t *testing.T) {
+	const header = "# Design: Caching\n\n## Options Considered\n\n"
+	const chosen = "\n## Decision\n\n**Chosen Option**: Option 1\n\n| Trade-off | Gain | Sacrifice | Justification |\n"
+	const onlyOne = "### Option 1: Redis\n\nShared cache.\n"
+	const justified = onlyOne + "\n### Justification\n\nThis is the only viable option because the platform mandates Redis.\n"
+	const two = onlyOne + "\n### Option 2: In-process\n\nPer-instance cache.\n"
+
+	const noOptions = "No options documented"
+	const singleOption = "Only one option documented"
+
+	tests := []struct {
+		name        string
+		options     string
+		strict      bool
+		wantError   string
+		wantWarning string
+	}{
+		{name: "zero", options: "", wantError: noOptions},
+		{name: "zero strict", options: "", strict: true, wantError: noOptions},
+		{name: "one", options: onlyOne, wantWarning: singleOption},
+		{name: "one strict", options: onlyOne, strict: true, wantError: singleOption},
+		{name: "one justified", options: justified},
+		{name: "one justified strict", options: justified, strict: true},
+		{name: "two", options: two},
+		{name: "two strict", options: two, strict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validateDesign(header+tt.options+chosen, tt.strict, designSections)
+			checkMessage(t, "error", result.Errors, tt.wantError, noOptions, singleOption)
+			checkMessage(t, "warning", result.Warnings, tt.wantWarning, noOptions, singleOption)
+		})
+	}
+}
+
+func TestValidateDuplicateSections(t *testing.T) {
+	const base = "# Caching\n\n## Abstract\n\nSummary.\n\n## 1. Introduction\n\nWhy.\n\n## 2. Requirements Notation\n\nRFC 2119.\n\n## 5. Requirements\n\n- The cache MUST expire entries.\n"
+	const duplicate = "Duplicate section"
+
+	tests := []struct {
+		name        string
+		content     string
+		wantWarning string
+	}{
+		{name: "single", content: base},
+		{name: "duplicate", content: base + "\n## Requirements\n\n- The cache SHOULD warm on start.\n", wantWarning: "Duplicate section: Requirements (lines 15, 19)"},
+		{name: "subsection", content: base + "\n### Functional Requirements\n\n- The cache MAY log hits.\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validateSpecification(tt.content, specificationSections)
+			checkMessage(t, "warning", result.Warnings, tt.wantWarning, duplicate)
+			if tt.wantWarning != "" {
+				for _, warning := range result.Warnings {
+					if strings.HasPrefix(warning, duplicate) && warning != tt.wantWarning {
+						t.Errorf("warning = %q, want %q", warning, tt.wantWarning)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestValidationDocumentCounts(t *testing.T) {
+	results := []ValidationResult{
+		{Document: "specification.md", Errors: []string{"Missing required section: Abstract", "Missing required section: Introduction"}, Warnings: []string{"Missing recommended section: Examples"}},
+		{Document: "design.md", Warnings: []string{"Missing metadata: Status"}, Notes: []string{"Waived: Missing metadata: Title (internal)"}},
+		{Document: "implementation.md"},
+	}
+
+	out := captureStdout(t, func() { printValidationResults(results) })
+
+	// Count the detailed messages under each document header
+	type counts struct{ errors, warnings int }
+	detailed := map[string]*counts{}
+	var current *counts
+	for _, line := range strings.Split(out, "\n") {
+		switch {
+		case strings.HasPrefix(line, "    ✗ "):
+			current.errors++
+		case strings.HasPrefix(line, "    ⚠ "):
+			current.warnings++
+		case strings.HasPrefix(line, "✗ "), strings.HasPrefix(line, "⚠ "), strings.HasPrefix(line, "✓ "):
+			current = &counts{}
+			detailed[strings.Fields(line)[1]] = current
+		}
+	}
+
+	for _, result := range results {
+		c := detailed[result.Document]
+		if c == nil {
+			t.Fatalf("no detailed output for %s:\n%s", result.Document, out)
+		}
+		want := documentCountLine(ValidationResult{Document: result.Document, Errors: make([]string, c.errors), Warnings: make([]string, c.warnings)})
+		if !strings.Contains(out, want+"\n") {
+			t.Errorf("output missing count line %q:\n%s", want, out)
+		}
+	}
+	for _, want := range []string{"specification.md: 2 errors, 1 warning", "design.md: 0 errors, 1 warning", "implementation.md: 0 errors, 0 warnings"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	validateSummary = true
+	t.Cleanup(func() { validateSummary = false })
+	summary := captureStdout(t, func() { printValidationResults(results) })
+	if strings.Contains(summary, "Missing required section") {
+		t.Errorf("--summary-only printed detailed messages:\n%s", summary)
+	}
+	if !strings.Contains(summary, "specification.md: 2 errors, 1 warning") || !strings.Contains(summary, "2 error(s), 2 warning(s)") {
+		t.Errorf("--summary-only output missing counts:\n%s", summary)
+	}
+}
+
+// checkMessage asserts that among the messages matching any of prefixes,
+// only one starting with want (or none, if want is empty) is present.
+func checkMessage(t *testing.T, kind string, messages []string, want string, prefixes ...string) {
+	t.Helper()
+	var got []string
+	for _, message := range messages {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(message, prefix) {
+				got = append(got, message)
+			}
+		}
+	}
+	switch {
+	case want == "" && len(got) > 0:
+		t.Errorf("unexpected %s(s): %q", kind, got)
+	case want != "" && (len(got) != 1 || !strings.HasPrefix(got[0], want)):
+		t.Errorf("%s(s) = %q, want one starting with %q", kind, got, want)
+	}
+}
+
+func TestValidateImplementationTaskIDs(t *testing.T) {
+	const content = "# Implementation\n\n### Phase 1: Setup\n\n- [ ] Add config {#add-config}\n- [ ] Add migration\n- [x] Empty marker {#}\n"
+	const missing = "2 task(s) without a {#id} marker (line 6, 7)"
+
+	result := validateImplementation(content, false)
+	checkMessage(t, "warning", result.Warnings, missing, missing)
+	checkMessage(t, "error", result.Errors, "", missing)
+
+	result = validateImplementation(content, true)
+	checkMessage(t, "error", result.Errors, missing, missing)
+	checkMessage(t, "warning", result.Warnings, "", missing)
+
+	result = validateImplementation("### Phase 1: Setup\n\n- [ ] Add config {#add-config}\n", true)
+	if len(result.Errors) > 0 {
+		t.Errorf("unexpected errors for fully marked tasks: %q", result.Errors)
+	}
+}
+
+func TestValidateProposalsFailFast(t *testing.T) {
+	t.Chdir(t.TempDir())
+	specPath := getSpecPath()
+	if err := os.MkdirAll(filepath.Join(specPath, proposalDir), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	for _, slug := range []string{"alpha", "beta", "gamma"} {
+		runSpecProposalAdd(specProposalAddCmd, []string{slug})
+	}
+	// Only alpha has errors; the scaffolded documents have just warnings
+	if err := os.WriteFile(filepath.Join(specPath, proposalDir, "alpha", "specification.md"), []byte("# Alpha\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	slugs := listProposalSlugs(specPath)
+
+	t.Cleanup(func() { validateFailFast = false })
+	for _, tt := range []struct {
+		failFast bool
+		want     []string
+	}{
+		{failFast: false, want: []string{"alpha", "beta", "gamma"}},
+		{failFast: true, want: []string{"alpha"}},
+	} {
+		validateFailFast = tt.failFast
+
+		var reports []ProposalValidation
+		var totalErrors int
+		out := captureStdout(t, func() {
+			var err error
+			reports, totalErrors, _, err = validateProposals(specPath, slugs)
+			if err != nil {
+				t.Fatalf("validateProposals: %v", err)
+			}
+		})
+
+		var got []string
+		for _, report := range reports {
+			got = append(got, report.Slug)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("failFast=%v: validated %v, want %v", tt.failFast, got, tt.want)
+		}
+		if totalErrors == 0 {
+			t.Errorf("failFast=%v: expected errors", tt.failFast)
+		}
+		if tt.failFast && strings.Contains(out, "beta") {
+			t.Errorf("later proposal evaluated with --fail-fast:\n%s", out)
+		}
+	}
+}
+
+func TestCheckAffectedFiles(t *testing.T) {
+	root := t.TempDir()
+	t.Chdir(root)
+	specPath := getSpecPath()
+	proposalPath := filepath.Join(specPath, proposalDir, "oauth-login")
+	if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(root, "cmd"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "cmd", "login.go"), []byte("package cmd\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	spec := "# OAuth Login\n\n**Affected files**: cmd/login.go, cmd/*.go, cmd/oauth.go, internal/*.go\n"
+	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte(spec), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	results := []ValidationResult{{Document: "specification.md", Warnings: []string{"existing"}}, {Document: "design.md"}}
+	results = checkAffectedFiles(specPath, proposalPath, results)
+
+	want := []string{"existing", "Affected file does not exist: cmd/oauth.go", "Affected file does not exist: internal/*.go"}
+	if !reflect.DeepEqual(results[0].Warnings, want) {
+		t.Errorf("warnings = %q, want %q", results[0].Warnings, want)
+	}
+	if len(results) != 2 || len(results[1].Warnings) != 0 {
+		t.Errorf("expected only specification.md to gain warnings, got %+v", results)
+	}
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+const week = 7 * 24 * time.Hour
+
+// Velocity summarizes recent task completions.
+type Velocity struct {
+	WeekStarts []time.Time // Start of each 7-day window, oldest first
+	Completed  []int       // Tasks completed in each window
+	PerWeek    float64     // Average tasks completed per week
+
+	// Remaining work on the active proposal, if any
+	Proposal  string
+	Remaining int
+	// EstimatedCompletion is zero when there is no recent velocity or
+	// nothing remains
+	EstimatedCompletion time.Time
+}
+
+// computeVelocity counts task_completed events in each of the last weeks
+// 7-day windows ending at now, and estimates when remaining tasks will be
+// done at the average rate.
+func computeVelocity(history []workspace.Event, weeks, remaining int, now time.Time) Velocity {
+	v := Velocity{Completed: make([]int, weeks), Remaining: remaining}
+	start := now.Add(-time.Duration(weeks) * week)
+	for i := 0; i < weeks; i++ {
+		v.WeekStarts = append(v.WeekStarts, start.Add(time.Duration(i)*week))
+	}
+
+	total := 0
+	for _, event := range history {
+		if event.Type != workspace.EventTaskCompleted {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, event.Timestamp)
+		if err != nil || t.Before(start) || t.After(now) {
+			continue
+		}
+		i := min(int(t.Sub(start)/week), weeks-1)
+		v.Completed[i]++
+		total++
+	}
+
+	if weeks > 0 {
+		v.PerWeek = float64(total) / float64(weeks)
+	}
+	if v.PerWeek > 0 && remaining > 0 {
+		days := math.Ceil(float64(remaining) / v.PerWeek * 7)
+		v.EstimatedCompletion = now.AddDate(0, 0, int(days))
+	}
+	return v
+}
+
+func runSpecStatsVelocity(specPath string) {
+	if statsWeeks <= 0 {
+		printError("--weeks must be at least 1")
+		return
+	}
+
+	state, err := loadState(specPath)
+	if err != nil {
+		printError(fmt.Sprintf("Failed to load state: %v", err))
+		return
+	}
+
+	remaining := 0
+	if state.Primary != "" {
+		total, completed := workspace.ProposalProgress(filepath.Join(specPath, proposalDir, state.Primary))
+		remaining = total - completed
+	}
+
+	v := computeVelocity(state.History, statsWeeks, remaining, time.Now())
+	v.Proposal = state.Primary
+
+	fmt.Println()
+	fmt.Println(boldStyle.Render(fmt.Sprintf("Velocity (last %d weeks)", statsWeeks)))
+	fmt.Println()
+
+	peak := 0
+	for _, n := range v.Completed {
+		peak = max(peak, n)
+	}
+
+	var rows [][]string
+	for i, n := range v.Completed {
+		bar := ""
+		if peak > 0 {
+			bar = infoStyle.Render(strings.Repeat("█", n*20/peak))
+		}
+		rows = append(rows, []string{v.WeekStarts[i].Local().Format("2006-01-02"), fmt.Sprint(n), bar})
+	}
+	fmt.Print(renderTable([]string{"WEEK OF", "TASKS", ""}, rows))
+	fmt.Println()
+
+	if v.PerWeek == 0 {
+		printDim("No recent completions")
+		if v.Proposal != "" {
+			printDim(fmt.Sprintf("Cannot estimate completion of '%s' without recent velocity", v.Proposal))
+		}
+		fmt.Println()
+		return
+	}
+
+	rows = [][]string{{"Average:", fmt.Sprintf("%.1f tasks/week", v.PerWeek)}}
+	switch {
+	case v.Proposal == "":
+		rows = append(rows, []string{"Current:", dimStyle.Render("no active proposal")})
+	case v.Remaining == 0:
+		rows = append(rows, []string{"Current:", fmt.Sprintf("%s %s", infoStyle.Render(v.Proposal), dimStyle.Render("(no tasks remaining)"))})
+	default:
+		rows = append(rows,
+			[]string{"Current:", fmt.Sprintf("%s %s", infoStyle.Render(v.Proposal), dimStyle.Render(fmt.Sprintf("(%d tasks remaining)", v.Remaining)))},
+			[]string{"Estimated:", v.EstimatedCompletion.Local().Format("2006-01-02")},
+		)
+	}
+	fmt.Print(renderTable(nil, rows))
+	fmt.Println()
+}
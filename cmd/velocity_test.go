@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+)
+
+func TestComputeVelocity(t *testing.T) {
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	at := func(daysAgo int) string {
+		return now.AddDate(0, 0, -daysAgo).Format(time.RFC3339)
+	}
+
+	history := []workspace.Event{
+		{Type: workspace.EventTaskCompleted, Slug: "oauth-login", Task: "1.1", Timestamp: at(20)},
+		{Type: workspace.EventTaskCompleted, Slug: "oauth-login", Task: "1.2", Timestamp: at(10)},
+		{Type: workspace.EventTaskCompleted, Slug: "oauth-login", Task: "1.3", Timestamp: at(9)},
+		{Type: workspace.EventTaskCompleted, Slug: "oauth-login", Task: "2.1", Timestamp: at(1)},
+		{Type: workspace.EventActivated, Slug: "oauth-login", Timestamp: at(2)},
+		{Type: workspace.EventTaskCompleted, Slug: "sso", Task: "1.1", Timestamp: at(60)},
+	}
+
+	v := computeVelocity(history, 4, 6, now)
+
+	want := []int{0, 1, 2, 1}
+	for i, n := range want {
+		if v.Completed[i] != n {
+			t.Errorf("week %d completed = %d, want %d (all: %v)", i, v.Completed[i], n, v.Completed)
+		}
+	}
+	if v.PerWeek != 1 {
+		t.Errorf("PerWeek = %v, want 1", v.PerWeek)
+	}
+	if want := now.AddDate(0, 0, 42); !v.EstimatedCompletion.Equal(want) {
+		t.Errorf("EstimatedCompletion = %v, want %v", v.EstimatedCompletion, want)
+	}
+}
+
+func TestComputeVelocityNoRecentCompletions(t *testing.T) {
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	history := []workspace.Event{
+		{Type: workspace.EventTaskCompleted, Slug: "sso", Task: "1.1", Timestamp: now.AddDate(0, 0, -90).Format(time.RFC3339)},
+	}
+
+	v := computeVelocity(history, 4, 5, now)
+	if v.PerWeek != 0 {
+		t.Errorf("PerWeek = %v, want 0", v.PerWeek)
+	}
+	if !v.EstimatedCompletion.IsZero() {
+		t.Errorf("expected no estimate without velocity, got %v", v.EstimatedCompletion)
+	}
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gitlab.com/caffeinatedjack/nocturnal/internal/workspace"
+	"gopkg.in/yaml.v3"
+)
+
+const validationWaiversFile = ".validate.yaml"
+
+// ValidationWaivers represents the .validate.yaml file stored in a proposal
+// directory.
+type ValidationWaivers struct {
+	Waivers []ValidationWaiver `yaml:"waivers"`
+}
+
+// ValidationWaiver waives every validation message that contains Check. An
+// empty Document applies the waiver to all documents.
+type ValidationWaiver struct {
+	Document      string `yaml:"document"`
+	Check         string `yaml:"check"`
+	Justification string `yaml:"justification"`
+}
+
+// loadValidationWaivers loads the waivers file from a proposal directory.
+// A missing file yields no waivers.
+func loadValidationWaivers(proposalPath string) ([]ValidationWaiver, error) {
+	waiversPath := filepath.Join(proposalPath, validationWaiversFile)
+	if !workspace.FileExists(waiversPath) {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(waiversPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read waivers file: %w", err)
+	}
+
+	var waivers ValidationWaivers
+	if err := yaml.Unmarshal(content, &waivers); err != nil {
+		return nil, fmt.Errorf("failed to parse waivers file: %w", err)
+	}
+	return waivers.Waivers, nil
+}
+
+// matches reports whether the waiver covers msg from document.
+func (w ValidationWaiver) matches(document, msg string) bool {
+	if strings.TrimSpace(w.Check) == "" {
+		return false
+	}
+	if w.Document != "" && w.Document != document {
+		return false
+	}
+	return containsText(msg, strings.TrimSpace(w.Check))
+}
+
+// applyValidationWaivers moves each waived error or warning into the
+// result's notes, recording the waiver's justification. A waiver without a
+// justification still applies but is warned about; under strict it is not
+// honored and is reported as an error instead.
+func applyValidationWaivers(results []ValidationResult, waivers []ValidationWaiver, strict bool) []ValidationResult {
+	if len(waivers) == 0 {
+		return results
+	}
+
+	var problems ValidationResult
+	problems.Document = validationWaiversFile
+	for i, waiver := range waivers {
+		if strings.TrimSpace(waiver.Check) == "" {
+			problems.Errors = append(problems.Errors, fmt.Sprintf("Waiver %d has no check", i+1))
+			continue
+		}
+		if strings.TrimSpace(waiver.Justification) == "" {
+			msg := fmt.Sprintf("Waiver for '%s' has no justification", waiver.Check)
+			if strict {
+				problems.Errors = append(problems.Errors, msg+" - not applied in strict mode")
+			} else {
+				problems.Warnings = append(problems.Warnings, msg)
+			}
+		}
+	}
+
+	for i := range results {
+		results[i].Errors = waiveMessages(&results[i], results[i].Errors, waivers, strict)
+		results[i].Warnings = waiveMessages(&results[i], results[i].Warnings, waivers, strict)
+	}
+
+	if len(problems.Errors) > 0 || len(problems.Warnings) > 0 {
+		results = append(results, problems)
+	}
+	return results
+}
+
+// waiveMessages returns the messages not covered by a waiver, adding a note
+// to result for each one that is.
+func waiveMessages(result *ValidationResult, messages []string, waivers []ValidationWaiver, strict bool) []string {
+	var kept []string
+	for _, msg := range messages {
+		waiver, ok := findWaiver(waivers, result.Document, msg, strict)
+		if !ok {
+			kept = append(kept, msg)
+			continue
+		}
+		justification := strings.TrimSpace(waiver.Justification)
+		if justification == "" {
+			justification = "no justification given"
+		}
+		result.Notes = append(result.Notes, fmt.Sprintf("Waived: %s (%s)", msg, justification))
+	}
+	return kept
+}
+
+// findWaiver returns the first applicable waiver covering msg. Under strict,
+// waivers without a justification are skipped.
+func findWaiver(waivers []ValidationWaiver, document, msg string, strict bool) (ValidationWaiver, bool) {
+	for _, waiver := range waivers {
+		if strict && strings.TrimSpace(waiver.Justification) == "" {
+			continue
+		}
+		if waiver.matches(document, msg) {
+			return waiver, true
+		}
+	}
+	return ValidationWaiver{}, false
+}
+
+// waiveProposalResults applies the proposal's waivers to results. A waivers
+// file that cannot be read is reported as an error.
+func waiveProposalResults(specPath, proposalPath string, results []ValidationResult) []ValidationResult {
+	waivers, err := loadValidationWaivers(proposalPath)
+	if err != nil {
+		return append(results, ValidationResult{Document: validationWaiversFile, Errors: []string{err.Error()}})
+	}
+	return applyValidationWaivers(results, waivers, loadConfigOrDefault(specPath).Validation.Strict)
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWaiverDowngradesMissingSection(t *testing.T) {
+	proposalPath := t.TempDir()
+	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte("# Spec\n\n## Abstract\n\nText.\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	waivers := `waivers:
+  - document: specification.md
+    check: "Missing required section: Introduction"
+    justification: Internal refactor with no user-facing behavior
+`
+	if err := os.WriteFile(filepath.Join(proposalPath, validationWaiversFile), []byte(waivers), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	content, _ := os.ReadFile(filepath.Join(proposalPath, "specification.md"))
+	loaded, err := loadValidationWaivers(proposalPath)
+	if err != nil {
+		t.Fatalf("loadValidationWaivers: %v", err)
+	}
+
+	for _, strict := range []bool{false, true} {
+		results := applyValidationWaivers([]ValidationResult{validateSpecification(string(content), specificationSections)}, loaded, strict)
+		if len(results) != 1 {
+			t.Fatalf("strict=%v: results = %+v, want only specification.md", strict, results)
+		}
+		for _, msg := range results[0].Errors {
+			if strings.Contains(msg, "Introduction") {
+				t.Errorf("strict=%v: waived error still reported: %s", strict, msg)
+			}
+		}
+		if len(results[0].Notes) != 1 || !strings.Contains(results[0].Notes[0], "Internal refactor with no user-facing behavior") {
+			t.Errorf("strict=%v: notes = %q, want one note with the justification", strict, results[0].Notes)
+		}
+	}
+}
+
+func TestWaiverWithoutJustification(t *testing.T) {
+	waivers := []ValidationWaiver{{Check: "Missing required section: Introduction"}}
+	newResults := func() []ValidationResult {
+		return []ValidationResult{{
+			Document: "specification.md",
+			Errors:   []string{"Missing required section: Introduction - Describe the problem"},
+		}}
+	}
+
+	// Honored with a warning when not strict
+	results := applyValidationWaivers(newResults(), waivers, false)
+	if len(results[0].Errors) != 0 || len(results[0].Notes) != 1 {
+		t.Errorf("results[0] = %+v, want the error waived", results[0])
+	}
+	if len(results) != 2 || results[1].Document != validationWaiversFile || len(results[1].Warnings) != 1 {
+		t.Errorf("results = %+v, want a warning for the waiver", results)
+	}
+
+	// Not honored, and reported as an error, under strict
+	results = applyValidationWaivers(newResults(), waivers, true)
+	if len(results[0].Errors) != 1 || len(results[0].Notes) != 0 {
+		t.Errorf("results[0] = %+v, want the error kept", results[0])
+	}
+	if len(results) != 2 || len(results[1].Errors) != 1 {
+		t.Errorf("results = %+v, want an error for the waiver", results)
+	}
+}
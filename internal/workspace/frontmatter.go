@@ -0,0 +1,80 @@
+package workspace
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FrontMatter is the metadata a document may declare in a leading YAML
+// block instead of the inline "**Status**:" style markers.
+type FrontMatter struct {
+	Title                  string
+	Status                 string
+	SpecificationReference string
+}
+
+// FrontMatterEnd returns the index of the line closing a front-matter block
+// that opens on the first line, or -1 when there is none. A block is a
+// first line of "---", a YAML mapping, and a closing "---" (or "...") line;
+// a document that merely starts with a --- separator, or whose block is not
+// a YAML mapping, has no front matter.
+func FrontMatterEnd(lines []string) int {
+	_, end := frontMatterFields(lines)
+	return end
+}
+
+// ParseFrontMatter reads a leading YAML front-matter block, as found by
+// FrontMatterEnd. It returns the metadata and the content with the block's
+// lines blanked, so line numbers reported by later checks still match the
+// file. Content without front matter is returned unchanged.
+func ParseFrontMatter(content string) (FrontMatter, string, bool) {
+	lines := strings.Split(content, "\n")
+	fields, end := frontMatterFields(lines)
+	if end == -1 {
+		return FrontMatter{}, content, false
+	}
+
+	field := func(key string) string {
+		if value, ok := fields[key]; ok && value != nil {
+			return strings.TrimSpace(fmt.Sprint(value))
+		}
+		return ""
+	}
+	meta := FrontMatter{
+		Title:                  field("title"),
+		Status:                 field("status"),
+		SpecificationReference: field("specification_reference"),
+	}
+
+	for i := 0; i <= end; i++ {
+		lines[i] = ""
+	}
+	return meta, strings.Join(lines, "\n"), true
+}
+
+// frontMatterFields decodes the front-matter block of lines, returning its
+// fields and the index of its closing line, or -1 when there is none.
+func frontMatterFields(lines []string) (map[string]any, int) {
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		return nil, -1
+	}
+
+	end := -1
+	for i := 1; i < len(lines); i++ {
+		if trimmed := strings.TrimSpace(lines[i]); trimmed == "---" || trimmed == "..." {
+			end = i
+			break
+		}
+	}
+	if end == -1 {
+		return nil, -1
+	}
+
+	var fields map[string]any
+	if err := yaml.Unmarshal([]byte(strings.Join(lines[1:end], "\n")), &fields); err != nil || len(fields) == 0 {
+		return nil, -1
+	}
+	return fields, end
+}
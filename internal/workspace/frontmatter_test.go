@@ -0,0 +1,57 @@
+package workspace
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	content := "---\ntitle: OAuth Login\nstatus: Draft\nspecification_reference: specification.md\n---\n# Design: OAuth Login\n"
+	meta, body, ok := ParseFrontMatter(content)
+	if !ok {
+		t.Fatal("ParseFrontMatter found no front matter")
+	}
+	want := FrontMatter{Title: "OAuth Login", Status: "Draft", SpecificationReference: "specification.md"}
+	if meta != want {
+		t.Errorf("meta = %+v, want %+v", meta, want)
+	}
+	if body != "\n\n\n\n\n# Design: OAuth Login\n" {
+		t.Errorf("body = %q, want the block blanked and line numbers kept", body)
+	}
+
+	for _, content := range []string{
+		"# Design\n\n---\n\nstatus: x\n---\n",       // separator later in the document
+		"---\n\n# Design: OAuth Login\n\nText\n",    // leading separator, never closed
+		"---\n\n# Design: OAuth Login\n\n---\nMore", // leading separator between sections
+		"---\n---\n# Design\n",                      // empty block
+	} {
+		if _, body, ok := ParseFrontMatter(content); ok || body != content {
+			t.Errorf("ParseFrontMatter(%q) treated a separator as front matter", content)
+		}
+	}
+}
+
+func TestFrontMatterEnd(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    int
+	}{
+		{name: "front matter", content: "---\nstatus: Draft\ntags:\n- auth\n---\n# Design", want: 4},
+		{name: "dot terminator", content: "---\ntitle: X\n...\n# Design", want: 2},
+		{name: "leading separator", content: "---\n\n# Design\n\nText\n---\nMore", want: -1},
+		{name: "empty block", content: "---\n---\n# Design", want: -1},
+		{name: "unclosed", content: "---\nstatus: Draft\n# Design", want: -1},
+		{name: "block scalar", content: "---\ndescription: |\n  text\n---\n# Design", want: 3},
+		{name: "not a mapping", content: "---\njust text\n---\n# Design", want: -1},
+		{name: "no block", content: "# Design\n---\nstatus: x\n---", want: -1},
+	}
+
+	for _, tt := range tests {
+		if got := FrontMatterEnd(strings.Split(tt.content, "\n")); got != tt.want {
+			t.Errorf("%s: FrontMatterEnd = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
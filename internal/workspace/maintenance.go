@@ -0,0 +1,191 @@
+package workspace
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MaintenanceRequirement represents a parsed requirement from a maintenance file.
+type MaintenanceRequirement struct {
+	ID           string
+	Text         string
+	Freq         string // daily, weekly, biweekly, monthly, quarterly, yearly, or empty (always)
+	Due          bool
+	LastActioned string // RFC3339 timestamp or empty
+	Note         string // Note recorded with the last action, or empty
+	Line         int    // 1-indexed line number in file
+	Group        string // Nearest "### " heading within Requirements, or empty
+}
+
+// MaintenanceFrequencies are the allowed [freq=...] values.
+var MaintenanceFrequencies = map[string]bool{
+	"daily":     true,
+	"weekly":    true,
+	"biweekly":  true,
+	"monthly":   true,
+	"quarterly": true,
+	"yearly":    true,
+}
+
+// MaintenanceIDPattern matches a requirement's [id=...] token.
+var MaintenanceIDPattern = regexp.MustCompile(`\[id=([^\]]+)\]`)
+
+// MaintenanceFreqPattern matches a requirement's [freq=...] token.
+var MaintenanceFreqPattern = regexp.MustCompile(`\[freq=([^\]]+)\]`)
+
+// ParseMaintenanceFile reads the requirements of a maintenance file, taking
+// when each was last actioned from state. Requirements without an [id=...],
+// with a duplicate id, or with an unknown frequency are errors.
+func ParseMaintenanceFile(filePath string, state *State, slug string) ([]MaintenanceRequirement, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(string(content), "\n")
+	var requirements []MaintenanceRequirement
+	inRequirements := false
+	group := ""
+	seenIDs := make(map[string]int) // id -> line number
+
+	for lineNum, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		// Detect start of Requirements section
+		if strings.HasPrefix(trimmed, "## Requirements") {
+			inRequirements = true
+			continue
+		}
+
+		// Stop at next section
+		if inRequirements && strings.HasPrefix(trimmed, "## ") {
+			break
+		}
+
+		// Subheadings name the group of the requirements that follow
+		if inRequirements && strings.HasPrefix(trimmed, "### ") {
+			group = strings.TrimSpace(strings.TrimPrefix(trimmed, "### "))
+			continue
+		}
+
+		// Parse requirement lines
+		if inRequirements && (strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ")) {
+			// Extract ID
+			idMatch := MaintenanceIDPattern.FindStringSubmatch(trimmed)
+			if len(idMatch) < 2 {
+				return nil, fmt.Errorf("line %d: requirement missing [id=...]: %s", lineNum+1, trimmed)
+			}
+			id := strings.TrimSpace(idMatch[1])
+
+			// Check for duplicate IDs
+			if prevLine, exists := seenIDs[id]; exists {
+				return nil, fmt.Errorf("line %d: duplicate id '%s' (first seen on line %d)", lineNum+1, id, prevLine)
+			}
+			seenIDs[id] = lineNum + 1
+
+			// Extract frequency (optional)
+			freq := ""
+			freqMatch := MaintenanceFreqPattern.FindStringSubmatch(trimmed)
+			if len(freqMatch) >= 2 {
+				freq = strings.TrimSpace(freqMatch[1])
+				if !MaintenanceFrequencies[freq] {
+					return nil, fmt.Errorf("line %d: unknown frequency '%s' (allowed: daily, weekly, biweekly, monthly, quarterly, yearly)", lineNum+1, freq)
+				}
+			}
+
+			// Strip tokens to get clean text
+			text := trimmed
+			text = MaintenanceIDPattern.ReplaceAllString(text, "")
+			text = MaintenanceFreqPattern.ReplaceAllString(text, "")
+			text = strings.TrimSpace(text)
+			// Remove leading bullet
+			text = strings.TrimPrefix(text, "- ")
+			text = strings.TrimPrefix(text, "* ")
+			text = strings.TrimSpace(text)
+
+			// Get last actioned time from state
+			lastActioned, note := "", ""
+			if state != nil && state.Maintenance != nil {
+				if slugMap, ok := state.Maintenance[slug]; ok {
+					if reqState, ok := slugMap[id]; ok {
+						lastActioned = reqState.LastActioned
+						note = reqState.Note
+					}
+				}
+			}
+
+			// Compute due status
+			due := MaintenanceDue(freq, lastActioned)
+
+			requirements = append(requirements, MaintenanceRequirement{
+				ID:           id,
+				Text:         text,
+				Freq:         freq,
+				Due:          due,
+				LastActioned: lastActioned,
+				Note:         note,
+				Line:         lineNum + 1,
+				Group:        group,
+			})
+		}
+	}
+
+	return requirements, nil
+}
+
+// MaintenanceDue determines if a requirement is due based on frequency and last actioned time.
+func MaintenanceDue(freq string, lastActioned string) bool {
+	// No freq => always due
+	if freq == "" {
+		return true
+	}
+
+	// Never actioned => due
+	if lastActioned == "" {
+		return true
+	}
+
+	// Parse last actioned time
+	lastTime, err := time.Parse(time.RFC3339, lastActioned)
+	if err != nil {
+		// Invalid timestamp => treat as never actioned
+		return true
+	}
+
+	now := time.Now()
+	var nextDue time.Time
+
+	switch freq {
+	case "daily":
+		nextDue = lastTime.AddDate(0, 0, 1)
+	case "weekly":
+		nextDue = lastTime.AddDate(0, 0, 7)
+	case "biweekly":
+		nextDue = lastTime.AddDate(0, 0, 14)
+	case "monthly":
+		nextDue = lastTime.AddDate(0, 1, 0)
+	case "quarterly":
+		nextDue = lastTime.AddDate(0, 3, 0)
+	case "yearly":
+		nextDue = lastTime.AddDate(1, 0, 0)
+	default:
+		// Unknown freq => always due
+		return true
+	}
+
+	return now.After(nextDue) || now.Equal(nextDue)
+}
+
+// CountDue returns how many of reqs are due.
+func CountDue(reqs []MaintenanceRequirement) int {
+	count := 0
+	for _, req := range reqs {
+		if req.Due {
+			count++
+		}
+	}
+	return count
+}
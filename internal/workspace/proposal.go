@@ -0,0 +1,187 @@
+package workspace
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// HashFile computes SHA256 hash of a file's contents. A missing file hashes
+// to the empty string.
+func HashFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	h := sha256.Sum256(content)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// ComputeProposalHashes computes hashes for the given proposal documents,
+// skipping any that don't exist.
+func ComputeProposalHashes(proposalPath string, files []string) (map[string]string, error) {
+	hashes := make(map[string]string)
+
+	for _, filename := range files {
+		hash, err := HashFile(filepath.Join(proposalPath, filename))
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash %s: %w", filename, err)
+		}
+		if hash != "" {
+			hashes[filename] = hash
+		}
+	}
+
+	return hashes, nil
+}
+
+// ReadProposalBaseline returns the contents of the given proposal documents
+// that exist, keyed by filename.
+func ReadProposalBaseline(proposalPath string, files []string) (map[string]string, error) {
+	baseline := make(map[string]string)
+
+	for _, filename := range files {
+		content, err := os.ReadFile(filepath.Join(proposalPath, filename))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read %s: %w", filename, err)
+		}
+		baseline[filename] = string(content)
+	}
+
+	return baseline, nil
+}
+
+// ProposalProgress counts the task checkboxes in the proposal's
+// implementation.md. A missing file has no tasks.
+func ProposalProgress(proposalPath string) (total int, completed int) {
+	content, err := os.ReadFile(filepath.Join(proposalPath, "implementation.md"))
+	if err != nil {
+		return 0, 0
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "- [ ]") {
+			total++
+		} else if strings.HasPrefix(trimmed, "- [x]") || strings.HasPrefix(trimmed, "- [X]") {
+			total++
+			completed++
+		}
+	}
+	return total, completed
+}
+
+// ProgressLevel is how far along task progress is, used to color progress
+// bars the same way in the CLI and the TUI.
+type ProgressLevel int
+
+const (
+	ProgressLow    ProgressLevel = iota // Below progressLowThreshold percent
+	ProgressMedium                      // Below progressHighThreshold percent
+	ProgressHigh
+)
+
+// Progress percentages at which progress moves from low to medium and from
+// medium to high.
+const (
+	progressLowThreshold  = 33
+	progressHighThreshold = 66
+)
+
+// ClassifyProgress returns the level of completed out of total tasks. No
+// tasks at all counts as low.
+func ClassifyProgress(completed, total int) ProgressLevel {
+	if total == 0 {
+		return ProgressLow
+	}
+	percentage := (completed * 100) / total
+	switch {
+	case percentage < progressLowThreshold:
+		return ProgressLow
+	case percentage < progressHighThreshold:
+		return ProgressMedium
+	default:
+		return ProgressHigh
+	}
+}
+
+// ProposalDependencies reads the proposal's specification.md and extracts
+// the "Depends on" field.
+func ProposalDependencies(proposalPath string) ([]string, error) {
+	content, err := os.ReadFile(filepath.Join(proposalPath, "specification.md"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read specification.md: %w", err)
+	}
+
+	return ParseDependsOn(string(content)), nil
+}
+
+// MissingCompletedDependencies returns the proposal's dependencies that are not
+// completed yet (absent from section/). This is the activation policy shared by
+// the CLI and the TUI: a proposal can only be activated once it returns none.
+func MissingCompletedDependencies(specPath, proposalPath string) ([]string, error) {
+	deps, err := ProposalDependencies(proposalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, dep := range deps {
+		if !FileExists(filepath.Join(specPath, "section", dep+".md")) {
+			missing = append(missing, dep)
+		}
+	}
+
+	sort.Strings(missing)
+	return missing, nil
+}
+
+// ParseDependsOn extracts dependencies from the "**Depends on**:" field in content
+func ParseDependsOn(content string) []string {
+	lines := strings.Split(content, "\n")
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		// Match "**Depends on**:" or "Depends on:" (case-insensitive)
+		lower := strings.ToLower(trimmed)
+		if strings.HasPrefix(lower, "**depends on**:") || strings.HasPrefix(lower, "depends on:") {
+			// Extract the value after the colon
+			idx := strings.Index(trimmed, ":")
+			if idx == -1 {
+				continue
+			}
+			value := strings.TrimSpace(trimmed[idx+1:])
+			// Remove any trailing comments
+			if commentIdx := strings.Index(value, "<!--"); commentIdx != -1 {
+				value = strings.TrimSpace(value[:commentIdx])
+			}
+			// Skip if empty, "none", or still contains template placeholder
+			if value == "" || strings.ToLower(value) == "none" || strings.Contains(value, "<!--") {
+				return nil
+			}
+			// Parse comma-separated list
+			var deps []string
+			for _, dep := range strings.Split(value, ",") {
+				dep = strings.TrimSpace(dep)
+				if dep != "" {
+					deps = append(deps, dep)
+				}
+			}
+			return deps
+		}
+	}
+	return nil
+}
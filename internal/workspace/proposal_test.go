@@ -0,0 +1,181 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestHashFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.md")
+
+	// Non-existent file returns empty hash
+	hash, err := HashFile(path)
+	if err != nil {
+		t.Fatalf("HashFile error: %v", err)
+	}
+	if hash != "" {
+		t.Fatalf("expected empty hash for non-existent file, got %q", hash)
+	}
+
+	// Write file and hash it
+	content := "# Test\n\nHello world\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	hash, err = HashFile(path)
+	if err != nil {
+		t.Fatalf("HashFile error: %v", err)
+	}
+	if hash == "" {
+		t.Fatal("expected non-empty hash")
+	}
+
+	// Same content = same hash
+	hash2, _ := HashFile(path)
+	if hash != hash2 {
+		t.Fatal("expected same hash for same content")
+	}
+
+	// Modified content = different hash
+	if err := os.WriteFile(path, []byte(content+"extra"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	hash3, _ := HashFile(path)
+	if hash == hash3 {
+		t.Fatal("expected different hash for modified content")
+	}
+}
+
+func TestParseDependsOn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "markdown_field_bold",
+			content: "# X\n\n**Depends on**: auth, rate-limiting\n",
+			want:    []string{"auth", "rate-limiting"},
+		},
+		{
+			name:    "plain_field",
+			content: "Depends on: a, b\n",
+			want:    []string{"a", "b"},
+		},
+		{
+			name:    "none",
+			content: "Depends on: none\n",
+			want:    nil,
+		},
+		{
+			name:    "empty",
+			content: "Depends on:    \n",
+			want:    nil,
+		},
+		{
+			name:    "with_comment",
+			content: "Depends on: auth, rate-limiting <!-- note -->\n",
+			want:    []string{"auth", "rate-limiting"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseDependsOn(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParseDependsOn() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeProposalHashes(t *testing.T) {
+	t.Parallel()
+
+	proposalPath := t.TempDir()
+	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte("# Spec\n"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	hashes, err := ComputeProposalHashes(proposalPath, []string{"specification.md", "design.md"})
+	if err != nil {
+		t.Fatalf("ComputeProposalHashes error: %v", err)
+	}
+	if len(hashes) != 1 || hashes["specification.md"] == "" {
+		t.Fatalf("expected a hash for specification.md only, got %v", hashes)
+	}
+}
+
+func TestProposalProgress(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if total, completed := ProposalProgress(dir); total != 0 || completed != 0 {
+		t.Fatalf("missing implementation.md = %d/%d, want 0/0", completed, total)
+	}
+
+	content := "# Implementation\n\n- [x] Done\n  - [X] Nested done\n- [ ] Open\n- Not a task\n"
+	if err := os.WriteFile(filepath.Join(dir, "implementation.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if total, completed := ProposalProgress(dir); total != 3 || completed != 2 {
+		t.Errorf("ProposalProgress = %d/%d, want 2/3", completed, total)
+	}
+}
+
+func TestClassifyProgress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		completed, total int
+		want             ProgressLevel
+	}{
+		{0, 0, ProgressLow},
+		{0, 10, ProgressLow},
+		{32, 100, ProgressLow},
+		{33, 100, ProgressMedium},
+		{65, 100, ProgressMedium},
+		{66, 100, ProgressHigh},
+		{10, 10, ProgressHigh},
+	}
+	for _, tt := range tests {
+		if got := ClassifyProgress(tt.completed, tt.total); got != tt.want {
+			t.Errorf("ClassifyProgress(%d, %d) = %v, want %v", tt.completed, tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestMissingCompletedDependencies(t *testing.T) {
+	t.Parallel()
+
+	specPath := t.TempDir()
+	proposalPath := filepath.Join(specPath, "proposal", "feature")
+	if err := os.MkdirAll(proposalPath, 0o755); err != nil {
+		t.Fatalf("mkdir proposal: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(proposalPath, "specification.md"), []byte("# Feature\n\n**Depends on**: dep-c, dep-a, dep-b\n"), 0o644); err != nil {
+		t.Fatalf("write specification.md: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(specPath, "section"), 0o755); err != nil {
+		t.Fatalf("mkdir section: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(specPath, "section", "dep-a.md"), []byte("# dep-a\n"), 0o644); err != nil {
+		t.Fatalf("write dep-a.md: %v", err)
+	}
+
+	missing, err := MissingCompletedDependencies(specPath, proposalPath)
+	if err != nil {
+		t.Fatalf("MissingCompletedDependencies error: %v", err)
+	}
+	if want := []string{"dep-b", "dep-c"}; !reflect.DeepEqual(missing, want) {
+		t.Errorf("missing = %v, want %v", missing, want)
+	}
+}
@@ -0,0 +1,226 @@
+package workspace
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// StateFile is the name of the state file in the spec directory.
+const StateFile = ".nocturnal.json"
+
+// State represents the nocturnal state file (spec/.nocturnal.json).
+type State struct {
+	Version      int                                    `json:"version"`
+	Active       []string                               `json:"active"`
+	Primary      string                                 `json:"primary"`
+	Hashes       map[string]map[string]string           `json:"hashes,omitempty"`
+	Baselines    map[string]map[string]string           `json:"baselines,omitempty"`  // Document contents at activation
+	Guidelines   map[string]map[string]string           `json:"guidelines,omitempty"` // Guideline hashes at proposal creation
+	Proposals    map[string]ProposalMeta                `json:"proposals,omitempty"`  // Creation metadata
+	Maintenance  map[string]map[string]MaintenanceState `json:"maintenance,omitempty"`
+	GitSnapshots map[string]GitSnapshotState            `json:"git_snapshots,omitempty"`
+	History      []Event                                `json:"history,omitempty"` // Append-only proposal lifecycle events
+}
+
+// Proposal lifecycle event types recorded in the state history.
+const (
+	EventCreated     = "created"
+	EventActivated   = "activated"
+	EventDeactivated = "deactivated"
+	EventCompleted   = "completed"
+	EventAbandoned   = "abandoned"
+
+	// EventTaskCompleted is recorded when a task is checked off
+	EventTaskCompleted = "task_completed"
+)
+
+// Event is a proposal lifecycle event.
+type Event struct {
+	Type      string `json:"type"`
+	Slug      string `json:"slug"`
+	Task      string `json:"task,omitempty"` // Task ID for task_completed events
+	Timestamp string `json:"timestamp"`      // RFC3339 timestamp
+}
+
+// ProposalMeta records who created a proposal and when.
+type ProposalMeta struct {
+	Author  string `json:"author,omitempty"`
+	Created string `json:"created"` // RFC3339 timestamp
+}
+
+// GitSnapshotState tracks git snapshots for task execution
+type GitSnapshotState struct {
+	SnapshotRef string `json:"snapshot_ref,omitempty"` // Git ref at snapshot time
+	TaskID      string `json:"task_id"`
+	Timestamp   string `json:"timestamp"` // RFC3339 timestamp
+}
+
+// MaintenanceState tracks when a maintenance requirement was last actioned.
+type MaintenanceState struct {
+	LastActioned string `json:"last_actioned"`  // RFC3339 timestamp
+	Note         string `json:"note,omitempty"` // What was done, from 'maintenance actioned --note'
+}
+
+// StatePath returns the path to the state file.
+func StatePath(specPath string) string {
+	return filepath.Join(specPath, StateFile)
+}
+
+// LoadState reads the state file. Returns empty state if file doesn't exist.
+func LoadState(specPath string) (*State, error) {
+	data, err := os.ReadFile(StatePath(specPath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &State{
+				Version:     1,
+				Active:      []string{},
+				Hashes:      make(map[string]map[string]string),
+				Baselines:   make(map[string]map[string]string),
+				Maintenance: make(map[string]map[string]MaintenanceState),
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to read state file: %w", err)
+	}
+
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse state file: %w", err)
+	}
+
+	if state.Hashes == nil {
+		state.Hashes = make(map[string]map[string]string)
+	}
+
+	if state.Baselines == nil {
+		state.Baselines = make(map[string]map[string]string)
+	}
+
+	if state.Maintenance == nil {
+		state.Maintenance = make(map[string]map[string]MaintenanceState)
+	}
+
+	return &state, nil
+}
+
+// SaveState writes the state file.
+func SaveState(specPath string, state *State) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize state: %w", err)
+	}
+
+	if err := os.WriteFile(StatePath(specPath), data, 0644); err != nil {
+		return fmt.Errorf("failed to write state file: %w", err)
+	}
+
+	return nil
+}
+
+// PrimaryProposal returns the primary proposal slug, or empty if there is
+// none or the state file cannot be read.
+func PrimaryProposal(specPath string) string {
+	state, err := LoadState(specPath)
+	if err != nil {
+		return ""
+	}
+	return state.Primary
+}
+
+// RecordEvent appends a lifecycle event for a proposal to the history.
+func (s *State) RecordEvent(slug, eventType string) {
+	s.History = append(s.History, Event{
+		Type:      eventType,
+		Slug:      slug,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// IsProposalActive checks if a proposal is in the active list.
+func (s *State) IsProposalActive(slug string) bool {
+	for _, active := range s.Active {
+		if active == slug {
+			return true
+		}
+	}
+	return false
+}
+
+// ActivateProposal adds a proposal to the active list and sets it as primary.
+func (s *State) ActivateProposal(slug string, hashes map[string]string) {
+	if !s.IsProposalActive(slug) {
+		s.Active = append(s.Active, slug)
+	}
+	s.Primary = slug
+	s.Hashes[slug] = hashes
+}
+
+// DeactivateProposal removes a proposal from the active list.
+func (s *State) DeactivateProposal(slug string) {
+	var newActive []string
+	for _, active := range s.Active {
+		if active != slug {
+			newActive = append(newActive, active)
+		}
+	}
+	s.Active = newActive
+	delete(s.Hashes, slug)
+	delete(s.Baselines, slug)
+
+	// Update primary if needed
+	if s.Primary == slug {
+		if len(s.Active) > 0 {
+			s.Primary = s.Active[0]
+		} else {
+			s.Primary = ""
+		}
+	}
+}
+
+// ForgetProposal clears the state of a proposal that has left proposal/ by
+// being completed, abandoned, or removed: it is deactivated and its
+// guideline hashes are dropped, so a later proposal reusing the slug starts
+// fresh. Creation metadata and history are kept. It reports whether
+// anything was cleared.
+func (s *State) ForgetProposal(slug string) bool {
+	_, hasGuidelines := s.Guidelines[slug]
+	if !s.IsProposalActive(slug) && !hasGuidelines {
+		return false
+	}
+	s.DeactivateProposal(slug)
+	delete(s.Guidelines, slug)
+	return true
+}
+
+// RenameProposal moves everything recorded under oldSlug to newSlug: its
+// active and primary status, hashes, baselines, creation metadata, and
+// history, so the proposal's timeline continues under the new name.
+func (s *State) RenameProposal(oldSlug, newSlug string) {
+	for i, active := range s.Active {
+		if active == oldSlug {
+			s.Active[i] = newSlug
+		}
+	}
+	if s.Primary == oldSlug {
+		s.Primary = newSlug
+	}
+
+	for _, m := range []map[string]map[string]string{s.Hashes, s.Baselines, s.Guidelines} {
+		if v, ok := m[oldSlug]; ok {
+			m[newSlug] = v
+			delete(m, oldSlug)
+		}
+	}
+	if meta, ok := s.Proposals[oldSlug]; ok {
+		s.Proposals[newSlug] = meta
+		delete(s.Proposals, oldSlug)
+	}
+
+	for i := range s.History {
+		if s.History[i].Slug == oldSlug {
+			s.History[i].Slug = newSlug
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package workspace
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStateLoadSave(t *testing.T) {
+	t.Parallel()
+
+	specPath := t.TempDir()
+
+	// Load from non-existent file returns empty state
+	state, err := LoadState(specPath)
+	if err != nil {
+		t.Fatalf("LoadState error: %v", err)
+	}
+	if state.Version != 1 {
+		t.Fatalf("expected version 1, got %d", state.Version)
+	}
+	if len(state.Active) != 0 {
+		t.Fatalf("expected empty active list, got %v", state.Active)
+	}
+
+	// Save and reload
+	state.ActivateProposal("test-proposal", map[string]string{
+		"specification.md": "abc123",
+	})
+
+	if err := SaveState(specPath, state); err != nil {
+		t.Fatalf("SaveState error: %v", err)
+	}
+
+	loaded, err := LoadState(specPath)
+	if err != nil {
+		t.Fatalf("LoadState after save error: %v", err)
+	}
+
+	if loaded.Primary != "test-proposal" {
+		t.Fatalf("expected primary 'test-proposal', got %q", loaded.Primary)
+	}
+	if len(loaded.Active) != 1 || loaded.Active[0] != "test-proposal" {
+		t.Fatalf("expected active ['test-proposal'], got %v", loaded.Active)
+	}
+	if loaded.Hashes["test-proposal"]["specification.md"] != "abc123" {
+		t.Fatalf("hash mismatch")
+	}
+}
+
+func TestStateActivateDeactivate(t *testing.T) {
+	t.Parallel()
+
+	state := &State{Version: 1, Active: []string{}, Hashes: make(map[string]map[string]string)}
+
+	// Activate first proposal
+	state.ActivateProposal("a", map[string]string{"spec.md": "hash-a"})
+	if state.Primary != "a" {
+		t.Fatalf("expected primary 'a', got %q", state.Primary)
+	}
+	if !state.IsProposalActive("a") {
+		t.Fatal("expected 'a' to be active")
+	}
+
+	// Activate second proposal (becomes primary)
+	state.ActivateProposal("b", map[string]string{"spec.md": "hash-b"})
+	if state.Primary != "b" {
+		t.Fatalf("expected primary 'b', got %q", state.Primary)
+	}
+	if len(state.Active) != 2 {
+		t.Fatalf("expected 2 active, got %d", len(state.Active))
+	}
+
+	// Deactivate primary
+	state.DeactivateProposal("b")
+	if state.Primary != "a" {
+		t.Fatalf("expected primary to fall back to 'a', got %q", state.Primary)
+	}
+	if state.IsProposalActive("b") {
+		t.Fatal("expected 'b' to be inactive")
+	}
+
+	// Deactivate last
+	state.DeactivateProposal("a")
+	if state.Primary != "" {
+		t.Fatalf("expected empty primary, got %q", state.Primary)
+	}
+}
+
+func TestStateRenameProposal(t *testing.T) {
+	t.Parallel()
+
+	state := &State{
+		Version:    1,
+		Active:     []string{"other", "login"},
+		Primary:    "login",
+		Hashes:     map[string]map[string]string{"login": {"spec.md": "h"}, "other": {"spec.md": "o"}},
+		Baselines:  map[string]map[string]string{"login": {"spec.md": "# Login"}},
+		Guidelines: map[string]map[string]string{"login": {"design guidelines.md": "g"}},
+		Proposals:  map[string]ProposalMeta{"login": {Author: "ada", Created: "2026-01-01T00:00:00Z"}},
+		History:    []Event{{Type: EventCreated, Slug: "login"}, {Type: EventCreated, Slug: "other"}},
+	}
+
+	state.RenameProposal("login", "sign-in")
+
+	if !reflect.DeepEqual(state.Active, []string{"other", "sign-in"}) || state.Primary != "sign-in" {
+		t.Errorf("active = %v, primary = %q, want sign-in in place of login", state.Active, state.Primary)
+	}
+	for name, m := range map[string]map[string]map[string]string{"hashes": state.Hashes, "baselines": state.Baselines, "guidelines": state.Guidelines} {
+		if _, ok := m["login"]; ok {
+			t.Errorf("%s still has login", name)
+		}
+		if _, ok := m["sign-in"]; !ok {
+			t.Errorf("%s missing sign-in", name)
+		}
+	}
+	if state.Hashes["other"]["spec.md"] != "o" {
+		t.Errorf("hashes of other proposal changed: %v", state.Hashes["other"])
+	}
+	if meta := state.Proposals["sign-in"]; meta.Author != "ada" {
+		t.Errorf("proposals[sign-in] = %+v, want the login metadata", meta)
+	}
+	if state.History[0].Slug != "sign-in" || state.History[1].Slug != "other" {
+		t.Errorf("history = %+v, want login events renamed only", state.History)
+	}
+}
+
+func TestPrimaryProposal(t *testing.T) {
+	t.Parallel()
+
+	specPath := t.TempDir()
+	if got := PrimaryProposal(specPath); got != "" {
+		t.Fatalf("expected no primary without a state file, got %q", got)
+	}
+
+	// An active proposal that isn't primary is not reported
+	state := &State{Version: 1, Active: []string{"a"}}
+	if err := SaveState(specPath, state); err != nil {
+		t.Fatalf("SaveState error: %v", err)
+	}
+	if got := PrimaryProposal(specPath); got != "" {
+		t.Fatalf("expected no primary, got %q", got)
+	}
+
+	state.Primary = "a"
+	if err := SaveState(specPath, state); err != nil {
+		t.Fatalf("SaveState error: %v", err)
+	}
+	if got := PrimaryProposal(specPath); got != "a" {
+		t.Fatalf("expected primary 'a', got %q", got)
+	}
+}
+
+func TestStateForgetProposal(t *testing.T) {
+	t.Parallel()
+
+	state := &State{
+		Hashes:     map[string]map[string]string{"feature": {"specification.md": "abc"}},
+		Baselines:  map[string]map[string]string{},
+		Guidelines: map[string]map[string]string{"feature": {"design guidelines.md": "def"}, "other": {}},
+		Proposals:  map[string]ProposalMeta{"feature": {}},
+	}
+	state.ActivateProposal("feature", map[string]string{"specification.md": "abc"})
+
+	if !state.ForgetProposal("feature") {
+		t.Fatal("ForgetProposal reported nothing cleared")
+	}
+	if state.IsProposalActive("feature") || state.Primary != "" {
+		t.Errorf("feature still active: %+v", state)
+	}
+	if _, ok := state.Guidelines["feature"]; ok {
+		t.Error("guideline hashes kept")
+	}
+	if _, ok := state.Guidelines["other"]; !ok {
+		t.Error("guideline hashes of another proposal dropped")
+	}
+	if _, ok := state.Proposals["feature"]; !ok {
+		t.Error("creation metadata dropped")
+	}
+
+	if state.ForgetProposal("feature") {
+		t.Error("ForgetProposal reported a change for a forgotten proposal")
+	}
+}
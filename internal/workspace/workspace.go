@@ -0,0 +1,45 @@
+// Package workspace holds the specification workspace helpers shared by the
+// CLI commands and the TUI: the state file, proposal document hashing, and
+// dependency parsing. Keeping a single copy stops the two front ends from
+// drifting apart.
+package workspace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// FileExists returns true if the path exists.
+func FileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// CopyFile copies a file from src to dst with 0644 permissions.
+func CopyFile(src, dst string) error {
+	content, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dst, content, 0644)
+}
+
+// ArchiveProposalDocs copies the given proposal documents that exist to the
+// archive directory.
+func ArchiveProposalDocs(proposalPath, archivePath string, files []string) error {
+	if err := os.MkdirAll(archivePath, 0755); err != nil {
+		return fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	for _, filename := range files {
+		src := filepath.Join(proposalPath, filename)
+		if FileExists(src) {
+			dst := filepath.Join(archivePath, filename)
+			if err := CopyFile(src, dst); err != nil {
+				return fmt.Errorf("failed to archive %s: %w", filename, err)
+			}
+		}
+	}
+	return nil
+}